@@ -42,7 +42,7 @@ type attestationStore struct {
 type Service struct {
 	ctx          context.Context
 	cancel       context.CancelFunc
-	beaconDB     *db.BeaconDB
+	beaconDB     db.Database
 	incomingFeed *event.Feed
 	incomingChan chan *pb.Attestation
 	// store is the mapping of individual
@@ -54,7 +54,7 @@ type Service struct {
 
 // Config options for the service.
 type Config struct {
-	BeaconDB *db.BeaconDB
+	BeaconDB db.Database
 }
 
 // NewAttestationService instantiates a new service instance that will
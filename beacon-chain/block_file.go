@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// maxBlockFileEntrySize bounds the size of a single block read from a block file.
+const maxBlockFileEntrySize = 1 << 24
+
+// writeBlock appends a block to a block file. A block file is a sequence of
+// protobuf encoded blocks, each prefixed by its length as a 4 byte big-endian integer.
+func writeBlock(w io.Writer, block *pb.BeaconBlock) error {
+	enc, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("could not marshal block: %v", err)
+	}
+	prefix := make([]byte, 4)
+	binary.BigEndian.PutUint32(prefix, uint32(len(enc)))
+	if _, err := w.Write(prefix); err != nil {
+		return err
+	}
+	_, err = w.Write(enc)
+	return err
+}
+
+// readBlock reads the next block of a block file, it returns io.EOF once all
+// the blocks have been read.
+func readBlock(r io.Reader) (*pb.BeaconBlock, error) {
+	prefix := make([]byte, 4)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(prefix)
+	if size > maxBlockFileEntrySize {
+		return nil, fmt.Errorf("block of %d bytes exceeds the maximum size of %d bytes", size, maxBlockFileEntrySize)
+	}
+	enc := make([]byte, size)
+	if _, err := io.ReadFull(r, enc); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	block := &pb.BeaconBlock{}
+	if err := proto.Unmarshal(enc, block); err != nil {
+		return nil, fmt.Errorf("could not unmarshal block: %v", err)
+	}
+	return block, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestBlockFile_RoundTrip(t *testing.T) {
+	blocks := []*pb.BeaconBlock{
+		{Slot: 1, ParentRootHash32: []byte{'A'}},
+		{Slot: 2, ParentRootHash32: []byte{'B'}},
+	}
+	buf := new(bytes.Buffer)
+	for _, b := range blocks {
+		if err := writeBlock(buf, b); err != nil {
+			t.Fatalf("Could not write block: %v", err)
+		}
+	}
+
+	for _, want := range blocks {
+		b, err := readBlock(buf)
+		if err != nil {
+			t.Fatalf("Could not read block: %v", err)
+		}
+		if !proto.Equal(b, want) {
+			t.Errorf("Wanted block %v, received %v", want, b)
+		}
+	}
+	if _, err := readBlock(buf); err != io.EOF {
+		t.Errorf("Expected io.EOF after the last block, received %v", err)
+	}
+}
+
+func TestReadBlock_Truncated(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := writeBlock(buf, &pb.BeaconBlock{Slot: 1}); err != nil {
+		t.Fatalf("Could not write block: %v", err)
+	}
+	truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-1])
+	if _, err := readBlock(truncated); err != io.ErrUnexpectedEOF {
+		t.Errorf("Expected io.ErrUnexpectedEOF, received %v", err)
+	}
+}
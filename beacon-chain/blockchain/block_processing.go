@@ -180,6 +180,9 @@ func (c *ChainService) ApplyBlockStateTransition(
 	if err != nil {
 		return beaconState, err
 	}
+	if err := c.insertBlockTree(block); err != nil {
+		return beaconState, fmt.Errorf("could not insert block in the block tree: %v", err)
+	}
 	return beaconState, nil
 }
 
@@ -269,8 +272,8 @@ func (c *ChainService) runStateTransition(
 		block,
 		headRoot,
 		&state.TransitionConfig{
-			VerifySignatures: false, // We disable signature verification for now.
-			Logging:          true,  // We enable logging in this state transition call.
+			VerifySignatures: !featureconfig.FeatureConfig().DisableSignatureVerification,
+			Logging:          true, // We enable logging in this state transition call.
 		},
 	)
 	if err != nil {
@@ -224,7 +224,8 @@ func TestReceiveBlock_UsesParentBlockState(t *testing.T) {
 
 func TestReceiveBlock_DeletesBadBlock(t *testing.T) {
 	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCheckBlockStateRoot: false,
+		EnableCheckBlockStateRoot:    false,
+		DisableSignatureVerification: true,
 	})
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -300,7 +301,8 @@ func TestReceiveBlock_DeletesBadBlock(t *testing.T) {
 		t.Error("Expected block root to have been blacklisted")
 	}
 	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCheckBlockStateRoot: true,
+		EnableCheckBlockStateRoot:    true,
+		DisableSignatureVerification: true,
 	})
 }
 
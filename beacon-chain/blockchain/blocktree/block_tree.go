@@ -0,0 +1,263 @@
+// Package blocktree implements an in-memory tree of the blocks descending from
+// the justified block, used to run the LMD-GHOST fork choice rule incrementally.
+//
+// The blocks are stored in a flat array in insertion order, so every parent comes
+// before its children (proto-array). Each node keeps the weight of the votes for
+// itself and its descendants along with its best child and descendant. Applying
+// the changed votes walks the array backwards once, so finding the head is linear
+// in the number of blocks and votes instead of rescanning the tree for every
+// child of every block on the way to the head.
+package blocktree
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+)
+
+// none is the index of a node which does not exist, such as the parent of the root.
+const none = -1
+
+type node struct {
+	slot           uint64
+	root           [32]byte
+	parent         int
+	weight         uint64
+	bestChild      int
+	bestDescendant int
+}
+
+// vote is the latest block a validator voted for, the weight of the vote is
+// moved from the current to the next block once the deltas are applied.
+type vote struct {
+	current [32]byte
+	next    [32]byte
+}
+
+// NodeInfo describes a block of the tree with the total balance voting for it
+// or its descendants.
+type NodeInfo struct {
+	Slot       uint64
+	Root       [32]byte
+	ParentRoot [32]byte
+	Weight     uint64
+}
+
+// Tree is a proto-array block tree rooted at the justified block.
+type Tree struct {
+	lock     sync.RWMutex
+	nodes    []*node
+	indices  map[[32]byte]int
+	votes    map[uint64]*vote
+	balances map[uint64]uint64
+}
+
+// New creates a block tree rooted at the block with the given slot and root.
+func New(slot uint64, root [32]byte) *Tree {
+	return &Tree{
+		nodes:    []*node{{slot: slot, root: root, parent: none, bestChild: none, bestDescendant: none}},
+		indices:  map[[32]byte]int{root: 0},
+		votes:    make(map[uint64]*vote),
+		balances: make(map[uint64]uint64),
+	}
+}
+
+// Root returns the root of the block at the base of the tree.
+func (t *Tree) Root() [32]byte {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.nodes[0].root
+}
+
+// Contains returns true if the block with the given root is in the tree.
+func (t *Tree) Contains(root [32]byte) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	_, ok := t.indices[root]
+	return ok
+}
+
+// InsertBlock adds a block to the tree. Blocks already in the tree, blocks whose
+// parent is not in the tree, which cannot descend from its root, and blocks which
+// are not after their parent are ignored. The block is considered by the fork
+// choice the next time the head is computed.
+func (t *Tree) InsertBlock(slot uint64, root [32]byte, parentRoot [32]byte) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, ok := t.indices[root]; ok {
+		return
+	}
+	parent, ok := t.indices[parentRoot]
+	if !ok || t.nodes[parent].slot >= slot {
+		return
+	}
+	t.indices[root] = len(t.nodes)
+	t.nodes = append(t.nodes, &node{
+		slot:           slot,
+		root:           root,
+		parent:         parent,
+		bestChild:      none,
+		bestDescendant: none,
+	})
+}
+
+// ProcessAttestation records the latest block voted for by a validator. The
+// vote is accounted for the next time the head is computed.
+func (t *Tree) ProcessAttestation(validatorIndex uint64, root [32]byte) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	v, ok := t.votes[validatorIndex]
+	if !ok {
+		t.votes[validatorIndex] = &vote{next: root}
+		return
+	}
+	v.next = root
+}
+
+// Head applies the votes and balances changed since the last call and returns
+// the root of the head block, the heaviest descendant of the root of the tree.
+// Ties between children are broken in favor of the highest block root.
+func (t *Tree) Head(balances map[uint64]uint64) [32]byte {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.applyDeltas(t.deltas(balances))
+	t.balances = balances
+
+	root := t.nodes[0]
+	if root.bestDescendant == none {
+		return root.root
+	}
+	return t.nodes[root.bestDescendant].root
+}
+
+// Weight returns the total balance voting for a block or its descendants, as
+// of the last time the head was computed.
+func (t *Tree) Weight(root [32]byte) (uint64, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	index, ok := t.indices[root]
+	if !ok {
+		return 0, false
+	}
+	return t.nodes[index].weight, true
+}
+
+// Nodes returns the blocks of the tree with their weight as of the last time
+// the head was computed. Every block comes after its parent.
+func (t *Tree) Nodes() []*NodeInfo {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	nodes := make([]*NodeInfo, len(t.nodes))
+	for i, n := range t.nodes {
+		nodes[i] = &NodeInfo{Slot: n.slot, Root: n.root, Weight: n.weight}
+		if n.parent != none {
+			nodes[i].ParentRoot = t.nodes[n.parent].root
+		}
+	}
+	return nodes
+}
+
+// Prune makes the block with the given root the root of the tree, dropping
+// every block which does not descend from it.
+func (t *Tree) Prune(root [32]byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	start, ok := t.indices[root]
+	if !ok {
+		return errors.New("cannot prune the tree to a block which is not in it")
+	}
+	if start == 0 {
+		return nil
+	}
+
+	// Descendants always come after their ancestors, so a single pass from the
+	// new root finds all of them.
+	remapped := map[int]int{start: 0}
+	nodes := []*node{t.nodes[start]}
+	for i := start + 1; i < len(t.nodes); i++ {
+		if parent, ok := remapped[t.nodes[i].parent]; ok {
+			remapped[i] = len(nodes)
+			t.nodes[i].parent = parent
+			nodes = append(nodes, t.nodes[i])
+		}
+	}
+	nodes[0].parent = none
+
+	t.indices = make(map[[32]byte]int, len(nodes))
+	for i, n := range nodes {
+		t.indices[n.root] = i
+		if n.bestChild != none {
+			n.bestChild = remapped[n.bestChild]
+		}
+		if n.bestDescendant != none {
+			n.bestDescendant = remapped[n.bestDescendant]
+		}
+	}
+	t.nodes = nodes
+	return nil
+}
+
+// deltas computes the weight to add to or remove from each node, moving each
+// vote from its current to its next block and accounting for balance changes.
+// A vote for a block which is not in the tree yet stays on its current block
+// until the block is inserted.
+func (t *Tree) deltas(balances map[uint64]uint64) []int64 {
+	deltas := make([]int64, len(t.nodes))
+	for validatorIndex, v := range t.votes {
+		oldBalance := t.balances[validatorIndex]
+		newBalance := balances[validatorIndex]
+		if v.current == v.next && oldBalance == newBalance {
+			continue
+		}
+		next, ok := t.indices[v.next]
+		if !ok {
+			if i, ok := t.indices[v.current]; ok {
+				deltas[i] += int64(newBalance) - int64(oldBalance)
+			}
+			continue
+		}
+		if i, ok := t.indices[v.current]; ok {
+			deltas[i] -= int64(oldBalance)
+		}
+		deltas[next] += int64(newBalance)
+		v.current = v.next
+	}
+	return deltas
+}
+
+// applyDeltas propagates the deltas from the leaves to the root of the tree,
+// then recomputes the best child and descendant of every node.
+func (t *Tree) applyDeltas(deltas []int64) {
+	for i := len(t.nodes) - 1; i >= 0; i-- {
+		n := t.nodes[i]
+		n.weight = uint64(int64(n.weight) + deltas[i])
+		if n.parent != none {
+			deltas[n.parent] += deltas[i]
+		}
+		n.bestChild = none
+		n.bestDescendant = none
+	}
+	// Children are visited before their parent, with their final weight and
+	// best descendant, so every node is compared against all of its siblings.
+	for i := len(t.nodes) - 1; i > 0; i-- {
+		t.updateBestChild(t.nodes[i].parent, i)
+	}
+}
+
+// updateBestChild makes the child the best child of its parent if it is heavier
+// than the current best child, and updates the best descendant of the parent.
+func (t *Tree) updateBestChild(parent int, child int) {
+	p := t.nodes[parent]
+	if p.bestChild != none && p.bestChild != child {
+		best := t.nodes[p.bestChild]
+		c := t.nodes[child]
+		if c.weight < best.weight || (c.weight == best.weight && bytes.Compare(c.root[:], best.root[:]) < 0) {
+			return
+		}
+	}
+	p.bestChild = child
+	p.bestDescendant = child
+	if t.nodes[child].bestDescendant != none {
+		p.bestDescendant = t.nodes[child].bestDescendant
+	}
+}
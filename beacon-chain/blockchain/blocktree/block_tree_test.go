@@ -0,0 +1,181 @@
+package blocktree
+
+import (
+	"testing"
+)
+
+func root(b byte) [32]byte {
+	return [32]byte{b}
+}
+
+// newTestTree builds the following tree, with the slot of each block in brackets:
+//
+//	       /- B(2) - C(3)
+//	A(1) -
+//	       \- D(2) - E(4)
+func newTestTree() *Tree {
+	tree := New(1, root('A'))
+	tree.InsertBlock(2, root('B'), root('A'))
+	tree.InsertBlock(2, root('D'), root('A'))
+	tree.InsertBlock(3, root('C'), root('B'))
+	tree.InsertBlock(4, root('E'), root('D'))
+	return tree
+}
+
+func TestHead_NoVotesHighestRootWins(t *testing.T) {
+	tree := newTestTree()
+	if head := tree.Head(nil); head != root('E') {
+		t.Errorf("Wanted head %x, received %x", root('E'), head)
+	}
+}
+
+func TestHead_FollowsHeaviestBranch(t *testing.T) {
+	tree := newTestTree()
+	balances := map[uint64]uint64{0: 10, 1: 10, 2: 10}
+	tree.ProcessAttestation(0, root('C'))
+	tree.ProcessAttestation(1, root('B'))
+	tree.ProcessAttestation(2, root('E'))
+	if head := tree.Head(balances); head != root('C') {
+		t.Errorf("Wanted head %x, received %x", root('C'), head)
+	}
+	if w, _ := tree.Weight(root('A')); w != 30 {
+		t.Errorf("Wanted weight 30 for the root, received %d", w)
+	}
+	if w, _ := tree.Weight(root('B')); w != 20 {
+		t.Errorf("Wanted weight 20 for B, received %d", w)
+	}
+
+	// Two validators moving their votes to the other branch switches the head.
+	tree.ProcessAttestation(0, root('D'))
+	tree.ProcessAttestation(1, root('E'))
+	if head := tree.Head(balances); head != root('E') {
+		t.Errorf("Wanted head %x, received %x", root('E'), head)
+	}
+	if w, _ := tree.Weight(root('B')); w != 0 {
+		t.Errorf("Wanted weight 0 for B, received %d", w)
+	}
+	if w, _ := tree.Weight(root('D')); w != 30 {
+		t.Errorf("Wanted weight 30 for D, received %d", w)
+	}
+}
+
+func TestHead_BalanceChange(t *testing.T) {
+	tree := newTestTree()
+	tree.ProcessAttestation(0, root('C'))
+	tree.ProcessAttestation(1, root('E'))
+	if head := tree.Head(map[uint64]uint64{0: 20, 1: 10}); head != root('C') {
+		t.Errorf("Wanted head %x, received %x", root('C'), head)
+	}
+	// Votes are unchanged, but the balance of the first validator dropped.
+	if head := tree.Head(map[uint64]uint64{0: 5, 1: 10}); head != root('E') {
+		t.Errorf("Wanted head %x, received %x", root('E'), head)
+	}
+	if w, _ := tree.Weight(root('A')); w != 15 {
+		t.Errorf("Wanted weight 15 for the root, received %d", w)
+	}
+}
+
+func TestHead_VoteBeforeBlock(t *testing.T) {
+	tree := newTestTree()
+	balances := map[uint64]uint64{0: 10, 1: 15}
+	tree.ProcessAttestation(0, root('C'))
+	tree.ProcessAttestation(1, root('E'))
+	if head := tree.Head(balances); head != root('E') {
+		t.Errorf("Wanted head %x, received %x", root('E'), head)
+	}
+
+	// The vote for F stays on C until F is inserted.
+	tree.ProcessAttestation(0, root('F'))
+	tree.ProcessAttestation(2, root('F'))
+	balances[2] = 10
+	if head := tree.Head(balances); head != root('E') {
+		t.Errorf("Wanted head %x, received %x", root('E'), head)
+	}
+	if w, _ := tree.Weight(root('C')); w != 10 {
+		t.Errorf("Wanted weight 10 for C, received %d", w)
+	}
+
+	tree.InsertBlock(4, root('F'), root('C'))
+	if head := tree.Head(balances); head != root('F') {
+		t.Errorf("Wanted head %x, received %x", root('F'), head)
+	}
+	if w, _ := tree.Weight(root('F')); w != 20 {
+		t.Errorf("Wanted weight 20 for F, received %d", w)
+	}
+	if w, _ := tree.Weight(root('A')); w != 35 {
+		t.Errorf("Wanted weight 35 for the root, received %d", w)
+	}
+}
+
+func TestInsertBlock_IgnoresUnknownParent(t *testing.T) {
+	tree := newTestTree()
+	tree.InsertBlock(5, root('F'), root('Z'))
+	if tree.Contains(root('F')) {
+		t.Error("Expected block with unknown parent to be ignored")
+	}
+	tree.InsertBlock(2, root('G'), root('C'))
+	if tree.Contains(root('G')) {
+		t.Error("Expected block before its parent to be ignored")
+	}
+	// A vote for a block which is not in the tree carries no weight.
+	tree.ProcessAttestation(0, root('F'))
+	tree.Head(map[uint64]uint64{0: 10})
+	if w, _ := tree.Weight(root('A')); w != 0 {
+		t.Errorf("Wanted weight 0 for the root, received %d", w)
+	}
+}
+
+func TestPrune_DropsOtherBranches(t *testing.T) {
+	tree := newTestTree()
+	balances := map[uint64]uint64{0: 10, 1: 10}
+	tree.ProcessAttestation(0, root('C'))
+	tree.ProcessAttestation(1, root('E'))
+	tree.Head(balances)
+
+	if err := tree.Prune(root('D')); err != nil {
+		t.Fatalf("Could not prune tree: %v", err)
+	}
+	if tree.Root() != root('D') {
+		t.Errorf("Wanted root %x, received %x", root('D'), tree.Root())
+	}
+	for _, r := range [][32]byte{root('A'), root('B'), root('C')} {
+		if tree.Contains(r) {
+			t.Errorf("Expected block %x to be pruned", r)
+		}
+	}
+
+	// The vote for the pruned branch moves to the remaining one.
+	tree.ProcessAttestation(0, root('E'))
+	tree.InsertBlock(5, root('F'), root('D'))
+	if head := tree.Head(balances); head != root('E') {
+		t.Errorf("Wanted head %x, received %x", root('E'), head)
+	}
+	if w, _ := tree.Weight(root('E')); w != 20 {
+		t.Errorf("Wanted weight 20 for E, received %d", w)
+	}
+
+	if err := tree.Prune(root('C')); err == nil {
+		t.Error("Expected error when pruning to a block which is not in the tree")
+	}
+}
+
+func TestNodes_ParentsFirst(t *testing.T) {
+	tree := newTestTree()
+	tree.ProcessAttestation(0, root('C'))
+	tree.Head(map[uint64]uint64{0: 10})
+
+	nodes := tree.Nodes()
+	if len(nodes) != 5 {
+		t.Fatalf("Wanted 5 nodes, received %d", len(nodes))
+	}
+	seen := make(map[[32]byte]bool)
+	for i, n := range nodes {
+		if i > 0 && !seen[n.ParentRoot] {
+			t.Errorf("Node %x came before its parent %x", n.Root, n.ParentRoot)
+		}
+		seen[n.Root] = true
+		if (n.Root == root('B') || n.Root == root('C')) && n.Weight != 10 {
+			t.Errorf("Wanted weight 10 for %x, received %d", n.Root, n.Weight)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/blocktree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -156,7 +157,7 @@ func (c *ChainService) ApplyForkChoiceRule(
 		return fmt.Errorf("could not retrieve justified head: %v", err)
 	}
 
-	newHead, err := c.lmdGhost(ctx, justifiedHead, justifiedState, attestationTargets)
+	newHead, err := c.blockTreeHead(ctx, justifiedHead, justifiedState, attestationTargets)
 	if err != nil {
 		return fmt.Errorf("could not run fork choice: %v", err)
 	}
@@ -183,7 +184,8 @@ func (c *ChainService) ApplyForkChoiceRule(
 	}
 
 	newState := postState
-	if !isDescendant && !proto.Equal(currentHead, newHead) {
+	reorged := !isDescendant && !proto.Equal(currentHead, newHead)
+	if reorged {
 		log.WithFields(logrus.Fields{
 			"currentSlot": currentHead.Slot - params.BeaconConfig().GenesisSlot,
 			"currentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(currentHeadRoot[:])),
@@ -230,11 +232,112 @@ func (c *ChainService) ApplyForkChoiceRule(
 		"stateSlot": newState.Slot - params.BeaconConfig().GenesisSlot,
 	}).Info("Chain head block and state updated")
 
+	if err := c.recordForkChoiceRun(&forkChoiceRun{
+		justifiedBlock: justifiedHead,
+		headState:      newState,
+		targets:        attestationTargets,
+		head:           newHead,
+	}); err != nil {
+		log.WithError(err).Error("Could not record fork choice run")
+	} else if reorged && c.forkChoiceDumpDir != "" {
+		if err := c.dumpForkChoiceStore(currentHead, currentHeadRoot); err != nil {
+			log.WithError(err).Error("Could not dump fork choice store")
+		}
+	}
+
+	return nil
+}
+
+// blockTreeHead runs the LMD-GHOST fork choice rule on the in-memory block tree
+// rooted at the justified block, instead of scanning the database for the
+// children of every block on the way to the head like lmdGhost.
+func (c *ChainService) blockTreeHead(
+	ctx context.Context,
+	justifiedBlock *pb.BeaconBlock,
+	justifiedState *pb.BeaconState,
+	voteTargets map[uint64]*pb.AttestationTarget,
+) (*pb.BeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.blockTreeHead")
+	defer span.End()
+	tree, err := c.justifiedBlockTree(ctx, justifiedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not build block tree: %v", err)
+	}
+
+	balances := make(map[uint64]uint64, len(voteTargets))
+	for validatorIndex, target := range voteTargets {
+		tree.ProcessAttestation(validatorIndex, bytesutil.ToBytes32(target.BlockRoot))
+		balances[validatorIndex] = helpers.EffectiveBalance(justifiedState, validatorIndex)
+	}
+	headRoot := tree.Head(balances)
+	head, err := c.beaconDB.Block(headRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head block: %v", err)
+	}
+	if head == nil {
+		return nil, fmt.Errorf("head block %#x does not exist in the db", headRoot)
+	}
+	return head, nil
+}
+
+// justifiedBlockTree returns the block tree rooted at the justified block. The
+// tree is pruned when the justified block moves to one of its descendants, and
+// built from the blocks in the database when the justified block is not in it.
+func (c *ChainService) justifiedBlockTree(ctx context.Context, justifiedBlock *pb.BeaconBlock) (*blocktree.Tree, error) {
+	justifiedRoot, err := hashutil.HashBeaconBlock(justifiedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash justified block: %v", err)
+	}
+	c.blockTreeLock.Lock()
+	defer c.blockTreeLock.Unlock()
+	if c.blockTree != nil && c.blockTree.Root() == justifiedRoot {
+		return c.blockTree, nil
+	}
+	if c.blockTree != nil && c.blockTree.Contains(justifiedRoot) {
+		if err := c.blockTree.Prune(justifiedRoot); err != nil {
+			return nil, err
+		}
+		return c.blockTree, nil
+	}
+
+	tree := blocktree.New(justifiedBlock.Slot, justifiedRoot)
+	highestSlot := c.beaconDB.HighestBlockSlot()
+	for slot := justifiedBlock.Slot + 1; slot <= highestSlot; slot++ {
+		blocks, err := c.beaconDB.BlocksBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get block by slot: %v", err)
+		}
+		for _, block := range blocks {
+			root, err := hashutil.HashBeaconBlock(block)
+			if err != nil {
+				return nil, err
+			}
+			tree.InsertBlock(block.Slot, root, bytesutil.ToBytes32(block.ParentRootHash32))
+		}
+	}
+	c.blockTree = tree
+	return tree, nil
+}
+
+// insertBlockTree adds a block which passed the state transition to the block
+// tree, if it has been built already.
+func (c *ChainService) insertBlockTree(block *pb.BeaconBlock) error {
+	c.blockTreeLock.Lock()
+	defer c.blockTreeLock.Unlock()
+	if c.blockTree == nil {
+		return nil
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	c.blockTree.InsertBlock(block.Slot, root, bytesutil.ToBytes32(block.ParentRootHash32))
 	return nil
 }
 
 // lmdGhost applies the Latest Message Driven, Greediest Heaviest Observed Sub-Tree
 // fork-choice rule defined in the Ethereum Serenity specification for the beacon chain.
+// It walks the blocks in the database and serves as the reference for blockTreeHead.
 //
 // Spec pseudocode definition:
 //	def lmd_ghost(store: Store, start_state: BeaconState, start_block: BeaconBlock) -> BeaconBlock:
@@ -392,7 +495,7 @@ func (c *ChainService) AttestationTargets(state *pb.BeaconState) (map[uint64]*pb
 //            for validator_index, target in attestation_targets
 //            if get_ancestor(store, target, block.slot) == block
 //        )
-func VoteCount(block *pb.BeaconBlock, state *pb.BeaconState, targets map[uint64]*pb.AttestationTarget, beaconDB *db.BeaconDB) (int, error) {
+func VoteCount(block *pb.BeaconBlock, state *pb.BeaconState, targets map[uint64]*pb.AttestationTarget, beaconDB db.Database) (int, error) {
 	balances := 0
 	var ancestorRoot []byte
 	var err error
@@ -436,7 +539,7 @@ func VoteCount(block *pb.BeaconBlock, state *pb.BeaconState, targets map[uint64]
 //        return None
 //    else:
 //        return get_ancestor(store, store.get_parent(block), slot)
-func BlockAncestor(targetBlock *pb.AttestationTarget, slot uint64, beaconDB *db.BeaconDB) ([]byte, error) {
+func BlockAncestor(targetBlock *pb.AttestationTarget, slot uint64, beaconDB db.Database) ([]byte, error) {
 	if targetBlock.Slot == slot {
 		return targetBlock.BlockRoot[:], nil
 	}
@@ -461,7 +564,7 @@ func BlockAncestor(targetBlock *pb.AttestationTarget, slot uint64, beaconDB *db.
 
 // cachedAncestor retrieves the cached ancestor target from block ancestor cache,
 // if it's not there it looks up the block tree get it and cache it.
-func cachedAncestor(target *pb.AttestationTarget, height uint64, beaconDB *db.BeaconDB) ([]byte, error) {
+func cachedAncestor(target *pb.AttestationTarget, height uint64, beaconDB db.Database) ([]byte, error) {
 	// check if the ancestor block of from a given block height was cached.
 	cachedAncestorInfo, err := blkAncestorCache.AncestorBySlot(target.BlockRoot, height)
 	if err != nil {
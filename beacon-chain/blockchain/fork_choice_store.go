@@ -0,0 +1,238 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/blocktree"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ForkChoiceStore is a snapshot of the fork choice store as of the last run of
+// the fork choice rule, to debug why a block was chosen as the head.
+type ForkChoiceStore struct {
+	JustifiedEpoch     uint64
+	JustifiedRoot      [32]byte
+	FinalizedEpoch     uint64
+	FinalizedRoot      [32]byte
+	HeadSlot           uint64
+	HeadRoot           [32]byte
+	Nodes              []*blocktree.NodeInfo
+	AttestationTargets map[uint64]*pb.AttestationTarget
+}
+
+// ForkChoiceStoreFetcher defines a struct which can retrieve a snapshot of the
+// fork choice store.
+type ForkChoiceStoreFetcher interface {
+	ForkChoiceStore() (*ForkChoiceStore, error)
+}
+
+// forkChoiceRun keeps the inputs and result of a run of the fork choice rule, with
+// the block tree and the finalized block as they were when the rule ran.
+type forkChoiceRun struct {
+	justifiedBlock *pb.BeaconBlock
+	headState      *pb.BeaconState
+	targets        map[uint64]*pb.AttestationTarget
+	head           *pb.BeaconBlock
+	nodes          []*blocktree.NodeInfo
+	finalizedRoot  [32]byte
+}
+
+func (c *ChainService) recordForkChoiceRun(run *forkChoiceRun) error {
+	c.blockTreeLock.Lock()
+	if c.blockTree != nil {
+		run.nodes = c.blockTree.Nodes()
+	}
+	c.blockTreeLock.Unlock()
+	finalizedBlock, err := c.beaconDB.FinalizedBlock()
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	run.finalizedRoot, err = hashutil.HashBeaconBlock(finalizedBlock)
+	if err != nil {
+		return fmt.Errorf("could not hash finalized block: %v", err)
+	}
+	c.lastForkChoiceLock.Lock()
+	defer c.lastForkChoiceLock.Unlock()
+	c.lastForkChoice = run
+	return nil
+}
+
+// ForkChoiceStore returns a snapshot of the fork choice store as of the last run
+// of the fork choice rule: the blocks since the justified block with their vote
+// weight, the latest attestation target of each validator, the justified and
+// finalized checkpoints and the chosen head.
+func (c *ChainService) ForkChoiceStore() (*ForkChoiceStore, error) {
+	c.lastForkChoiceLock.RLock()
+	run := c.lastForkChoice
+	c.lastForkChoiceLock.RUnlock()
+	if run == nil {
+		return nil, errors.New("fork choice rule has not run yet")
+	}
+
+	justifiedRoot, err := hashutil.HashBeaconBlock(run.justifiedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash justified block: %v", err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(run.head)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash head block: %v", err)
+	}
+	return &ForkChoiceStore{
+		JustifiedEpoch:     run.headState.JustifiedEpoch,
+		JustifiedRoot:      justifiedRoot,
+		FinalizedEpoch:     run.headState.FinalizedEpoch,
+		FinalizedRoot:      run.finalizedRoot,
+		HeadSlot:           run.head.Slot,
+		HeadRoot:           headRoot,
+		Nodes:              run.nodes,
+		AttestationTargets: run.targets,
+	}, nil
+}
+
+// dumpForkChoiceStore writes the fork choice store after a reorg from the given
+// previous head to the fork choice dump directory, both as JSON and as a Graphviz graph.
+func (c *ChainService) dumpForkChoiceStore(previousHead *pb.BeaconBlock, previousHeadRoot [32]byte) error {
+	store, err := c.ForkChoiceStore()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.forkChoiceDumpDir, 0700); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("reorg-slot-%d-%d", store.HeadSlot-params.BeaconConfig().GenesisSlot, time.Now().Unix())
+
+	jsonFile, err := os.Create(path.Join(c.forkChoiceDumpDir, name+".json"))
+	if err != nil {
+		return err
+	}
+	defer jsonFile.Close()
+	if err := writeForkChoiceJSON(jsonFile, store, previousHead.Slot, previousHeadRoot); err != nil {
+		return fmt.Errorf("could not write fork choice store as JSON: %v", err)
+	}
+
+	dotFile, err := os.Create(path.Join(c.forkChoiceDumpDir, name+".dot"))
+	if err != nil {
+		return err
+	}
+	defer dotFile.Close()
+	if err := writeForkChoiceDot(dotFile, store); err != nil {
+		return fmt.Errorf("could not write fork choice store as Graphviz graph: %v", err)
+	}
+
+	log.WithField("path", path.Join(c.forkChoiceDumpDir, name)).Info("Dumped fork choice store after reorg")
+	return nil
+}
+
+// The dumps count slots and epochs from genesis, as the logs do.
+type forkChoiceDump struct {
+	PreviousHeadSlot   uint64                  `json:"previous_head_slot"`
+	PreviousHeadRoot   string                  `json:"previous_head_root"`
+	HeadSlot           uint64                  `json:"head_slot"`
+	HeadRoot           string                  `json:"head_root"`
+	JustifiedEpoch     uint64                  `json:"justified_epoch"`
+	JustifiedRoot      string                  `json:"justified_root"`
+	FinalizedEpoch     uint64                  `json:"finalized_epoch"`
+	FinalizedRoot      string                  `json:"finalized_root"`
+	Nodes              []*forkChoiceDumpNode   `json:"nodes"`
+	AttestationTargets []*forkChoiceDumpTarget `json:"attestation_targets"`
+}
+
+type forkChoiceDumpNode struct {
+	Slot       uint64 `json:"slot"`
+	Root       string `json:"root"`
+	ParentRoot string `json:"parent_root"`
+	Weight     uint64 `json:"weight"`
+}
+
+type forkChoiceDumpTarget struct {
+	ValidatorIndex uint64 `json:"validator_index"`
+	Slot           uint64 `json:"slot"`
+	BlockRoot      string `json:"block_root"`
+}
+
+func writeForkChoiceJSON(w io.Writer, store *ForkChoiceStore, previousHeadSlot uint64, previousHeadRoot [32]byte) error {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	dump := &forkChoiceDump{
+		PreviousHeadSlot: previousHeadSlot - genesisSlot,
+		PreviousHeadRoot: fmt.Sprintf("%#x", previousHeadRoot),
+		HeadSlot:         store.HeadSlot - genesisSlot,
+		HeadRoot:         fmt.Sprintf("%#x", store.HeadRoot),
+		JustifiedEpoch:   store.JustifiedEpoch - genesisEpoch,
+		JustifiedRoot:    fmt.Sprintf("%#x", store.JustifiedRoot),
+		FinalizedEpoch:   store.FinalizedEpoch - genesisEpoch,
+		FinalizedRoot:    fmt.Sprintf("%#x", store.FinalizedRoot),
+	}
+	for _, n := range store.Nodes {
+		dump.Nodes = append(dump.Nodes, &forkChoiceDumpNode{
+			Slot:       n.Slot - genesisSlot,
+			Root:       fmt.Sprintf("%#x", n.Root),
+			ParentRoot: fmt.Sprintf("%#x", n.ParentRoot),
+			Weight:     n.Weight,
+		})
+	}
+	for _, index := range sortedValidatorIndices(store.AttestationTargets) {
+		target := store.AttestationTargets[index]
+		dump.AttestationTargets = append(dump.AttestationTargets, &forkChoiceDumpTarget{
+			ValidatorIndex: index,
+			Slot:           target.Slot - genesisSlot,
+			BlockRoot:      fmt.Sprintf("%#x", target.BlockRoot),
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(dump)
+}
+
+// writeForkChoiceDot writes the block tree as a Graphviz graph, with an edge from
+// every block to its parent. The head is filled and the justified block boxed.
+func writeForkChoiceDot(w io.Writer, store *ForkChoiceStore) error {
+	votes := make(map[[32]byte]int)
+	for _, target := range store.AttestationTargets {
+		votes[bytesutil.ToBytes32(target.BlockRoot)]++
+	}
+	if _, err := fmt.Fprintln(w, "digraph forkchoice {\n  rankdir=RL;"); err != nil {
+		return err
+	}
+	for _, n := range store.Nodes {
+		attrs := ""
+		if n.Root == store.HeadRoot {
+			attrs += ", style=filled, fillcolor=lightblue"
+		}
+		if n.Root == store.JustifiedRoot {
+			attrs += ", shape=box"
+		}
+		if _, err := fmt.Fprintf(w, "  \"%#x\" [label=\"slot %d\\n%#x\\nweight %d\\nvotes %d\"%s];\n",
+			n.Root, n.Slot-params.BeaconConfig().GenesisSlot, bytesutil.Trunc(n.Root[:]), n.Weight, votes[n.Root], attrs); err != nil {
+			return err
+		}
+		if n.Root != store.JustifiedRoot {
+			if _, err := fmt.Fprintf(w, "  \"%#x\" -> \"%#x\";\n", n.Root, n.ParentRoot); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+func sortedValidatorIndices(targets map[uint64]*pb.AttestationTarget) []uint64 {
+	indices := make([]uint64, 0, len(targets))
+	for index := range targets {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices
+}
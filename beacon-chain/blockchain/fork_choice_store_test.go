@@ -0,0 +1,159 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/blocktree"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestForkChoiceStore_NotRunYet(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	if _, err := chainService.ForkChoiceStore(); err == nil {
+		t.Error("Expected error before the fork choice rule ran")
+	}
+}
+
+func TestDumpForkChoiceStore_WritesJSONAndGraph(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := setupBeaconChain(t, beaconDB, nil)
+	chainService.forkChoiceDumpDir = path.Join(os.TempDir(), "forkchoicedump")
+	defer os.RemoveAll(chainService.forkChoiceDumpDir)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	justified := &pb.BeaconBlock{Slot: genesisSlot}
+	justifiedRoot, err := hashutil.HashBeaconBlock(justified)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedBlock(justified); err != nil {
+		t.Fatal(err)
+	}
+	previousHead := &pb.BeaconBlock{Slot: genesisSlot + 1, ParentRootHash32: justifiedRoot[:]}
+	previousHeadRoot, err := hashutil.HashBeaconBlock(previousHead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := &pb.BeaconBlock{Slot: genesisSlot + 2, ParentRootHash32: justifiedRoot[:]}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chainService.blockTree = blocktree.New(justified.Slot, justifiedRoot)
+	chainService.blockTree.InsertBlock(previousHead.Slot, previousHeadRoot, justifiedRoot)
+	chainService.blockTree.InsertBlock(head.Slot, headRoot, justifiedRoot)
+	targets := map[uint64]*pb.AttestationTarget{
+		0: {Slot: head.Slot, BlockRoot: headRoot[:], ParentRoot: head.ParentRootHash32},
+		1: {Slot: head.Slot, BlockRoot: headRoot[:], ParentRoot: head.ParentRootHash32},
+	}
+	for index := range targets {
+		chainService.blockTree.ProcessAttestation(index, headRoot)
+	}
+	chainService.blockTree.Head(map[uint64]uint64{0: 1, 1: 1})
+	if err := chainService.recordForkChoiceRun(&forkChoiceRun{
+		justifiedBlock: justified,
+		headState:      &pb.BeaconState{Slot: head.Slot},
+		targets:        targets,
+		head:           head,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chainService.dumpForkChoiceStore(previousHead, previousHeadRoot); err != nil {
+		t.Fatalf("Could not dump fork choice store: %v", err)
+	}
+	files, err := ioutil.ReadDir(chainService.forkChoiceDumpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("Expected a JSON and a Graphviz file, received %d files", len(files))
+	}
+	for _, f := range files {
+		content, err := ioutil.ReadFile(path.Join(chainService.forkChoiceDumpDir, f.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch path.Ext(f.Name()) {
+		case ".json":
+			dump := &forkChoiceDump{}
+			if err := json.Unmarshal(content, dump); err != nil {
+				t.Fatalf("Could not unmarshal dump: %v", err)
+			}
+			if dump.HeadSlot != 2 || dump.PreviousHeadSlot != 1 || len(dump.Nodes) != 3 || len(dump.AttestationTargets) != 2 {
+				t.Errorf("Unexpected fork choice dump %+v", dump)
+			}
+		case ".dot":
+			if !strings.Contains(string(content), "weight 2") {
+				t.Errorf("Expected the head to carry the weight of both votes, received %s", content)
+			}
+		default:
+			t.Errorf("Unexpected dump file %s", f.Name())
+		}
+	}
+}
+
+func TestForkChoiceStore_SnapshotOfLastRun(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	justified := &pb.BeaconBlock{Slot: genesisSlot}
+	justifiedRoot, err := hashutil.HashBeaconBlock(justified)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedBlock(justified); err != nil {
+		t.Fatal(err)
+	}
+	head := &pb.BeaconBlock{Slot: genesisSlot + 1, ParentRootHash32: justifiedRoot[:]}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainService.blockTree = blocktree.New(justified.Slot, justifiedRoot)
+	chainService.blockTree.InsertBlock(head.Slot, headRoot, justifiedRoot)
+	if err := chainService.recordForkChoiceRun(&forkChoiceRun{
+		justifiedBlock: justified,
+		headState:      &pb.BeaconState{Slot: head.Slot},
+		head:           head,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Blocks and finality after the run must not leak into the snapshot.
+	child := &pb.BeaconBlock{Slot: genesisSlot + 2, ParentRootHash32: headRoot[:]}
+	childRoot, err := hashutil.HashBeaconBlock(child)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainService.blockTree.InsertBlock(child.Slot, childRoot, headRoot)
+	if err := beaconDB.SaveFinalizedBlock(head); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := chainService.ForkChoiceStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Nodes) != 2 {
+		t.Errorf("Wanted 2 nodes in the snapshot, received %d", len(store.Nodes))
+	}
+	if store.FinalizedRoot != justifiedRoot {
+		t.Errorf("Wanted finalized root %#x, received %#x", justifiedRoot, store.FinalizedRoot)
+	}
+}
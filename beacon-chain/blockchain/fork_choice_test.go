@@ -1747,3 +1747,87 @@ func TestVoteCount_CacheEnabledAndHit(t *testing.T) {
 		t.Errorf("Expected total balances 2e9, received %d", count)
 	}
 }
+
+func TestBlockTreeHead_UpdatesIncrementally(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	beaconState := &pb.BeaconState{
+		Slot: 10,
+		ValidatorBalances: []uint64{
+			params.BeaconConfig().MaxDepositAmount,
+			params.BeaconConfig().MaxDepositAmount,
+			params.BeaconConfig().MaxDepositAmount},
+		ValidatorRegistry: []*pb.Validator{{}, {}, {}},
+	}
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	// Construct the following chain:
+	//    /- B2
+	// B1  - B3
+	//    \- B4
+	block1 := &pb.BeaconBlock{Slot: 1, ParentRootHash32: []byte{'A'}}
+	root1, err := hashutil.HashBeaconBlock(block1)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+	blocks := []*pb.BeaconBlock{block1}
+	roots := [][32]byte{root1}
+	for slot := uint64(2); slot <= 4; slot++ {
+		block := &pb.BeaconBlock{Slot: slot, ParentRootHash32: root1[:]}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatalf("Could not hash block: %v", err)
+		}
+		blocks = append(blocks, block)
+		roots = append(roots, root)
+	}
+	for _, block := range blocks {
+		if err := chainService.beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+	}
+
+	target := func(i int) *pb.AttestationTarget {
+		return &pb.AttestationTarget{
+			Slot:       blocks[i].Slot,
+			BlockRoot:  roots[i][:],
+			ParentRoot: blocks[i].ParentRootHash32,
+		}
+	}
+	// Give block 4 the most votes, both fork choice implementations should pick it.
+	voteTargets := map[uint64]*pb.AttestationTarget{0: target(1), 1: target(3), 2: target(3)}
+	head, err := chainService.blockTreeHead(ctx, block1, beaconState, voteTargets)
+	if err != nil {
+		t.Fatalf("Could not run fork choice: %v", err)
+	}
+	ghostHead, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
+	if err != nil {
+		t.Fatalf("Could not run LMD GHOST: %v", err)
+	}
+	if !reflect.DeepEqual(head, blocks[3]) || !reflect.DeepEqual(head, ghostHead) {
+		t.Errorf("Expected head to equal %v, received %v and %v from LMD GHOST", blocks[3], head, ghostHead)
+	}
+
+	// A new child of block 2, which validators now vote for, becomes the head.
+	block5 := &pb.BeaconBlock{Slot: 5, ParentRootHash32: roots[1][:]}
+	root5, err := hashutil.HashBeaconBlock(block5)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+	if err := chainService.beaconDB.SaveBlock(block5); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	if err := chainService.insertBlockTree(block5); err != nil {
+		t.Fatalf("Could not insert block in the block tree: %v", err)
+	}
+	voteTargets[1] = &pb.AttestationTarget{Slot: block5.Slot, BlockRoot: root5[:], ParentRoot: block5.ParentRootHash32}
+	head, err = chainService.blockTreeHead(ctx, block1, beaconState, voteTargets)
+	if err != nil {
+		t.Fatalf("Could not run fork choice: %v", err)
+	}
+	if !reflect.DeepEqual(head, block5) {
+		t.Errorf("Expected head to equal %v, received %v", block5, head)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/blocktree"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
@@ -37,7 +38,7 @@ type ChainFeeds interface {
 type ChainService struct {
 	ctx                  context.Context
 	cancel               context.CancelFunc
-	beaconDB             *db.BeaconDB
+	beaconDB             db.Database
 	web3Service          *powchain.Web3Service
 	attsService          attestation.TargetHandler
 	opsPoolService       operations.OperationFeeds
@@ -51,18 +52,24 @@ type ChainService struct {
 	canonicalBlocksLock  sync.RWMutex
 	receiveBlockLock     sync.Mutex
 	maxRoutines          int64
+	blockTree            *blocktree.Tree
+	blockTreeLock        sync.Mutex
+	lastForkChoice       *forkChoiceRun
+	lastForkChoiceLock   sync.RWMutex
+	forkChoiceDumpDir    string
 }
 
 // Config options for the service.
 type Config struct {
-	BeaconBlockBuf int
-	Web3Service    *powchain.Web3Service
-	AttsService    attestation.TargetHandler
-	BeaconDB       *db.BeaconDB
-	OpsPoolService operations.OperationFeeds
-	DevMode        bool
-	P2p            p2p.Broadcaster
-	MaxRoutines    int64
+	BeaconBlockBuf    int
+	Web3Service       *powchain.Web3Service
+	AttsService       attestation.TargetHandler
+	BeaconDB          db.Database
+	OpsPoolService    operations.OperationFeeds
+	DevMode           bool
+	P2p               p2p.Broadcaster
+	MaxRoutines       int64
+	ForkChoiceDumpDir string
 }
 
 // NewChainService instantiates a new service instance that will
@@ -82,6 +89,7 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		p2p:                  cfg.P2p,
 		canonicalBlocks:      make(map[uint64][]byte),
 		maxRoutines:          cfg.MaxRoutines,
+		forkChoiceDumpDir:    cfg.ForkChoiceDumpDir,
 	}, nil
 }
 
@@ -39,9 +39,11 @@ var _ = ChainFeeds(&ChainService{})
 func init() {
 	logrus.SetLevel(logrus.DebugLevel)
 	logrus.SetOutput(ioutil.Discard)
+	// The blocks built by these tests are not signed by their proposers.
 	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCrosslinks:          true,
-		EnableCheckBlockStateRoot: true,
+		EnableCrosslinks:             true,
+		EnableCheckBlockStateRoot:    true,
+		DisableSignatureVerification: true,
 	})
 }
 
@@ -59,6 +61,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return nil
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return nil
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return nil
+}
+
 type mockClient struct{}
 
 func (m *mockClient) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
@@ -22,7 +22,7 @@ var log = logrus.WithField("prefix", "stategenerator")
 //  Input: slot 6.
 //	Output: resulting state of state transition function after applying block C and D.
 //  	along with skipped slot 4 and 6.
-func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (*pb.BeaconState, error) {
+func GenerateStateFromBlock(ctx context.Context, db db.Database, slot uint64) (*pb.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.stategenerator.GenerateStateFromBlock")
 	defer span.End()
 	fState, err := db.HistoricalStateFromSlot(ctx, slot, [32]byte{})
@@ -82,8 +82,68 @@ func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (
 
 	log.Infof("Recompute state starting last finalized slot %d and ending slot %d",
 		fState.Slot-params.BeaconConfig().GenesisSlot, slot-params.BeaconConfig().GenesisSlot)
-	postState := fState
-	root := fRoot
+	postState, err := replayBlocks(ctx, fState, fRoot, blocks, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Finished recompute state with slot %d and finalized epoch %d",
+		postState.Slot-params.BeaconConfig().GenesisSlot, postState.FinalizedEpoch-params.BeaconConfig().GenesisEpoch)
+
+	return postState, nil
+}
+
+// GenerateStateFromArchive generates the canonical state at the input slot from the
+// closest archived state checkpoint at or before the slot, by replaying the canonical
+// blocks since the checkpoint. This requires the database to be in archive mode.
+func GenerateStateFromArchive(ctx context.Context, db db.Database, slot uint64) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.stategenerator.GenerateStateFromArchive")
+	defer span.End()
+	checkpoint, err := db.ArchivedState(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint.Slot == slot {
+		return checkpoint, nil
+	}
+	if checkpoint.LatestBlock == nil {
+		return nil, fmt.Errorf("latest block in archived state at slot %d is nil",
+			checkpoint.Slot-params.BeaconConfig().GenesisSlot)
+	}
+	checkpointRoot, err := hashutil.HashBeaconBlock(checkpoint.LatestBlock)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get block root %v", err)
+	}
+
+	// Find the most recent canonical block since the checkpoint, skipped slots
+	// after it are processed without a block.
+	var mostRecentBlock *pb.BeaconBlock
+	for s := slot; s > checkpoint.Slot && mostRecentBlock == nil; s-- {
+		mostRecentBlock, err = db.CanonicalBlockBySlot(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var blocks []*pb.BeaconBlock
+	if mostRecentBlock != nil {
+		blocks, err = blocksSinceFinalized(ctx, db, mostRecentBlock, checkpointRoot)
+		if err != nil {
+			return nil, fmt.Errorf("unable to look up block ancestors %v", err)
+		}
+	}
+
+	log.Debugf("Replaying %d blocks from archived state at slot %d to slot %d", len(blocks),
+		checkpoint.Slot-params.BeaconConfig().GenesisSlot, slot-params.BeaconConfig().GenesisSlot)
+	return replayBlocks(ctx, checkpoint, checkpointRoot, blocks, slot)
+}
+
+// replayBlocks runs the state transition from the given state for the blocks,
+// which are ordered from the most recent one, and for the skipped slots up
+// to the input slot.
+func replayBlocks(ctx context.Context, postState *pb.BeaconState, root [32]byte,
+	blocks []*pb.BeaconBlock, slot uint64) (*pb.BeaconState, error) {
+	var err error
 	// this recomputes state up to the last available block.
 	//	ex: 1A - 2B (finalized) - 3C - 4 - 5 - 6C - 7 - 8 (C is the last block).
 	// 	input slot 8, this recomputes state to slot 6.
@@ -93,7 +153,7 @@ func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (
 			continue
 		}
 		// running state transitions for skipped slots.
-		for block.Slot != fState.Slot+1 {
+		for block.Slot != postState.Slot+1 {
 			postState, err = state.ExecuteStateTransition(
 				ctx,
 				postState,
@@ -146,10 +206,6 @@ func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (
 			return nil, fmt.Errorf("could not execute state transition %v", err)
 		}
 	}
-
-	log.Infof("Finished recompute state with slot %d and finalized epoch %d",
-		postState.Slot-params.BeaconConfig().GenesisSlot, postState.FinalizedEpoch-params.BeaconConfig().GenesisEpoch)
-
 	return postState, nil
 }
 
@@ -159,7 +215,7 @@ func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (
 // Ex:
 // 	A -> B(finalized) -> C -> D -> E -> D.
 // 	Input: E, output: [E, D, C, B].
-func blocksSinceFinalized(ctx context.Context, db *db.BeaconDB, block *pb.BeaconBlock,
+func blocksSinceFinalized(ctx context.Context, db db.Database, block *pb.BeaconBlock,
 	finalizedBlockRoot [32]byte) ([]*pb.BeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.stategenerator.blocksSinceFinalized")
 	defer span.End()
@@ -160,3 +160,75 @@ func TestGenerateState_WithNilBlocksOK(t *testing.T) {
 		t.Error("generated and saved states are unequal")
 	}
 }
+
+func TestGenerateStateFromArchive_OK(t *testing.T) {
+	b, err := backend.NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := b.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	beaconDb := b.DB()
+	defer b.Shutdown()
+	defer db.TeardownDB(beaconDb)
+	beaconDb.EnableArchiveMode(1)
+	ctx := context.Background()
+
+	slotLimit := uint64(30)
+
+	// Run the simulated chain for 30 slots, to get a state that we can archive as finalized.
+	for i := uint64(0); i < slotLimit; i++ {
+		if err := b.GenerateBlockAndAdvanceChain(&backend.SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and transition state successfully %v for slot %d", err, b.State().Slot+1)
+		}
+		inMemBlocks := b.InMemoryBlocks()
+		if err := beaconDb.SaveBlock(inMemBlocks[len(inMemBlocks)-1]); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+		if err := beaconDb.UpdateChainHead(ctx, inMemBlocks[len(inMemBlocks)-1], b.State()); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+	}
+
+	if err := beaconDb.SaveFinalizedState(b.State()); err != nil {
+		t.Fatalf("Unable to save finalized state: %v", err)
+	}
+
+	slotsWithNil := uint64(10)
+
+	// Run the chain for 10 slots with nil blocks.
+	for i := uint64(0); i < slotsWithNil; i++ {
+		if err := b.GenerateNilBlockAndAdvanceChain(); err != nil {
+			t.Fatalf("Could not generate block and transition state successfully %v for slot %d", err, b.State().Slot+1)
+		}
+	}
+
+	for i := uint64(0); i < slotLimit-slotsWithNil; i++ {
+		if err := b.GenerateBlockAndAdvanceChain(&backend.SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and transition state successfully %v for slot %d", err, b.State().Slot+1)
+		}
+		inMemBlocks := b.InMemoryBlocks()
+		if err := beaconDb.SaveBlock(inMemBlocks[len(inMemBlocks)-1]); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+		if err := beaconDb.UpdateChainHead(ctx, inMemBlocks[len(inMemBlocks)-1], b.State()); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+	}
+
+	slotToGenerateTill := params.BeaconConfig().GenesisSlot + slotLimit*2
+	newState, err := stategenerator.GenerateStateFromArchive(ctx, beaconDb, slotToGenerateTill)
+	if err != nil {
+		t.Fatalf("Unable to generate new state from archived state %v", err)
+	}
+
+	if !proto.Equal(newState, b.State()) {
+		t.Error("Generated and saved states are unequal")
+	}
+
+	if _, err := stategenerator.GenerateStateFromArchive(ctx, beaconDb, params.BeaconConfig().GenesisSlot); err == nil {
+		t.Error("Expected error when no archived state exists before the requested slot")
+	}
+}
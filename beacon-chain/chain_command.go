@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var log = logrus.WithField("prefix", "main")
+
+// importProgressInterval is how often the progress of a chain import is logged.
+const importProgressInterval = 10 * time.Second
+
+var fromFinalizedFlag = cli.BoolFlag{
+	Name:  "from-finalized",
+	Usage: "Export the canonical chain from the last finalized block instead of genesis",
+}
+
+var exportChainCommand = cli.Command{
+	Name:     "export-chain",
+	Category: "chain",
+	Usage:    "writes the canonical chain of the beacon chain database to a block file",
+	Description: `writes the canonical blocks from genesis, or from the last finalized block, to the chain head
+into a block file, which can be fed to another beacon node with import-chain`,
+	Flags: []cli.Flag{
+		cmd.DataDirFlag,
+		blockFileFlag,
+		fromFinalizedFlag,
+	},
+	Action: exportChain,
+}
+
+var importChainCommand = cli.Command{
+	Name:     "import-chain",
+	Category: "chain",
+	Usage:    "processes the blocks of a block file written by export-chain",
+	Description: `processes the blocks of a block file through the state transition and fork choice rule,
+as if they were received from peers. The beacon chain database must already hold the chain start state
+and blocks it already holds are skipped. The blocks are validated against the proof-of-work chain, so the
+web3 provider and deposit contract flags of the beacon node must be given before the command name`,
+	Flags: []cli.Flag{
+		cmd.DataDirFlag,
+		blockFileFlag,
+	},
+	Action: importChain,
+}
+
+func exportChain(ctx *cli.Context) error {
+	if !ctx.IsSet(blockFileFlag.Name) {
+		return errors.New("the path of the block file is required")
+	}
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	// The genesis block is not exported, it is created by every node at chain start.
+	start := params.BeaconConfig().GenesisSlot + 1
+	if ctx.Bool(fromFinalizedFlag.Name) {
+		finalized, err := beaconDB.FinalizedBlock()
+		if err != nil {
+			return fmt.Errorf("could not retrieve finalized block: %v", err)
+		}
+		start = finalized.Slot + 1
+	}
+	exported, err := writeCanonicalBlocks(beaconDB, ctx.String(blockFileFlag.Name), start, head.Slot)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"blocks":   exported,
+		"headSlot": head.Slot - params.BeaconConfig().GenesisSlot,
+		"file":     ctx.String(blockFileFlag.Name),
+	}).Info("Exported canonical chain")
+	return nil
+}
+
+func importChain(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(blockFileFlag.Name) {
+		return errors.New("the path of the block file is required")
+	}
+	f, err := os.Open(cliCtx.String(blockFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	beaconDB, err := openDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	ctx := context.Background()
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return errors.New("the beacon chain database holds no chain start state")
+	}
+
+	web3Service, err := node.NewWeb3Service(cliCtx, beaconDB)
+	if err != nil {
+		return err
+	}
+	opsService := operations.NewOpsPoolService(ctx, &operations.Config{
+		BeaconDB: beaconDB,
+		P2P:      noopBroadcaster{},
+	})
+	opsService.Start()
+	defer opsService.Stop()
+	chainService, err := blockchain.NewChainService(ctx, &blockchain.Config{
+		BeaconDB:       beaconDB,
+		Web3Service:    web3Service,
+		AttsService:    attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB}),
+		OpsPoolService: opsService,
+		P2p:            noopBroadcaster{},
+		MaxRoutines:    cliCtx.GlobalInt64(cmd.MaxGoroutines.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create chain service: %v", err)
+	}
+	chainService.Start()
+	defer chainService.Stop()
+
+	r := bufio.NewReader(f)
+	imported, skipped := 0, 0
+	start := time.Now()
+	lastReport := start
+	for {
+		block, err := readBlock(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not read block %d: %v", imported+skipped, err)
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return fmt.Errorf("could not hash block: %v", err)
+		}
+		if beaconDB.HasBlock(root) {
+			skipped++
+			continue
+		}
+
+		beaconState, err := chainService.ReceiveBlock(ctx, block)
+		if err != nil {
+			return fmt.Errorf("could not process block with slot %d: %v", block.Slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if err := chainService.ApplyForkChoiceRule(ctx, block, beaconState); err != nil {
+			return fmt.Errorf("could not run fork choice on block with slot %d: %v", block.Slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		imported++
+
+		if time.Since(lastReport) >= importProgressInterval {
+			lastReport = time.Now()
+			log.WithFields(logrus.Fields{
+				"slot":         block.Slot - params.BeaconConfig().GenesisSlot,
+				"imported":     imported,
+				"skipped":      skipped,
+				"blocksPerSec": fmt.Sprintf("%.2f", float64(imported)/time.Since(start).Seconds()),
+			}).Info("Importing chain")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"imported": imported,
+		"skipped":  skipped,
+		"elapsed":  time.Since(start),
+	}).Info("Imported chain")
+	return nil
+}
+
+// noopBroadcaster stands in for the p2p network when blocks are processed offline.
+type noopBroadcaster struct{}
+
+func (noopBroadcaster) Broadcast(_ context.Context, _ proto.Message) {}
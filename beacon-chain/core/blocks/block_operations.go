@@ -15,8 +15,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	"github.com/prysmaticlabs/prysm/shared/bls"
-	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
@@ -27,12 +25,20 @@ import (
 // the correct proposer created an incoming beacon block during state
 // transition processing.
 //
-// WIP - this is stubbed out until BLS is integrated into Prysm.
-func VerifyProposerSignature(
-	_ *pb.BeaconBlock,
-) error {
-
-	return nil
+// Official spec definition for proposer signature verification:
+//   Let block_without_signature_root be the hash_tree_root of block where
+//     block.signature is set to EMPTY_SIGNATURE.
+//   Let proposal_root = hash_tree_root(ProposalSignedData(state.slot,
+//     BEACON_CHAIN_SHARD_NUMBER, block_without_signature_root)).
+//   Verify that bls_verify(pubkey=state.validator_registry[get_beacon_proposer_index(state, state.slot)].pubkey,
+//     message_hash=proposal_root, signature=block.signature,
+//     domain=get_domain(state.fork, get_current_epoch(state), DOMAIN_PROPOSAL)).
+func VerifyProposerSignature(beaconState *pb.BeaconState, block *pb.BeaconBlock) error {
+	set, err := proposerSignatureSet(beaconState, block)
+	if err != nil {
+		return err
+	}
+	return set.verify()
 }
 
 // ProcessEth1DataInBlock is an operation performed on each
@@ -109,18 +115,9 @@ func ProcessBlockRandao(
 // Verify that bls_verify(pubkey=proposer.pubkey, message_hash=hash_tree_root(get_current_epoch(state)),
 //   signature=block.randao_reveal, domain=get_domain(state.fork, get_current_epoch(state), DOMAIN_RANDAO))
 func verifyBlockRandao(beaconState *pb.BeaconState, block *pb.BeaconBlock, proposerIdx uint64, enableLogging bool) error {
-	proposer := beaconState.ValidatorRegistry[proposerIdx]
-	pub, err := bls.PublicKeyFromBytes(proposer.Pubkey)
-	if err != nil {
-		return fmt.Errorf("could not deserialize proposer public key: %v", err)
-	}
-	currentEpoch := helpers.CurrentEpoch(beaconState)
-	buf := make([]byte, 32)
-	binary.LittleEndian.PutUint64(buf, currentEpoch)
-	domain := forkutil.DomainVersion(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao)
-	sig, err := bls.SignatureFromBytes(block.RandaoReveal)
+	set, err := randaoSignatureSet(beaconState, block, proposerIdx)
 	if err != nil {
-		return fmt.Errorf("could not deserialize block randao reveal: %v", err)
+		return err
 	}
 	if enableLogging {
 		log.WithFields(logrus.Fields{
@@ -128,10 +125,7 @@ func verifyBlockRandao(beaconState *pb.BeaconState, block *pb.BeaconBlock, propo
 			"proposerIndex": proposerIdx,
 		}).Info("Verifying randao")
 	}
-	if !sig.Verify(buf, pub, domain) {
-		return fmt.Errorf("block randao reveal signature did not verify")
-	}
-	return nil
+	return set.verify()
 }
 
 // ProcessProposerSlashings is one of the operations performed
@@ -171,7 +165,7 @@ func ProcessProposerSlashings(
 	}
 	var err error
 	for idx, slashing := range body.ProposerSlashings {
-		if err = verifyProposerSlashing(slashing, verifySignatures); err != nil {
+		if err = verifyProposerSlashing(beaconState, slashing, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify proposer slashing #%d: %v", idx, err)
 		}
 		proposer := registry[slashing.ProposerIndex]
@@ -187,6 +181,7 @@ func ProcessProposerSlashings(
 }
 
 func verifyProposerSlashing(
+	beaconState *pb.BeaconState,
 	slashing *pb.ProposerSlashing,
 	verifySignatures bool,
 ) error {
@@ -210,16 +205,40 @@ func verifyProposerSlashing(
 	if shard1 != shard2 {
 		return fmt.Errorf("slashing proposal data shards do not match: %d, %d", shard1, shard2)
 	}
-	if !bytes.Equal(root1, root2) {
-		return fmt.Errorf("slashing proposal data block roots do not match: %#x, %#x", root1, root2)
+	if bytes.Equal(root1, root2) {
+		return fmt.Errorf("slashing proposal data block roots should not match: %#x", root1)
 	}
 	if verifySignatures {
-		// TODO(#258): Verify BLS according to the specification in the "Proposer Slashings"
-		return nil
+		sets, err := proposerSlashingSignatureSets(beaconState, slashing)
+		if err != nil {
+			return err
+		}
+		for _, set := range sets {
+			if err := set.verify(); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// VerifyProposerSlashing checks that a proposer slashing received outside of a
+// block, over p2p for instance, can be processed against the given state
+// without failing the block which includes it.
+func VerifyProposerSlashing(
+	beaconState *pb.BeaconState,
+	slashing *pb.ProposerSlashing,
+	verifySignatures bool,
+) error {
+	if slashing.ProposalData_1 == nil || slashing.ProposalData_2 == nil {
+		return errors.New("proposer slashing is missing proposal data")
+	}
+	if slashing.ProposerIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("proposer index %d is out of range", slashing.ProposerIndex)
+	}
+	return verifyProposerSlashing(beaconState, slashing, verifySignatures)
+}
+
 // ProcessAttesterSlashings is one of the operations performed
 // on each processed beacon block to slash attesters based on
 // Casper FFG slashing conditions if any slashable events occurred.
@@ -255,7 +274,7 @@ func ProcessAttesterSlashings(
 		)
 	}
 	for idx, slashing := range body.AttesterSlashings {
-		if err := verifyAttesterSlashing(slashing, verifySignatures); err != nil {
+		if err := verifyAttesterSlashing(beaconState, slashing, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify attester slashing #%d: %v", idx, err)
 		}
 		slashableIndices, err := attesterSlashableIndices(beaconState, slashing)
@@ -273,7 +292,7 @@ func ProcessAttesterSlashings(
 	return beaconState, nil
 }
 
-func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool) error {
+func verifyAttesterSlashing(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing, verifySignatures bool) error {
 	slashableAttestation1 := slashing.SlashableAttestation_1
 	slashableAttestation2 := slashing.SlashableAttestation_2
 	data1 := slashableAttestation1.Data
@@ -295,15 +314,41 @@ func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool
 	if !(isSameTarget || isSurroundVote(data1, data2)) {
 		return errors.New("attester slashing is not a double vote nor surround vote")
 	}
-	if err := verifySlashableAttestation(slashableAttestation1, verifySignatures); err != nil {
+	if err := verifySlashableAttestation(beaconState, slashableAttestation1, verifySignatures); err != nil {
 		return fmt.Errorf("could not verify attester slashable attestation data 1: %v", err)
 	}
-	if err := verifySlashableAttestation(slashableAttestation2, verifySignatures); err != nil {
+	if err := verifySlashableAttestation(beaconState, slashableAttestation2, verifySignatures); err != nil {
 		return fmt.Errorf("could not verify attester slashable attestation data 2: %v", err)
 	}
 	return nil
 }
 
+// VerifyAttesterSlashing checks that an attester slashing received outside of
+// a block, over p2p for instance, can be processed against the given state
+// without failing the block which includes it, that is it must slash at least
+// one validator.
+func VerifyAttesterSlashing(
+	beaconState *pb.BeaconState,
+	slashing *pb.AttesterSlashing,
+	verifySignatures bool,
+) error {
+	for _, att := range []*pb.SlashableAttestation{slashing.SlashableAttestation_1, slashing.SlashableAttestation_2} {
+		if att == nil || att.Data == nil {
+			return errors.New("attester slashing is missing slashable attestation data")
+		}
+		for _, idx := range att.ValidatorIndices {
+			if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+				return fmt.Errorf("validator index %d is out of range", idx)
+			}
+		}
+	}
+	if err := verifyAttesterSlashing(beaconState, slashing, verifySignatures); err != nil {
+		return err
+	}
+	_, err := attesterSlashableIndices(beaconState, slashing)
+	return err
+}
+
 func attesterSlashableIndices(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing) ([]uint64, error) {
 	slashableAttestation1 := slashing.SlashableAttestation_1
 	slashableAttestation2 := slashing.SlashableAttestation_2
@@ -327,10 +372,11 @@ func attesterSlashableIndices(beaconState *pb.BeaconState, slashing *pb.Attester
 	return slashableIndices, nil
 }
 
-func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures bool) error {
+func verifySlashableAttestation(beaconState *pb.BeaconState, att *pb.SlashableAttestation, verifySignatures bool) error {
+	// Custody bits are only used from phase 1 onwards, until then they must all be 0.
 	emptyCustody := make([]byte, len(att.CustodyBitfield))
-	if bytes.Equal(att.CustodyBitfield, emptyCustody) {
-		return errors.New("custody bit field can't all be 0s")
+	if !bytes.Equal(att.CustodyBitfield, emptyCustody) {
+		return errors.New("custody bit field must be all 0s")
 	}
 	if len(att.ValidatorIndices) == 0 {
 		return errors.New("empty validator indices")
@@ -355,8 +401,11 @@ func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures b
 	}
 
 	if verifySignatures {
-		// TODO(#258): Implement BLS verify multiple.
-		return nil
+		set, err := slashableAttestationSignatureSet(beaconState, att)
+		if err != nil {
+			return err
+		}
+		return set.verify()
 	}
 	return nil
 }
@@ -517,24 +566,34 @@ func VerifyAttestation(beaconState *pb.BeaconState, att *pb.Attestation, verifyS
 		)
 	}
 	if verifySignatures {
-		// TODO(#258): Integrate BLS signature verification for attestation.
-		// assert bls_verify_multiple(
-		//   pubkeys=[
-		//	 bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
-		//   bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
-		//   ],
-		//   message_hash=[
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
-		//   ],
-		//   signature=attestation.aggregate_signature,
-		//   domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
-		// )
-		return nil
+		return VerifyAttestationSignature(beaconState, att)
 	}
 	return nil
 }
 
+// VerifyAttestationSignature verifies the aggregate signature of the attestation
+// participants. Custody bits are all 0 in phase 0, so only the first message
+// of the spec definition is signed:
+//   assert bls_verify_multiple(
+//     pubkeys=[
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
+//     ],
+//     message_hash=[
+//       hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
+//       hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
+//     ],
+//     signature=attestation.aggregate_signature,
+//     domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
+//   )
+func VerifyAttestationSignature(beaconState *pb.BeaconState, att *pb.Attestation) error {
+	set, err := attestationSignatureSet(beaconState, att)
+	if err != nil {
+		return err
+	}
+	return set.verify()
+}
+
 // ProcessValidatorDeposits is one of the operations performed on each processed
 // beacon block to verify queued validators from the Ethereum 1.0 Deposit Contract
 // into the beacon chain.
@@ -685,6 +744,15 @@ func ProcessValidatorExits(
 	return beaconState, nil
 }
 
+// VerifyExit checks that a voluntary exit submitted outside of a block can be
+// processed against the given state without failing the block which includes it.
+func VerifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
+	if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("validator index %d is out of range", exit.ValidatorIndex)
+	}
+	return verifyExit(beaconState, exit, verifySignatures)
+}
+
 func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
 	validator := beaconState.ValidatorRegistry[exit.ValidatorIndex]
 	currentEpoch := helpers.CurrentEpoch(beaconState)
@@ -704,13 +772,16 @@ func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySigna
 		)
 	}
 	if verifySignatures {
-		// TODO(#258): Verify using BLS signature verification below:
 		// Let exit_message = hash_tree_root(
 		//   Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
 		// )
 		// Verify that bls_verify(pubkey=validator.pubkey, message_hash=exit_message,
 		//   signature=exit.signature, domain=get_domain(state.fork, exit.epoch, DOMAIN_EXIT)).
-		return nil
+		set, err := exitSignatureSet(beaconState, exit)
+		if err != nil {
+			return err
+		}
+		return set.verify()
 	}
 	return nil
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -31,7 +32,7 @@ func init() {
 	})
 }
 
-func setupInitialDeposits(t *testing.T, numDeposits int) ([]*pb.Deposit, []*bls.SecretKey) {
+func setupInitialDeposits(t testing.TB, numDeposits int) ([]*pb.Deposit, []*bls.SecretKey) {
 	privKeys := make([]*bls.SecretKey, numDeposits)
 	deposits := make([]*pb.Deposit, numDeposits)
 	for i := 0; i < len(deposits); i++ {
@@ -129,6 +130,38 @@ func TestProcessBlockRandao_SignatureVerifiesAndUpdatesLatestStateMixes(t *testi
 	}
 }
 
+func TestVerifyProposerSignature_VerifiesProposer(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pb.BeaconBlock{
+		Slot:            beaconState.Slot,
+		StateRootHash32: []byte{'A'},
+	}
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.CurrentEpoch(beaconState), params.BeaconConfig().DomainProposal)
+
+	block.Signature = privKeys[proposerIdx+1].Sign(proposalRoot[:], domain).Marshal()
+	want := "block signature did not verify"
+	if err := blocks.VerifyProposerSignature(beaconState, block); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+
+	block.Signature = privKeys[proposerIdx].Sign(proposalRoot[:], domain).Marshal()
+	if err := blocks.VerifyProposerSignature(beaconState, block); err != nil {
+		t.Errorf("Expected signature of the proposer to verify: %v", err)
+	}
+}
+
 func TestProcessEth1Data_SameRootHash(t *testing.T) {
 	beaconState := &pb.BeaconState{
 		Eth1DataVotes: []*pb.Eth1DataVote{
@@ -296,7 +329,7 @@ func TestProcessProposerSlashings_UnmatchedShards(t *testing.T) {
 	}
 }
 
-func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
+func TestProcessProposerSlashings_MatchingBlockRoots(t *testing.T) {
 	registry := []*pb.Validator{}
 	currentSlot := uint64(0)
 	slashings := []*pb.ProposerSlashing{
@@ -310,7 +343,7 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           0,
-				BlockRootHash32: []byte{1, 1, 0},
+				BlockRootHash32: []byte{0, 1, 0},
 			},
 		},
 	}
@@ -325,8 +358,8 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 		},
 	}
 	want := fmt.Sprintf(
-		"slashing proposal data block roots do not match: %#x, %#x",
-		[]byte{0, 1, 0}, []byte{1, 1, 0},
+		"slashing proposal data block roots should not match: %#x",
+		[]byte{0, 1, 0},
 	)
 
 	if _, err := blocks.ProcessProposerSlashings(
@@ -366,7 +399,7 @@ func TestProcessProposerSlashings_AppliesCorrectStatus(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            params.BeaconConfig().GenesisSlot + 1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -428,7 +461,7 @@ func TestProcessAttesterSlashings_ThresholdReached(t *testing.T) {
 	}
 }
 
-func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
+func TestProcessAttesterSlashings_NonEmptyCustodyFields(t *testing.T) {
 	slashings := []*pb.AttesterSlashing{
 		{
 			SlashableAttestation_1: &pb.SlashableAttestation{
@@ -436,20 +469,16 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 					Slot:  5,
 					Shard: 4,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x80},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data: &pb.AttestationData{
 					Slot:  5,
 					Shard: 3,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -465,55 +494,24 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 			AttesterSlashings: slashings,
 		},
 	}
-	want := fmt.Sprint("custody bit field can't all be 0")
+	want := fmt.Sprint("custody bit field must be all 0s")
 
 	if _, err := blocks.ProcessAttesterSlashings(
 		beaconState,
 		block,
 		false,
-	); !strings.Contains(err.Error(), want) {
+	); err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 
-	// Perform the same check for SlashableVoteData_2.
-	slashings = []*pb.AttesterSlashing{
-		{
-			SlashableAttestation_1: &pb.SlashableAttestation{
-				Data: &pb.AttestationData{
-					Slot:  5,
-					Shard: 4,
-				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
-			},
-			SlashableAttestation_2: &pb.SlashableAttestation{
-				Data: &pb.AttestationData{
-					Slot:  5,
-					Shard: 3,
-				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
-			},
-		},
-	}
-	beaconState = &pb.BeaconState{
-		ValidatorRegistry: registry,
-		Slot:              currentSlot,
-	}
-	block = &pb.BeaconBlock{
-		Body: &pb.BeaconBlockBody{
-			AttesterSlashings: slashings,
-		},
-	}
+	// Perform the same check for SlashableAttestation_2.
+	slashings[0].SlashableAttestation_1.CustodyBitfield = []byte{0x00}
+	slashings[0].SlashableAttestation_2.CustodyBitfield = []byte{0x80}
 	if _, err := blocks.ProcessAttesterSlashings(
 		beaconState,
 		block,
 		false,
-	); !strings.Contains(err.Error(), want) {
+	); err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 }
@@ -527,12 +525,12 @@ func TestProcessAttesterSlashings_UnmatchedAttestations(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{2},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -577,12 +575,12 @@ func TestProcessAttesterSlashings_EmptyVoteIndexIntersection(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{9, 10, 11, 12, 13, 14, 15, 16},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -638,12 +636,12 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -684,6 +682,69 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 	}
 }
 
+func TestVerifyProposerSlashing_IndexOutOfRange(t *testing.T) {
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: make([]*pb.Validator, 2),
+	}
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:  2,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{1}},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{2}},
+	}
+	want := "proposer index 2 is out of range"
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing, false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	slashing.ProposerIndex = 1
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing, false); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	slashing.ProposalData_2 = nil
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing, false); err == nil {
+		t.Error("Expected slashing without proposal data to fail verification")
+	}
+}
+
+func TestVerifyAttesterSlashing_RequiresSlashableIndex(t *testing.T) {
+	validators := make([]*pb.Validator, 4)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			SlashedEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: validators,
+		Slot:              params.BeaconConfig().GenesisSlot,
+	}
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 5},
+			ValidatorIndices: []uint64{0, 1},
+			CustodyBitfield:  []byte{0x00},
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: 4},
+			ValidatorIndices: []uint64{1, 4},
+			CustodyBitfield:  []byte{0x00},
+		},
+	}
+	want := "validator index 4 is out of range"
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing, false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+
+	slashing.SlashableAttestation_2.ValidatorIndices = []uint64{1, 2}
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing, false); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	validators[1].SlashedEpoch = params.BeaconConfig().GenesisEpoch
+	want = "expected a non-empty list"
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing, false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
 func TestProcessBlockAttestations_ThresholdReached(t *testing.T) {
 	attestations := make([]*pb.Attestation, params.BeaconConfig().MaxAttestations+1)
 	block := &pb.BeaconBlock{
@@ -1615,6 +1676,27 @@ func TestProcessValidatorExits_InvalidExitEpoch(t *testing.T) {
 	}
 }
 
+func TestVerifyExit_IndexOutOfRange(t *testing.T) {
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		},
+		Slot: params.BeaconConfig().GenesisSlot,
+	}
+	exit := &pb.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 1,
+	}
+	want := "validator index 1 is out of range"
+	if err := blocks.VerifyExit(beaconState, exit, false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	exit.ValidatorIndex = 0
+	if err := blocks.VerifyExit(beaconState, exit, false); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
 func TestProcessValidatorExits_InvalidStatusChangeSlot(t *testing.T) {
 	exits := []*pb.VoluntaryExit{
 		{
@@ -1678,3 +1760,52 @@ func TestProcessValidatorExits_AppliesCorrectStatus(t *testing.T) {
 		t.Error("Expected validator status to change, remained INITIAL")
 	}
 }
+
+func TestVerifyAttestation_VerifiesAggregateSignature(t *testing.T) {
+	// Enough validators for committees of more than 2 members.
+	deposits, privKeys := setupInitialDeposits(t, 8*int(params.BeaconConfig().SlotsPerEpoch))
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot += params.BeaconConfig().MinAttestationInclusionDelay
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, params.BeaconConfig().GenesisSlot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0].Committee
+	att := &pb.Attestation{
+		Data: &pb.AttestationData{
+			Slot:                     params.BeaconConfig().GenesisSlot,
+			Shard:                    committees[0].Shard,
+			JustifiedEpoch:           beaconState.JustifiedEpoch,
+			JustifiedBlockRootHash32: beaconState.JustifiedRoot,
+			LatestCrosslink:          beaconState.LatestCrosslinks[committees[0].Shard],
+			CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
+		},
+		AggregationBitfield: make([]byte, (len(committee)+7)/8),
+		CustodyBitfield:     make([]byte, (len(committee)+7)/8),
+	}
+	dataRoot, err := hashutil.HashAttestationData(att.Data, false /* custodyBit */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+	// The first two members of the committee attest.
+	var sigs []*bls.Signature
+	for i, idx := range committee[:2] {
+		att.AggregationBitfield[i/8] |= 1 << (7 - uint(i%8))
+		sigs = append(sigs, privKeys[idx].Sign(dataRoot[:], domain))
+	}
+	att.AggregateSignature = bls.AggregateSignatures(sigs).Marshal()
+	if err := blocks.VerifyAttestation(beaconState, att, true /* verify signatures */); err != nil {
+		t.Errorf("Expected aggregate signature to verify: %v", err)
+	}
+
+	// The signature does not cover a participant which did not sign.
+	att.AggregationBitfield[0] |= 1 << 5
+	want := "attestation aggregate signature did not verify"
+	if err := blocks.VerifyAttestation(beaconState, att, true /* verify signatures */); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
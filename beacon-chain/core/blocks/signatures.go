@@ -0,0 +1,276 @@
+package blocks
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// signatureSet is a signature along with the public keys and message it must
+// verify against. The description names the signature in verification errors.
+type signatureSet struct {
+	sig         *bls.Signature
+	pubKeys     []*bls.PublicKey
+	msg         []byte
+	domain      uint64
+	description string
+}
+
+// verify checks the signature of the set on its own.
+func (s *signatureSet) verify() error {
+	var ok bool
+	if len(s.pubKeys) == 1 {
+		ok = s.sig.Verify(s.msg, s.pubKeys[0], s.domain)
+	} else {
+		ok = s.sig.VerifyAggregate(s.pubKeys, s.msg, s.domain)
+	}
+	if !ok {
+		return fmt.Errorf("%s signature did not verify", s.description)
+	}
+	return nil
+}
+
+// BlockSignatureBatch collects every signature of the block into a batch which
+// verifies them together: the proposer signature, the randao reveal, the
+// signatures of proposer and attester slashings, the aggregate signatures of
+// attestations and the signatures of voluntary exits. Operations processing does
+// not change validator public keys, committees or the fork, so every signature
+// is checked against the state the block is applied to.
+func BlockSignatureBatch(beaconState *pb.BeaconState, block *pb.BeaconBlock) (*bls.SignatureBatch, error) {
+	var sets []*signatureSet
+	proposerSet, err := proposerSignatureSet(beaconState, block)
+	if err != nil {
+		return nil, err
+	}
+	sets = append(sets, proposerSet)
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon proposer index: %v", err)
+	}
+	randaoSet, err := randaoSignatureSet(beaconState, block, proposerIdx)
+	if err != nil {
+		return nil, err
+	}
+	sets = append(sets, randaoSet)
+
+	for idx, slashing := range block.Body.ProposerSlashings {
+		if slashing.ProposalData_1 == nil || slashing.ProposalData_2 == nil {
+			return nil, fmt.Errorf("proposer slashing #%d is missing proposal data", idx)
+		}
+		slashingSets, err := proposerSlashingSignatureSets(beaconState, slashing)
+		if err != nil {
+			return nil, fmt.Errorf("proposer slashing #%d: %v", idx, err)
+		}
+		sets = append(sets, slashingSets...)
+	}
+	for idx, slashing := range block.Body.AttesterSlashings {
+		for _, att := range []*pb.SlashableAttestation{slashing.SlashableAttestation_1, slashing.SlashableAttestation_2} {
+			if att == nil || att.Data == nil {
+				return nil, fmt.Errorf("attester slashing #%d is missing slashable attestation data", idx)
+			}
+			set, err := slashableAttestationSignatureSet(beaconState, att)
+			if err != nil {
+				return nil, fmt.Errorf("attester slashing #%d: %v", idx, err)
+			}
+			sets = append(sets, set)
+		}
+	}
+	for idx, att := range block.Body.Attestations {
+		if att.Data == nil {
+			return nil, fmt.Errorf("attestation #%d is missing attestation data", idx)
+		}
+		set, err := attestationSignatureSet(beaconState, att)
+		if err != nil {
+			return nil, fmt.Errorf("attestation #%d: %v", idx, err)
+		}
+		set.description = fmt.Sprintf("attestation #%d aggregate", idx)
+		sets = append(sets, set)
+	}
+	for idx, exit := range block.Body.VoluntaryExits {
+		set, err := exitSignatureSet(beaconState, exit)
+		if err != nil {
+			return nil, fmt.Errorf("exit #%d: %v", idx, err)
+		}
+		set.description = fmt.Sprintf("exit #%d", idx)
+		sets = append(sets, set)
+	}
+
+	batch := bls.NewSignatureBatch()
+	for _, set := range sets {
+		batch.Add(set.sig, set.pubKeys, set.msg, set.domain, set.description)
+	}
+	return batch, nil
+}
+
+// proposerSignatureSet is the signature of the block by the proposer of the
+// state's slot over the block's proposal signed data.
+func proposerSignatureSet(beaconState *pb.BeaconState, block *pb.BeaconBlock) (*signatureSet, error) {
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon proposer index: %v", err)
+	}
+	pub, err := validatorPubKey(beaconState, proposerIdx)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(block.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize block signature: %v", err)
+	}
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash proposal: %v", err)
+	}
+	return &signatureSet{
+		sig:         sig,
+		pubKeys:     []*bls.PublicKey{pub},
+		msg:         proposalRoot[:],
+		domain:      forkutil.DomainVersion(beaconState.Fork, helpers.CurrentEpoch(beaconState), params.BeaconConfig().DomainProposal),
+		description: "block",
+	}, nil
+}
+
+// randaoSignatureSet is the signature of the current epoch by the proposer which
+// makes up the block's randao reveal.
+func randaoSignatureSet(beaconState *pb.BeaconState, block *pb.BeaconBlock, proposerIdx uint64) (*signatureSet, error) {
+	pub, err := validatorPubKey(beaconState, proposerIdx)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(block.RandaoReveal)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize block randao reveal: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, currentEpoch)
+	return &signatureSet{
+		sig:         sig,
+		pubKeys:     []*bls.PublicKey{pub},
+		msg:         buf,
+		domain:      forkutil.DomainVersion(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao),
+		description: "block randao reveal",
+	}, nil
+}
+
+// proposerSlashingSignatureSets are the signatures of both proposals of the
+// slashed proposer.
+func proposerSlashingSignatureSets(beaconState *pb.BeaconState, slashing *pb.ProposerSlashing) ([]*signatureSet, error) {
+	pub, err := validatorPubKey(beaconState, slashing.ProposerIndex)
+	if err != nil {
+		return nil, err
+	}
+	var sets []*signatureSet
+	proposals := []*pb.ProposalSignedData{slashing.ProposalData_1, slashing.ProposalData_2}
+	signatures := [][]byte{slashing.ProposalSignature_1, slashing.ProposalSignature_2}
+	for i, data := range proposals {
+		sig, err := bls.SignatureFromBytes(signatures[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not deserialize proposal signature %d: %v", i+1, err)
+		}
+		root, err := hashutil.HashProto(data)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash proposal data %d: %v", i+1, err)
+		}
+		sets = append(sets, &signatureSet{
+			sig:         sig,
+			pubKeys:     []*bls.PublicKey{pub},
+			msg:         root[:],
+			domain:      forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainProposal),
+			description: fmt.Sprintf("proposer slashing proposal %d", i+1),
+		})
+	}
+	return sets, nil
+}
+
+// slashableAttestationSignatureSet is the aggregate signature of the validators
+// of the slashable attestation. Custody bits are all 0 in phase 0, so every
+// validator signed the attestation data with a 0 custody bit.
+func slashableAttestationSignatureSet(beaconState *pb.BeaconState, att *pb.SlashableAttestation) (*signatureSet, error) {
+	return aggregateSignatureSet(beaconState, att.Data, att.ValidatorIndices, att.AggregateSignature, "slashable attestation aggregate")
+}
+
+// attestationSignatureSet is the aggregate signature of the attestation
+// participants, see VerifyAttestationSignature.
+func attestationSignatureSet(beaconState *pb.BeaconState, att *pb.Attestation) (*signatureSet, error) {
+	participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	return aggregateSignatureSet(beaconState, att.Data, participants, att.AggregateSignature, "attestation aggregate")
+}
+
+func aggregateSignatureSet(
+	beaconState *pb.BeaconState,
+	data *pb.AttestationData,
+	indices []uint64,
+	signature []byte,
+	description string,
+) (*signatureSet, error) {
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("%s has no participants", description)
+	}
+	pubKeys := make([]*bls.PublicKey, len(indices))
+	for i, idx := range indices {
+		pub, err := validatorPubKey(beaconState, idx)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys[i] = pub
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize aggregate signature: %v", err)
+	}
+	dataRoot, err := hashutil.HashAttestationData(data, false /* custodyBit */)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	return &signatureSet{
+		sig:         sig,
+		pubKeys:     pubKeys,
+		msg:         dataRoot[:],
+		domain:      forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation),
+		description: description,
+	}, nil
+}
+
+// exitSignatureSet is the signature of the exit by the exiting validator.
+func exitSignatureSet(beaconState *pb.BeaconState, exit *pb.VoluntaryExit) (*signatureSet, error) {
+	pub, err := validatorPubKey(beaconState, exit.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(exit.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize exit signature: %v", err)
+	}
+	exitRoot, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	return &signatureSet{
+		sig:         sig,
+		pubKeys:     []*bls.PublicKey{pub},
+		msg:         exitRoot[:],
+		domain:      forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit),
+		description: "exit",
+	}, nil
+}
+
+func validatorPubKey(beaconState *pb.BeaconState, idx uint64) (*bls.PublicKey, error) {
+	if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+		return nil, fmt.Errorf("validator index %d is out of range", idx)
+	}
+	pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[idx].Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize public key of validator %d: %v", idx, err)
+	}
+	return pub, nil
+}
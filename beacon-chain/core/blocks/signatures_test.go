@@ -0,0 +1,170 @@
+package blocks_test
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// signedBlock returns a state along with a block for its slot, signed by the
+// proposer, carrying an attestation for every committee of the genesis slot and
+// an exit of the validator at exitIdx.
+func signedBlock(tb testing.TB, exitIdx uint64) (*pb.BeaconState, *pb.BeaconBlock) {
+	deposits, privKeys := setupInitialDeposits(tb, 8*int(params.BeaconConfig().SlotsPerEpoch))
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	beaconState.Slot += params.BeaconConfig().MinAttestationInclusionDelay
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	block := &pb.BeaconBlock{
+		Slot:            beaconState.Slot,
+		StateRootHash32: []byte{'A'},
+		Body:            &pb.BeaconBlockBody{},
+	}
+
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, params.BeaconConfig().GenesisSlot, false)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	for _, committee := range committees {
+		att := &pb.Attestation{
+			Data: &pb.AttestationData{
+				Slot:                     params.BeaconConfig().GenesisSlot,
+				Shard:                    committee.Shard,
+				JustifiedEpoch:           beaconState.JustifiedEpoch,
+				JustifiedBlockRootHash32: beaconState.JustifiedRoot,
+				LatestCrosslink:          beaconState.LatestCrosslinks[committee.Shard],
+				CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
+			},
+			AggregationBitfield: make([]byte, (len(committee.Committee)+7)/8),
+			CustodyBitfield:     make([]byte, (len(committee.Committee)+7)/8),
+		}
+		dataRoot, err := hashutil.HashAttestationData(att.Data, false /* custodyBit */)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+		var sigs []*bls.Signature
+		for i, idx := range committee.Committee {
+			att.AggregationBitfield[i/8] |= 1 << (7 - uint(i%8))
+			sigs = append(sigs, privKeys[idx].Sign(dataRoot[:], domain))
+		}
+		att.AggregateSignature = bls.AggregateSignatures(sigs).Marshal()
+		block.Body.Attestations = append(block.Body.Attestations, att)
+	}
+
+	exit := &pb.VoluntaryExit{
+		Epoch:          currentEpoch,
+		ValidatorIndex: exitIdx,
+	}
+	exitRoot, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	exitDomain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = privKeys[exitIdx].Sign(exitRoot[:], exitDomain).Marshal()
+	block.Body.VoluntaryExits = []*pb.VoluntaryExit{exit}
+
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, currentEpoch)
+	randaoDomain := forkutil.DomainVersion(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao)
+	block.RandaoReveal = privKeys[proposerIdx].Sign(buf, randaoDomain).Marshal()
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	proposalDomain := forkutil.DomainVersion(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainProposal)
+	block.Signature = privKeys[proposerIdx].Sign(proposalRoot[:], proposalDomain).Marshal()
+	return beaconState, block
+}
+
+func TestBlockSignatureBatch_VerifiesBlock(t *testing.T) {
+	beaconState, block := signedBlock(t, 3)
+	batch, err := blocks.BlockSignatureBatch(beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The block signature, randao reveal, attestations and exit.
+	if want := 2 + len(block.Body.Attestations) + 1; batch.Len() != want {
+		t.Errorf("Expected %d signatures in the batch, received %d", want, batch.Len())
+	}
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Expected block signatures to verify: %v", err)
+	}
+}
+
+func TestBlockSignatureBatch_PinpointsInvalidSignature(t *testing.T) {
+	beaconState, block := signedBlock(t, 3)
+	// The exit is signed by another validator than the exiting one.
+	block.Body.VoluntaryExits[0].ValidatorIndex = 4
+	batch, err := blocks.BlockSignatureBatch(beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "exit #0 signature did not verify"
+	if err := batch.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+
+	beaconState, block = signedBlock(t, 3)
+	// The first member of the committee no longer participates but its
+	// signature is still part of the aggregate.
+	block.Body.Attestations[0].AggregationBitfield[0] ^= 1 << 7
+	batch, err = blocks.BlockSignatureBatch(beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "attestation #0 aggregate signature did not verify"
+	if err := batch.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func BenchmarkBlockSignatures_Individually(b *testing.B) {
+	beaconState, block := signedBlock(b, 3)
+	block.Body.VoluntaryExits = nil
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := blocks.VerifyProposerSignature(beaconState, block); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := blocks.ProcessBlockRandao(beaconState, block, true /* verify signatures */, false); err != nil {
+			b.Fatal(err)
+		}
+		for _, att := range block.Body.Attestations {
+			if err := blocks.VerifyAttestation(beaconState, att, true /* verify signatures */); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkBlockSignatures_Batch(b *testing.B) {
+	beaconState, block := signedBlock(b, 3)
+	// Exits can only be verified individually by processing them.
+	block.Body.VoluntaryExits = nil
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch, err := blocks.BlockSignatureBatch(beaconState, block)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := batch.Verify(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
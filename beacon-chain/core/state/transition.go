@@ -133,36 +133,44 @@ func ProcessBlock(
 		)
 	}
 
-	// Verify block signature.
+	// Verify every signature of the block at once, the block signature, randao
+	// reveal and the signatures of its operations. The operations below are then
+	// processed without checking their signatures again.
 	if config.VerifySignatures {
-		// TODO(#781): Verify Proposer Signature.
-		if err := b.VerifyProposerSignature(block); err != nil {
-			return nil, fmt.Errorf("could not verify proposer signature: %v", err)
+		batch, err := b.BlockSignatureBatch(state, block)
+		if err != nil {
+			return nil, fmt.Errorf("could not collect block signatures: %v", err)
+		}
+		if err := batch.Verify(); err != nil {
+			return nil, fmt.Errorf("could not verify block signatures: %v", err)
+		}
+		if config.Logging {
+			log.WithField("signatures", batch.Len()).Debug("Verified block signatures")
 		}
 	}
 
 	// Save latest block.
 	state.LatestBlock = block
 
-	// Verify block RANDAO.
-	state, err = b.ProcessBlockRandao(state, block, config.VerifySignatures, config.Logging)
+	// Process block RANDAO.
+	state, err = b.ProcessBlockRandao(state, block, false /* verifySignatures */, config.Logging)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify and process block randao: %v", err)
 	}
 
 	// Process ETH1 data.
 	state = b.ProcessEth1DataInBlock(state, block)
-	state, err = b.ProcessAttesterSlashings(state, block, config.VerifySignatures)
+	state, err = b.ProcessAttesterSlashings(state, block, false /* verifySignatures */)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify block attester slashings: %v", err)
 	}
 
-	state, err = b.ProcessProposerSlashings(state, block, config.VerifySignatures)
+	state, err = b.ProcessProposerSlashings(state, block, false /* verifySignatures */)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify block proposer slashings: %v", err)
 	}
 
-	state, err = b.ProcessBlockAttestations(state, block, config.VerifySignatures)
+	state, err = b.ProcessBlockAttestations(state, block, false /* verifySignatures */)
 	if err != nil {
 		return nil, fmt.Errorf("could not process block attestations: %v", err)
 	}
@@ -171,7 +179,7 @@ func ProcessBlock(
 	if err != nil {
 		return nil, fmt.Errorf("could not process block validator deposits: %v", err)
 	}
-	state, err = b.ProcessValidatorExits(state, block, config.VerifySignatures)
+	state, err = b.ProcessValidatorExits(state, block, false /* verifySignatures */)
 	if err != nil {
 		return nil, fmt.Errorf("could not process validator exits: %v", err)
 	}
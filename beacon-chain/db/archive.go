@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// EnableArchiveMode makes the database keep a finalized state as a checkpoint every
+// given number of epochs, which are never pruned. Together with the canonical blocks,
+// the checkpoints allow regenerating the state at any slot.
+func (db *BeaconDB) EnableArchiveMode(checkpointEpochs uint64) {
+	if checkpointEpochs == 0 {
+		checkpointEpochs = 1
+	}
+	db.archiveCheckpointEpochs = checkpointEpochs
+}
+
+// ArchiveMode returns true if the database keeps archived state checkpoints.
+func (db *BeaconDB) ArchiveMode() bool {
+	return db.archiveCheckpointEpochs > 0
+}
+
+// ArchivedState retrieves the archived state checkpoint with the highest slot
+// smaller than or equal to the input slot.
+func (db *BeaconDB) ArchivedState(ctx context.Context, slot uint64) (*pb.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ArchivedState")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(slot-params.BeaconConfig().GenesisSlot)))
+
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx kvTx) error {
+		_, enc := closestArchivedState(tx.Bucket(archivedStateBucket).Cursor(), slot)
+		if enc == nil {
+			return fmt.Errorf("no archived state saved at or before slot %d", slot-params.BeaconConfig().GenesisSlot)
+		}
+		var err error
+		beaconState, err = createState(enc)
+		return err
+	})
+	return beaconState, err
+}
+
+// saveArchivedState saves the finalized state as a checkpoint if archive mode is
+// enabled and there is no checkpoint for its checkpoint interval yet.
+func (db *BeaconDB) saveArchivedState(tx kvTx, beaconState *pb.BeaconState) error {
+	if !db.ArchiveMode() {
+		return nil
+	}
+	archivedStates := tx.Bucket(archivedStateBucket)
+	interval := helpers.SlotToEpoch(beaconState.Slot) / db.archiveCheckpointEpochs
+	k, _ := closestArchivedState(archivedStates.Cursor(), beaconState.Slot)
+	if k != nil && helpers.SlotToEpoch(decodeToSlotNumber(k))/db.archiveCheckpointEpochs == interval {
+		return nil
+	}
+	enc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return err
+	}
+	return archivedStates.Put(encodeSlotNumber(beaconState.Slot), enc)
+}
+
+// closestArchivedState returns the key and encoding of the archived state with the
+// highest slot smaller than or equal to the input slot, or nil if there is none.
+func closestArchivedState(c kvCursor, slot uint64) ([]byte, []byte) {
+	k, v := c.Seek(encodeSlotNumber(slot))
+	if k != nil && decodeToSlotNumber(k) == slot {
+		return k, v
+	}
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+	if k == nil || decodeToSlotNumber(k) > slot {
+		return nil, nil
+	}
+	return k, v
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestArchivedState_DisabledByDefault(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.SaveFinalizedState(&pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot}); err != nil {
+		t.Fatalf("could not save finalized state: %v", err)
+	}
+	if _, err := db.ArchivedState(context.Background(), params.BeaconConfig().GenesisSlot); err == nil {
+		t.Error("Expected no archived state without archive mode")
+	}
+}
+
+func TestArchivedState_CheckpointEveryInterval(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	db.EnableArchiveMode(2)
+	ctx := context.Background()
+
+	epochSize := params.BeaconConfig().SlotsPerEpoch
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	finalizedStates := []*pb.BeaconState{
+		{Slot: genesisSlot, FinalizedEpoch: 1},
+		{Slot: genesisSlot + epochSize, FinalizedEpoch: 2},
+		{Slot: genesisSlot + 2*epochSize, FinalizedEpoch: 3},
+		{Slot: genesisSlot + 3*epochSize, FinalizedEpoch: 4},
+	}
+	for _, st := range finalizedStates {
+		if err := db.SaveFinalizedState(st); err != nil {
+			t.Fatalf("could not save finalized state: %v", err)
+		}
+	}
+
+	tests := []struct {
+		slot uint64
+		want *pb.BeaconState
+	}{
+		{slot: genesisSlot, want: finalizedStates[0]},
+		{slot: genesisSlot + epochSize + 1, want: finalizedStates[0]},
+		{slot: genesisSlot + 2*epochSize, want: finalizedStates[2]},
+		{slot: genesisSlot + 10*epochSize, want: finalizedStates[2]},
+	}
+	for _, tt := range tests {
+		archived, err := db.ArchivedState(ctx, tt.slot)
+		if err != nil {
+			t.Fatalf("Unable to retrieve archived state: %v", err)
+		}
+		if !proto.Equal(archived, tt.want) {
+			t.Errorf("Wanted archived state at slot %d for slot %d, got %d",
+				tt.want.Slot-genesisSlot, tt.slot-genesisSlot, archived.Slot-genesisSlot)
+		}
+	}
+
+	if _, err := db.ArchivedState(ctx, genesisSlot-1); err == nil {
+		t.Error("Expected error when no archived state exists before the requested slot")
+	}
+}
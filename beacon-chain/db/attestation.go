@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -22,7 +21,7 @@ func (db *BeaconDB) SaveAttestation(ctx context.Context, attestation *pb.Attesta
 	}
 	hash := hashutil.Hash(encodedAtt)
 
-	return db.batch(func(tx *bolt.Tx) error {
+	return db.batch(func(tx kvTx) error {
 		a := tx.Bucket(attestationBucket)
 
 		return a.Put(hash[:], encodedAtt)
@@ -39,7 +38,7 @@ func (db *BeaconDB) SaveAttestationTarget(ctx context.Context, attTarget *pb.Att
 		return err
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		a := tx.Bucket(attestationTargetBucket)
 
 		return a.Put(attTarget.BlockRoot, encodedAttTgt)
@@ -53,7 +52,7 @@ func (db *BeaconDB) DeleteAttestation(attestation *pb.Attestation) error {
 		return err
 	}
 
-	return db.batch(func(tx *bolt.Tx) error {
+	return db.batch(func(tx kvTx) error {
 		a := tx.Bucket(attestationBucket)
 		return a.Delete(hash[:])
 	})
@@ -62,7 +61,7 @@ func (db *BeaconDB) DeleteAttestation(attestation *pb.Attestation) error {
 // Attestation retrieves an attestation record from the db using its hash.
 func (db *BeaconDB) Attestation(hash [32]byte) (*pb.Attestation, error) {
 	var attestation *pb.Attestation
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		a := tx.Bucket(attestationBucket)
 
 		enc := a.Get(hash[:])
@@ -82,7 +81,7 @@ func (db *BeaconDB) Attestation(hash [32]byte) (*pb.Attestation, error) {
 // These are the attestations that have not been seen on the beacon chain.
 func (db *BeaconDB) Attestations() ([]*pb.Attestation, error) {
 	var attestations []*pb.Attestation
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		a := tx.Bucket(attestationBucket)
 
 		if err := a.ForEach(func(k, v []byte) error {
@@ -104,7 +103,7 @@ func (db *BeaconDB) Attestations() ([]*pb.Attestation, error) {
 // AttestationTarget retrieves an attestation target record from the db using its hash.
 func (db *BeaconDB) AttestationTarget(hash [32]byte) (*pb.AttestationTarget, error) {
 	var attTgt *pb.AttestationTarget
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		a := tx.Bucket(attestationTargetBucket)
 
 		enc := a.Get(hash[:])
@@ -124,7 +123,7 @@ func (db *BeaconDB) AttestationTarget(hash [32]byte) (*pb.AttestationTarget, err
 func (db *BeaconDB) HasAttestation(hash [32]byte) bool {
 	exists := false
 	// #nosec G104
-	db.view(func(tx *bolt.Tx) error {
+	db.view(func(tx kvTx) error {
 		a := tx.Bucket(attestationBucket)
 
 		exists = a.Get(hash[:]) != nil
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -57,7 +56,7 @@ func (db *BeaconDB) Block(root [32]byte) (*pb.BeaconBlock, error) {
 	}
 
 	var block *pb.BeaconBlock
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		bucket := tx.Bucket(blockBucket)
 
 		enc := bucket.Get(root[:])
@@ -95,7 +94,7 @@ func (db *BeaconDB) HasBlock(root [32]byte) bool {
 
 	hasBlock := false
 	// #nosec G104
-	_ = db.view(func(tx *bolt.Tx) error {
+	_ = db.view(func(tx kvTx) error {
 		bucket := tx.Bucket(blockBucket)
 
 		hasBlock = bucket.Get(root[:]) != nil
@@ -158,7 +157,7 @@ func (db *BeaconDB) SaveBlock(block *pb.BeaconBlock) error {
 		db.highestBlockSlot = block.Slot
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		bucket := tx.Bucket(blockBucket)
 		if err := bucket.Put(slotRootBinary, enc); err != nil {
 			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
@@ -183,7 +182,7 @@ func (db *BeaconDB) DeleteBlock(block *pb.BeaconBlock) error {
 
 	slotRootBinary := encodeSlotNumberRoot(block.Slot, root)
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		bucket := tx.Bucket(blockBucket)
 		if err := bucket.Delete(slotRootBinary); err != nil {
 			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
@@ -194,7 +193,7 @@ func (db *BeaconDB) DeleteBlock(block *pb.BeaconBlock) error {
 
 // SaveJustifiedBlock saves the last justified block from canonical chain to DB.
 func (db *BeaconDB) SaveJustifiedBlock(block *pb.BeaconBlock) error {
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		enc, err := proto.Marshal(block)
 		if err != nil {
 			return fmt.Errorf("failed to encode block: %v", err)
@@ -206,7 +205,7 @@ func (db *BeaconDB) SaveJustifiedBlock(block *pb.BeaconBlock) error {
 
 // SaveFinalizedBlock saves the last finalized block from canonical chain to DB.
 func (db *BeaconDB) SaveFinalizedBlock(block *pb.BeaconBlock) error {
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		enc, err := proto.Marshal(block)
 		if err != nil {
 			return fmt.Errorf("failed to encode block: %v", err)
@@ -219,7 +218,7 @@ func (db *BeaconDB) SaveFinalizedBlock(block *pb.BeaconBlock) error {
 // JustifiedBlock retrieves the justified block from the db.
 func (db *BeaconDB) JustifiedBlock() (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		encBlock := chainInfo.Get(justifiedBlockLookupKey)
 		if encBlock == nil {
@@ -236,7 +235,7 @@ func (db *BeaconDB) JustifiedBlock() (*pb.BeaconBlock, error) {
 // FinalizedBlock retrieves the finalized block from the db.
 func (db *BeaconDB) FinalizedBlock() (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		encBlock := chainInfo.Get(finalizedBlockLookupKey)
 		if encBlock == nil {
@@ -253,7 +252,7 @@ func (db *BeaconDB) FinalizedBlock() (*pb.BeaconBlock, error) {
 // ChainHead returns the head of the main chain.
 func (db *BeaconDB) ChainHead() (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		blockBkt := tx.Bucket(blockBucket)
 
@@ -305,7 +304,7 @@ func (db *BeaconDB) UpdateChainHead(ctx context.Context, block *pb.BeaconBlock,
 		return err
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		blockBucket := tx.Bucket(blockBucket)
 		chainInfo := tx.Bucket(chainInfoBucket)
 		mainChainBucket := tx.Bucket(mainChainBucket)
@@ -339,7 +338,7 @@ func (db *BeaconDB) CanonicalBlockBySlot(ctx context.Context, slot uint64) (*pb.
 	var block *pb.BeaconBlock
 	slotEnc := encodeSlotNumber(slot)
 
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		bkt := tx.Bucket(mainChainBucket)
 		blockEnc := bkt.Get(slotEnc)
 		var err error
@@ -365,7 +364,7 @@ func (db *BeaconDB) BlocksBySlot(ctx context.Context, slot uint64) ([]*pb.Beacon
 	blocks := []*pb.BeaconBlock{}
 	slotEnc := encodeSlotNumber(slot)
 
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		c := tx.Bucket(blockBucket).Cursor()
 
 		var err error
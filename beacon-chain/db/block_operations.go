@@ -3,7 +3,6 @@ package db
 import (
 	"context"
 
-	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -23,7 +22,7 @@ func (db *BeaconDB) SaveExit(ctx context.Context, exit *pb.VoluntaryExit) error
 	if err != nil {
 		return err
 	}
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		a := tx.Bucket(blockOperationsBucket)
 		return a.Put(hash[:], encodedExit)
 	})
@@ -32,7 +31,7 @@ func (db *BeaconDB) SaveExit(ctx context.Context, exit *pb.VoluntaryExit) error
 // HasExit checks if the exit request exists.
 func (db *BeaconDB) HasExit(hash [32]byte) bool {
 	exists := false
-	if err := db.view(func(tx *bolt.Tx) error {
+	if err := db.view(func(tx kvTx) error {
 		b := tx.Bucket(blockOperationsBucket)
 		exists = b.Get(hash[:]) != nil
 		return nil
@@ -41,3 +40,25 @@ func (db *BeaconDB) HasExit(hash [32]byte) bool {
 	}
 	return exists
 }
+
+// DeleteExit deletes the exit request from the beacon chain db.
+func (db *BeaconDB) DeleteExit(exit *pb.VoluntaryExit) error {
+	return db.deleteOperation(blockOperationsBucket, exit)
+}
+
+// Exits retrieves all the exit requests which have not been included in a
+// block yet.
+func (db *BeaconDB) Exits() ([]*pb.VoluntaryExit, error) {
+	var exits []*pb.VoluntaryExit
+	err := db.view(func(tx kvTx) error {
+		return tx.Bucket(blockOperationsBucket).ForEach(func(k, v []byte) error {
+			exit := &pb.VoluntaryExit{}
+			if err := unmarshalOperation(v, exit); err != nil {
+				return err
+			}
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
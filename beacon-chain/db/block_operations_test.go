@@ -31,3 +31,43 @@ func TestBeaconDB_HasExit(t *testing.T) {
 		t.Fatal("Expected HasExit to return true")
 	}
 }
+
+func TestBeaconDB_ExitsAndDeleteExit(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	exits := []*pb.VoluntaryExit{
+		{Epoch: 100, ValidatorIndex: 1},
+		{Epoch: 100, ValidatorIndex: 2},
+	}
+	for _, exit := range exits {
+		if err := db.SaveExit(context.Background(), exit); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+	saved, err := db.Exits()
+	if err != nil {
+		t.Fatalf("Failed to retrieve exit requests: %v", err)
+	}
+	if len(saved) != len(exits) {
+		t.Fatalf("Expected %d exit requests, received %d", len(exits), len(saved))
+	}
+
+	if err := db.DeleteExit(exits[0]); err != nil {
+		t.Fatalf("Failed to delete exit request: %v", err)
+	}
+	hash, err := hashutil.HashProto(exits[0])
+	if err != nil {
+		t.Fatalf("could not hash exit request: %v", err)
+	}
+	if db.HasExit(hash) {
+		t.Fatal("Expected HasExit to return false after deletion")
+	}
+	saved, err = db.Exits()
+	if err != nil {
+		t.Fatalf("Failed to retrieve exit requests: %v", err)
+	}
+	if len(saved) != 1 || saved[0].ValidatorIndex != 2 {
+		t.Fatalf("Expected only the exit of validator 2 to remain, received %v", saved)
+	}
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Database defines the operations the services of the beacon node perform on
+// the beacon chain database, independently of the storage backend. BeaconDB
+// implements it either on disk, see NewDB, or in memory, see NewInMemoryDB.
+type Database interface {
+	Close() error
+
+	// Blocks.
+	Block(root [32]byte) (*pb.BeaconBlock, error)
+	HasBlock(root [32]byte) bool
+	IsEvilBlockHash(root [32]byte) bool
+	MarkEvilBlockHash(root [32]byte)
+	SaveBlock(block *pb.BeaconBlock) error
+	DeleteBlock(block *pb.BeaconBlock) error
+	SaveJustifiedBlock(block *pb.BeaconBlock) error
+	SaveFinalizedBlock(block *pb.BeaconBlock) error
+	JustifiedBlock() (*pb.BeaconBlock, error)
+	FinalizedBlock() (*pb.BeaconBlock, error)
+	ChainHead() (*pb.BeaconBlock, error)
+	UpdateChainHead(ctx context.Context, block *pb.BeaconBlock, beaconState *pb.BeaconState) error
+	CanonicalBlockBySlot(ctx context.Context, slot uint64) (*pb.BeaconBlock, error)
+	BlocksBySlot(ctx context.Context, slot uint64) ([]*pb.BeaconBlock, error)
+	HighestBlockSlot() uint64
+	ClearBlockCache()
+
+	// Attestations and block operations.
+	SaveAttestation(ctx context.Context, attestation *pb.Attestation) error
+	SaveAttestationTarget(ctx context.Context, attTarget *pb.AttestationTarget) error
+	DeleteAttestation(attestation *pb.Attestation) error
+	Attestation(hash [32]byte) (*pb.Attestation, error)
+	Attestations() ([]*pb.Attestation, error)
+	AttestationTarget(hash [32]byte) (*pb.AttestationTarget, error)
+	HasAttestation(hash [32]byte) bool
+	SaveExit(ctx context.Context, exit *pb.VoluntaryExit) error
+	HasExit(hash [32]byte) bool
+	DeleteExit(exit *pb.VoluntaryExit) error
+	Exits() ([]*pb.VoluntaryExit, error)
+	SaveProposerSlashing(ctx context.Context, slashing *pb.ProposerSlashing) error
+	SaveAttesterSlashing(ctx context.Context, slashing *pb.AttesterSlashing) error
+	DeleteProposerSlashing(slashing *pb.ProposerSlashing) error
+	DeleteAttesterSlashing(slashing *pb.AttesterSlashing) error
+	HasProposerSlashing(hash [32]byte) bool
+	HasAttesterSlashing(hash [32]byte) bool
+	ProposerSlashing(hash [32]byte) (*pb.ProposerSlashing, error)
+	AttesterSlashing(hash [32]byte) (*pb.AttesterSlashing, error)
+	ProposerSlashings() ([]*pb.ProposerSlashing, error)
+	AttesterSlashings() ([]*pb.AttesterSlashing, error)
+
+	// States.
+	InitializeState(ctx context.Context, genesisTime uint64, deposits []*pb.Deposit, eth1Data *pb.Eth1Data) error
+	HeadState(ctx context.Context) (*pb.BeaconState, error)
+	HeadStateRoot() [32]byte
+	SaveState(ctx context.Context, beaconState *pb.BeaconState) error
+	SaveJustifiedState(beaconState *pb.BeaconState) error
+	SaveFinalizedState(beaconState *pb.BeaconState) error
+	SaveHistoricalState(ctx context.Context, beaconState *pb.BeaconState, blockRoot [32]byte) error
+	JustifiedState() (*pb.BeaconState, error)
+	FinalizedState() (*pb.BeaconState, error)
+	HistoricalStateFromSlot(ctx context.Context, slot uint64, blockRoot [32]byte) (*pb.BeaconState, error)
+	ArchiveMode() bool
+	ArchivedState(ctx context.Context, slot uint64) (*pb.BeaconState, error)
+
+	// Validators.
+	ValidatorRegistry(ctx context.Context) ([]*pb.Validator, error)
+	ValidatorFromState(ctx context.Context, index uint64) (*pb.Validator, error)
+	ValidatorBalances(ctx context.Context) ([]uint64, error)
+	SaveValidatorIndex(pubKey []byte, index int) error
+	SaveValidatorIndexBatch(pubKey []byte, index int) error
+	ValidatorIndex(pubKey []byte) (uint64, error)
+	DeleteValidatorIndex(pubKey []byte) error
+	HasValidator(pubKey []byte) bool
+	HasAnyValidators(state *pb.BeaconState, pubKeys [][]byte) (bool, error)
+
+	// Deposits.
+	InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error
+	MarkPubkeyForChainstart(ctx context.Context, pubkey string)
+	PubkeyInChainstart(ctx context.Context, pubkey string) bool
+	AllDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit
+	DepositByPubkey(ctx context.Context, pubKey []byte) (*pb.Deposit, *big.Int)
+	SaveLastProcessedETH1Block(ctx context.Context, blockNum *big.Int) error
+	LastProcessedETH1Block(ctx context.Context) *big.Int
+	InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error
+	PendingDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit
+	RemovePendingDeposit(ctx context.Context, d *pb.Deposit)
+	PrunePendingDeposits(ctx context.Context, merkleTreeIndex uint64)
+	VerifyContractAddress(ctx context.Context, addr common.Address) error
+
+	// Peers.
+	SavePeerBan(peerID string, until time.Time) error
+	DeletePeerBan(peerID string) error
+	PeerBans() (map[string]time.Time, error)
+}
+
+var _ = Database(&BeaconDB{})
@@ -2,6 +2,7 @@ package db
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"sync"
@@ -26,7 +27,7 @@ type BeaconDB struct {
 	stateHash         [32]byte
 	validatorRegistry []*pb.Validator
 	validatorBalances []uint64
-	db                *bolt.DB
+	db                backend
 	DatabasePath      string
 
 	// Beacon block info in memory.
@@ -43,24 +44,29 @@ type BeaconDB struct {
 	depositsLock          sync.RWMutex
 	chainstartPubkeys     map[string]bool
 	chainstartPubkeysLock sync.RWMutex
+
+	// Number of epochs between archived state checkpoints, archive mode is disabled if zero.
+	archiveCheckpointEpochs uint64
+	// Number of epochs between full historical state snapshots.
+	stateSnapshotEpochs uint64
 }
 
-// Close closes the underlying boltdb database.
+// Close closes the underlying storage backend.
 func (db *BeaconDB) Close() error {
 	return db.db.Close()
 }
 
-func (db *BeaconDB) update(fn func(*bolt.Tx) error) error {
+func (db *BeaconDB) update(fn func(kvTx) error) error {
 	return db.db.Update(fn)
 }
-func (db *BeaconDB) batch(fn func(*bolt.Tx) error) error {
+func (db *BeaconDB) batch(fn func(kvTx) error) error {
 	return db.db.Batch(fn)
 }
-func (db *BeaconDB) view(fn func(*bolt.Tx) error) error {
+func (db *BeaconDB) view(fn func(kvTx) error) error {
 	return db.db.View(fn)
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx kvTx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
@@ -84,17 +90,34 @@ func NewDB(dirPath string) (*BeaconDB, error) {
 		return nil, err
 	}
 
-	db := &BeaconDB{db: boltDB, DatabasePath: dirPath}
+	return newBeaconDB(&boltBackend{db: boltDB}, dirPath)
+}
+
+// NewInMemoryDB initializes a new DB which keeps all its data in memory instead of
+// persisting it to disk. The data is lost once the DB is closed.
+func NewInMemoryDB() (*BeaconDB, error) {
+	return newBeaconDB(newMemoryBackend(), "")
+}
+
+func newBeaconDB(kv backend, dirPath string) (*BeaconDB, error) {
+	db := &BeaconDB{db: kv, DatabasePath: dirPath, stateSnapshotEpochs: defaultStateSnapshotEpochs}
 	db.blocks = make(map[[32]byte]*pb.BeaconBlock)
 
-	if err := db.update(func(tx *bolt.Tx) error {
-		return createBuckets(tx, blockBucket, attestationBucket, attestationTargetBucket, mainChainBucket,
-			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket)
+	if err := db.update(func(tx kvTx) error {
+		return createBuckets(tx, allBuckets...)
 	}); err != nil {
 		return nil, err
 	}
 
-	return db, err
+	if err := db.migrate(); err != nil {
+		return nil, err
+	}
+
+	if err := db.loadDeposits(); err != nil {
+		return nil, fmt.Errorf("could not load deposits: %v", err)
+	}
+
+	return db, nil
 }
 
 // ClearDB removes the previously stored directory at the data directory.
@@ -3,9 +3,13 @@ package db
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"math/big"
 	"sort"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -23,7 +27,7 @@ var (
 
 // InsertDeposit into the database. If deposit or block number are nil
 // then this method does nothing.
-func (db *BeaconDB) InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) {
+func (db *BeaconDB) InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.InsertDeposit")
 	defer span.End()
 	if d == nil || blockNum == nil {
@@ -31,12 +35,17 @@ func (db *BeaconDB) InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *
 			"block":   blockNum,
 			"deposit": d,
 		}).Debug("Ignoring nil deposit insertion")
-		return
+		return nil
 	}
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
-	db.deposits = append(db.deposits, &depositContainer{deposit: d, block: blockNum})
+	ctnr := &depositContainer{deposit: d, block: blockNum}
+	if err := db.saveDepositContainer(depositBucket, ctnr); err != nil {
+		return fmt.Errorf("could not persist deposit: %v", err)
+	}
+	db.deposits = append(db.deposits, ctnr)
 	historicalDepositsCount.Inc()
+	return nil
 }
 
 // MarkPubkeyForChainstart sets the pubkey deposit status to true.
@@ -45,6 +54,14 @@ func (db *BeaconDB) MarkPubkeyForChainstart(ctx context.Context, pubkey string)
 	defer span.End()
 	db.chainstartPubkeysLock.Lock()
 	defer db.chainstartPubkeysLock.Unlock()
+	if db.chainstartPubkeys == nil {
+		db.chainstartPubkeys = make(map[string]bool)
+	}
+	if err := db.update(func(tx kvTx) error {
+		return tx.Bucket(chainstartPubkeyBucket).Put([]byte(pubkey), []byte{1})
+	}); err != nil {
+		log.Errorf("Could not persist chainstart pubkey: %v", err)
+	}
 	db.chainstartPubkeys[pubkey] = true
 }
 
@@ -107,3 +124,127 @@ func (db *BeaconDB) DepositByPubkey(ctx context.Context, pubKey []byte) (*pb.Dep
 	}
 	return deposit, blockNum
 }
+
+// SaveLastProcessedETH1Block records the highest ETH1.0 block number for which
+// deposit contract logs have been processed, so they are not replayed on restart.
+func (db *BeaconDB) SaveLastProcessedETH1Block(ctx context.Context, blockNum *big.Int) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLastProcessedETH1Block")
+	defer span.End()
+	if blockNum == nil {
+		return errors.New("nil block number")
+	}
+	return db.update(func(tx kvTx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		return chainInfo.Put(lastETH1BlockLookupKey, blockNum.Bytes())
+	})
+}
+
+// LastProcessedETH1Block returns the highest ETH1.0 block number for which
+// deposit contract logs have been processed. Returns nil if none has been saved.
+func (db *BeaconDB) LastProcessedETH1Block(ctx context.Context) *big.Int {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastProcessedETH1Block")
+	defer span.End()
+	var blockNum *big.Int
+	// #nosec G104
+	_ = db.view(func(tx kvTx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		if enc := chainInfo.Get(lastETH1BlockLookupKey); enc != nil {
+			blockNum = new(big.Int).SetBytes(enc)
+		}
+		return nil
+	})
+	return blockNum
+}
+
+// saveDepositContainer persists a deposit container in the given bucket, keyed by
+// the big-endian encoded Merkle tree index of the deposit.
+func (db *BeaconDB) saveDepositContainer(bucket []byte, ctnr *depositContainer) error {
+	enc, err := encodeDepositContainer(ctnr)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx kvTx) error {
+		return tx.Bucket(bucket).Put(encodeDepositIndex(ctnr.deposit.MerkleTreeIndex), enc)
+	})
+}
+
+// loadDeposits reads all the persisted deposits, pending deposits and
+// chainstart pubkeys into memory.
+func (db *BeaconDB) loadDeposits() error {
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+	db.chainstartPubkeysLock.Lock()
+	defer db.chainstartPubkeysLock.Unlock()
+
+	db.chainstartPubkeys = make(map[string]bool)
+	return db.view(func(tx kvTx) error {
+		if err := tx.Bucket(depositBucket).ForEach(func(k, v []byte) error {
+			ctnr, err := decodeDepositContainer(v)
+			if err != nil {
+				return err
+			}
+			db.deposits = append(db.deposits, ctnr)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := tx.Bucket(pendingDepositBucket).ForEach(func(k, v []byte) error {
+			ctnr, err := decodeDepositContainer(v)
+			if err != nil {
+				return err
+			}
+			db.pendingDeposits = append(db.pendingDeposits, ctnr)
+			return nil
+		}); err != nil {
+			return err
+		}
+		historicalDepositsCount.Add(float64(len(db.deposits)))
+		pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
+
+		return tx.Bucket(chainstartPubkeyBucket).ForEach(func(k, v []byte) error {
+			db.chainstartPubkeys[string(k)] = true
+			return nil
+		})
+	})
+}
+
+// encodeDepositIndex encodes a deposit Merkle tree index as big-endian uint64,
+// so deposits are iterated in order of their index.
+func encodeDepositIndex(index uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, index)
+	return enc
+}
+
+func decodeDepositIndex(enc []byte) uint64 {
+	return binary.BigEndian.Uint64(enc)
+}
+
+// encodeDepositContainer encodes the ETH1.0 block number of a deposit as
+// big-endian uint64, followed by the protobuf encoded deposit.
+func encodeDepositContainer(ctnr *depositContainer) ([]byte, error) {
+	if !ctnr.block.IsUint64() {
+		return nil, fmt.Errorf("block number %v does not fit in uint64", ctnr.block)
+	}
+	depositEnc, err := proto.Marshal(ctnr.deposit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode deposit: %v", err)
+	}
+	enc := make([]byte, 8, 8+len(depositEnc))
+	binary.BigEndian.PutUint64(enc, ctnr.block.Uint64())
+	return append(enc, depositEnc...), nil
+}
+
+func decodeDepositContainer(enc []byte) (*depositContainer, error) {
+	if len(enc) < 8 {
+		return nil, errors.New("deposit encoding is too short")
+	}
+	deposit := &pb.Deposit{}
+	if err := proto.Unmarshal(enc[8:], deposit); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal encoding: %v", err)
+	}
+	return &depositContainer{
+		deposit: deposit,
+		block:   new(big.Int).SetUint64(binary.BigEndian.Uint64(enc[:8])),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestDeposits_PersistAcrossRestart(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	deposits := []*pb.Deposit{
+		{MerkleTreeIndex: 0, DepositData: []byte{'A'}},
+		{MerkleTreeIndex: 1, DepositData: []byte{'B'}},
+		{MerkleTreeIndex: 2, DepositData: []byte{'C'}},
+	}
+	for i, d := range deposits {
+		db.InsertDeposit(ctx, d, big.NewInt(int64(10*(i+1))))
+	}
+	db.MarkPubkeyForChainstart(ctx, "pubkey")
+	if err := db.SaveLastProcessedETH1Block(ctx, big.NewInt(30)); err != nil {
+		t.Fatalf("Could not save last processed block: %v", err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
+	reopened, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Failed to reopen database: %v", err)
+	}
+	defer teardownDB(t, reopened)
+
+	all := reopened.AllDeposits(ctx, nil)
+	if len(all) != len(deposits) {
+		t.Fatalf("Wanted %d deposits, got %d", len(deposits), len(all))
+	}
+	for i := range deposits {
+		if !proto.Equal(all[i], deposits[i]) {
+			t.Errorf("Unexpected deposit %d. got=%+v want=%+v", i, all[i], deposits[i])
+		}
+	}
+	if got := reopened.AllDeposits(ctx, big.NewInt(20)); len(got) != 2 {
+		t.Errorf("Wanted 2 deposits before block 20, got %d", len(got))
+	}
+	if !reopened.PubkeyInChainstart(ctx, "pubkey") {
+		t.Error("Expected chainstart pubkey to be persisted")
+	}
+	if reopened.PubkeyInChainstart(ctx, "other-pubkey") {
+		t.Error("Did not expect unknown pubkey to be marked for chainstart")
+	}
+	if got := reopened.LastProcessedETH1Block(ctx); got == nil || got.Cmp(big.NewInt(30)) != 0 {
+		t.Errorf("Wanted last processed block 30, got %v", got)
+	}
+}
+
+func TestLastProcessedETH1Block_NoneSaved(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if got := db.LastProcessedETH1Block(context.Background()); got != nil {
+		t.Errorf("Wanted nil last processed block, got %v", got)
+	}
+}
@@ -0,0 +1,38 @@
+package db
+
+import (
+	"sort"
+)
+
+// BucketStat is the number of keys stored in a bucket of the database.
+type BucketStat struct {
+	Name string
+	Keys int
+}
+
+// BucketStats returns the number of keys stored in each bucket of the database,
+// sorted by bucket name. It is meant for offline inspection of the database.
+func (db *BeaconDB) BucketStats() ([]*BucketStat, error) {
+	var stats []*BucketStat
+	err := db.view(func(tx kvTx) error {
+		for _, name := range allBuckets {
+			bkt := tx.Bucket(name)
+			if bkt == nil {
+				continue
+			}
+			stat := &BucketStat{Name: string(name)}
+			if err := bkt.ForEach(func(k, v []byte) error {
+				stat.Keys++
+				return nil
+			}); err != nil {
+				return err
+			}
+			stats = append(stats, stat)
+		}
+		return nil
+	})
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+	return stats, err
+}
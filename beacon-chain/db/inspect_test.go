@@ -0,0 +1,35 @@
+package db
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestBucketStats_CountsKeys(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	for i := uint64(0); i < 3; i++ {
+		if err := db.SaveBlock(&pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + i}); err != nil {
+			t.Fatalf("Failed to save block: %v", err)
+		}
+	}
+
+	stats, err := db.BucketStats()
+	if err != nil {
+		t.Fatalf("Failed to compute bucket stats: %v", err)
+	}
+	if len(stats) != len(allBuckets) {
+		t.Fatalf("Expected stats for %d buckets, received %d", len(allBuckets), len(stats))
+	}
+	for i, stat := range stats {
+		if i > 0 && stats[i-1].Name >= stat.Name {
+			t.Errorf("Expected buckets sorted by name, %s came before %s", stats[i-1].Name, stat.Name)
+		}
+		if stat.Name == string(blockBucket) && stat.Keys != 3 {
+			t.Errorf("Expected 3 keys in the block bucket, received %d", stat.Keys)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"github.com/boltdb/bolt"
+)
+
+// backend is the transactional key-value store the beacon chain data is persisted in.
+// Keys are organized in buckets and iterated in byte-wise order.
+type backend interface {
+	Update(fn func(tx kvTx) error) error
+	Batch(fn func(tx kvTx) error) error
+	View(fn func(tx kvTx) error) error
+	Close() error
+}
+
+// kvTx is a transaction of a backend. Buckets and values retrieved from it
+// are only valid for the life of the transaction.
+type kvTx interface {
+	// Bucket returns nil if the bucket does not exist.
+	Bucket(name []byte) kvBucket
+	CreateBucketIfNotExists(name []byte) (kvBucket, error)
+	Writable() bool
+}
+
+// kvBucket is a collection of key-value pairs.
+type kvBucket interface {
+	Get(key []byte) []byte
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+	ForEach(fn func(k, v []byte) error) error
+	Cursor() kvCursor
+}
+
+// kvCursor iterates over the sorted keys of a bucket.
+type kvCursor interface {
+	First() (key []byte, value []byte)
+	Last() (key []byte, value []byte)
+	Seek(seek []byte) (key []byte, value []byte)
+	Next() (key []byte, value []byte)
+	Prev() (key []byte, value []byte)
+}
+
+// boltBackend persists the beacon chain data on disk using boltdb.
+type boltBackend struct {
+	db *bolt.DB
+}
+
+func (b *boltBackend) Update(fn func(tx kvTx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx})
+	})
+}
+
+func (b *boltBackend) Batch(fn func(tx kvTx) error) error {
+	return b.db.Batch(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx})
+	})
+}
+
+func (b *boltBackend) View(fn func(tx kvTx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx})
+	})
+}
+
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t *boltTx) Bucket(name []byte) kvBucket {
+	bkt := t.tx.Bucket(name)
+	if bkt == nil {
+		return nil
+	}
+	return &boltBucket{bkt}
+}
+
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (kvBucket, error) {
+	bkt, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{bkt}, nil
+}
+
+func (t *boltTx) Writable() bool {
+	return t.tx.Writable()
+}
+
+type boltBucket struct {
+	*bolt.Bucket
+}
+
+func (b *boltBucket) Cursor() kvCursor {
+	return b.Bucket.Cursor()
+}
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+)
+
+var errReadOnlyTx = errors.New("cannot write in a read-only transaction")
+
+// memoryBackend keeps the beacon chain data in memory, which is lost once the
+// database is closed. Write transactions are serialized and rolled back on error.
+type memoryBackend struct {
+	lock    sync.RWMutex
+	buckets map[string]*memoryBucket
+}
+
+func newMemoryBackend() *memoryBackend {
+	return &memoryBackend{buckets: make(map[string]*memoryBucket)}
+}
+
+func (m *memoryBackend) Update(fn func(tx kvTx) error) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	tx := &memoryTx{backend: m, writable: true}
+	if err := fn(tx); err != nil {
+		tx.rollback()
+		return err
+	}
+	return nil
+}
+
+func (m *memoryBackend) Batch(fn func(tx kvTx) error) error {
+	return m.Update(fn)
+}
+
+func (m *memoryBackend) View(fn func(tx kvTx) error) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return fn(&memoryTx{backend: m})
+}
+
+func (m *memoryBackend) Close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.buckets = make(map[string]*memoryBucket)
+	return nil
+}
+
+// memoryTx records how to undo each of its writes, so they can be rolled back.
+type memoryTx struct {
+	backend  *memoryBackend
+	writable bool
+	undo     []func()
+}
+
+func (t *memoryTx) Bucket(name []byte) kvBucket {
+	bkt, ok := t.backend.buckets[string(name)]
+	if !ok {
+		return nil
+	}
+	return &memoryTxBucket{tx: t, bucket: bkt}
+}
+
+func (t *memoryTx) CreateBucketIfNotExists(name []byte) (kvBucket, error) {
+	if !t.writable {
+		return nil, errReadOnlyTx
+	}
+	if _, ok := t.backend.buckets[string(name)]; !ok {
+		t.backend.buckets[string(name)] = &memoryBucket{values: make(map[string][]byte)}
+		t.undo = append(t.undo, func() {
+			delete(t.backend.buckets, string(name))
+		})
+	}
+	return t.Bucket(name), nil
+}
+
+func (t *memoryTx) Writable() bool {
+	return t.writable
+}
+
+func (t *memoryTx) rollback() {
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+}
+
+// memoryBucket keeps its keys sorted for cursors.
+type memoryBucket struct {
+	keys   [][]byte
+	values map[string][]byte
+}
+
+func (b *memoryBucket) search(key []byte) int {
+	return sort.Search(len(b.keys), func(i int) bool {
+		return bytes.Compare(b.keys[i], key) >= 0
+	})
+}
+
+func (b *memoryBucket) put(key []byte, value []byte) {
+	if _, ok := b.values[string(key)]; !ok {
+		i := b.search(key)
+		b.keys = append(b.keys, nil)
+		copy(b.keys[i+1:], b.keys[i:])
+		b.keys[i] = key
+	}
+	b.values[string(key)] = value
+}
+
+func (b *memoryBucket) delete(key []byte) {
+	if _, ok := b.values[string(key)]; !ok {
+		return
+	}
+	i := b.search(key)
+	b.keys = append(b.keys[:i], b.keys[i+1:]...)
+	delete(b.values, string(key))
+}
+
+type memoryTxBucket struct {
+	tx     *memoryTx
+	bucket *memoryBucket
+}
+
+func (b *memoryTxBucket) Get(key []byte) []byte {
+	return b.bucket.values[string(key)]
+}
+
+func (b *memoryTxBucket) Put(key []byte, value []byte) error {
+	if !b.tx.writable {
+		return errReadOnlyTx
+	}
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	b.recordUndo(key)
+	b.bucket.put(append([]byte{}, key...), append([]byte{}, value...))
+	return nil
+}
+
+func (b *memoryTxBucket) Delete(key []byte) error {
+	if !b.tx.writable {
+		return errReadOnlyTx
+	}
+	b.recordUndo(key)
+	b.bucket.delete(key)
+	return nil
+}
+
+func (b *memoryTxBucket) recordUndo(key []byte) {
+	bkt := b.bucket
+	k := append([]byte{}, key...)
+	if old, ok := bkt.values[string(key)]; ok {
+		b.tx.undo = append(b.tx.undo, func() { bkt.put(k, old) })
+	} else {
+		b.tx.undo = append(b.tx.undo, func() { bkt.delete(k) })
+	}
+}
+
+func (b *memoryTxBucket) ForEach(fn func(k, v []byte) error) error {
+	keys := append([][]byte{}, b.bucket.keys...)
+	for _, k := range keys {
+		if err := fn(k, b.bucket.values[string(k)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *memoryTxBucket) Cursor() kvCursor {
+	return &memoryCursor{bucket: b.bucket, index: -1}
+}
+
+type memoryCursor struct {
+	bucket *memoryBucket
+	index  int
+}
+
+func (c *memoryCursor) First() ([]byte, []byte) {
+	c.index = 0
+	return c.current()
+}
+
+func (c *memoryCursor) Last() ([]byte, []byte) {
+	c.index = len(c.bucket.keys) - 1
+	return c.current()
+}
+
+func (c *memoryCursor) Seek(seek []byte) ([]byte, []byte) {
+	c.index = c.bucket.search(seek)
+	return c.current()
+}
+
+func (c *memoryCursor) Next() ([]byte, []byte) {
+	if c.index < len(c.bucket.keys) {
+		c.index++
+	}
+	return c.current()
+}
+
+func (c *memoryCursor) Prev() ([]byte, []byte) {
+	if c.index >= 0 {
+		c.index--
+	}
+	return c.current()
+}
+
+func (c *memoryCursor) current() ([]byte, []byte) {
+	if c.index < 0 || c.index >= len(c.bucket.keys) {
+		return nil, nil
+	}
+	k := c.bucket.keys[c.index]
+	return k, c.bucket.values[string(k)]
+}
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestMemoryBackend_CursorOrder(t *testing.T) {
+	kv := newMemoryBackend()
+	bucket := []byte("bucket")
+	if err := kv.Update(func(tx kvTx) error {
+		bkt, err := tx.CreateBucketIfNotExists(bucket)
+		if err != nil {
+			return err
+		}
+		for _, k := range []uint64{30, 10, 20} {
+			if err := bkt.Put(encodeSlotNumber(k), []byte{byte(k)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := kv.View(func(tx kvTx) error {
+		if tx.Bucket([]byte("missing")) != nil {
+			t.Error("Expected nil for a bucket which does not exist")
+		}
+		c := tx.Bucket(bucket).Cursor()
+		var slots []uint64
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			slots = append(slots, decodeToSlotNumber(k))
+		}
+		if len(slots) != 3 || slots[0] != 10 || slots[1] != 20 || slots[2] != 30 {
+			t.Errorf("Expected keys in sorted order, received %v", slots)
+		}
+		if k, v := c.Seek(encodeSlotNumber(15)); decodeToSlotNumber(k) != 20 || !bytes.Equal(v, []byte{20}) {
+			t.Errorf("Expected seek to return the next key, received %d", decodeToSlotNumber(k))
+		}
+		if k, _ := c.Prev(); decodeToSlotNumber(k) != 10 {
+			t.Errorf("Expected previous key 10, received %d", decodeToSlotNumber(k))
+		}
+		if k, _ := c.Prev(); k != nil {
+			t.Errorf("Expected no key before the first one, received %d", decodeToSlotNumber(k))
+		}
+		if k, _ := c.Last(); decodeToSlotNumber(k) != 30 {
+			t.Errorf("Expected last key 30, received %d", decodeToSlotNumber(k))
+		}
+		if err := tx.Bucket(bucket).Put([]byte("key"), []byte("value")); err != errReadOnlyTx {
+			t.Errorf("Expected write in read-only transaction to fail, received %v", err)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryBackend_RollbackOnError(t *testing.T) {
+	kv := newMemoryBackend()
+	bucket := []byte("bucket")
+	if err := kv.Update(func(tx kvTx) error {
+		bkt, err := tx.CreateBucketIfNotExists(bucket)
+		if err != nil {
+			return err
+		}
+		return bkt.Put([]byte("A"), []byte("1"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	txErr := errors.New("failed")
+	if err := kv.Update(func(tx kvTx) error {
+		bkt := tx.Bucket(bucket)
+		if err := bkt.Put([]byte("A"), []byte("2")); err != nil {
+			return err
+		}
+		if err := bkt.Put([]byte("B"), []byte("3")); err != nil {
+			return err
+		}
+		return txErr
+	}); err != txErr {
+		t.Fatalf("Expected transaction error, received %v", err)
+	}
+
+	if err := kv.View(func(tx kvTx) error {
+		bkt := tx.Bucket(bucket)
+		if v := bkt.Get([]byte("A")); !bytes.Equal(v, []byte("1")) {
+			t.Errorf("Expected rolled back value 1, received %q", v)
+		}
+		if v := bkt.Get([]byte("B")); v != nil {
+			t.Errorf("Expected rolled back key to be deleted, received %q", v)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInMemoryDB_SaveAndRetrieve(t *testing.T) {
+	db, err := NewInMemoryDB()
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	block := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatalf("Failed to save block: %v", err)
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retBlock, err := db.Block(root)
+	if err != nil {
+		t.Fatalf("Failed to retrieve block: %v", err)
+	}
+	if !proto.Equal(block, retBlock) {
+		t.Errorf("Saved and retrieved blocks are not equal got %v but wanted %v", retBlock, block)
+	}
+
+	state := &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 1, ValidatorBalances: []uint64{1, 2}}
+	if err := db.SaveHistoricalState(ctx, state, root); err != nil {
+		t.Fatalf("Failed to save historical state: %v", err)
+	}
+	retState, err := db.HistoricalStateFromSlot(ctx, state.Slot, root)
+	if err != nil {
+		t.Fatalf("Failed to retrieve historical state: %v", err)
+	}
+	if !proto.Equal(state, retState) {
+		t.Errorf("Saved and retrieved states are not equal got %v but wanted %v", retState, state)
+	}
+}
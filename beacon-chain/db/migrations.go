@@ -0,0 +1,221 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// Migration defines a change to the schema of the database, such as a change of
+// key encoding, which has to be applied to data directories created by older versions
+// of the beacon node.
+type Migration struct {
+	Version     uint64
+	Description string
+	migrate     func(tx kvTx) error
+}
+
+// migrations is the ordered registry of all schema migrations. New migrations
+// must be appended to the end of the list with their version incremented by one.
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "Encode slot numbers in keys as big-endian",
+		migrate:     migrateBigEndianSlotKeys,
+	},
+}
+
+// LatestSchemaVersion is the schema version of a database which has all known
+// migrations applied.
+func LatestSchemaVersion() uint64 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// PendingMigrations opens the database in the given directory in read-only mode and
+// returns its schema version along with the migrations which would be applied to it
+// the next time it is opened by NewDB.
+func PendingMigrations(dirPath string) (uint64, []*Migration, error) {
+	datafile := path.Join(dirPath, "beaconchain.db")
+	if _, err := os.Stat(datafile); err != nil {
+		return 0, nil, fmt.Errorf("could not find database: %v", err)
+	}
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return 0, nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, nil, err
+	}
+	kv := &boltBackend{db: boltDB}
+	defer kv.Close()
+
+	var version uint64
+	if err := kv.View(func(tx kvTx) error {
+		var err error
+		version, err = schemaVersion(tx)
+		return err
+	}); err != nil {
+		return 0, nil, err
+	}
+	if version > LatestSchemaVersion() {
+		return version, nil, errNewerSchema(version)
+	}
+	return version, migrationsSince(version), nil
+}
+
+// migrate applies all the migrations newer than the schema version of the database,
+// each in its own transaction, and refuses to open a database with a schema version
+// newer than the latest known one.
+func (db *BeaconDB) migrate() error {
+	var version uint64
+	if err := db.update(func(tx kvTx) error {
+		var err error
+		version, err = schemaVersion(tx)
+		return err
+	}); err != nil {
+		return err
+	}
+	if version > LatestSchemaVersion() {
+		return errNewerSchema(version)
+	}
+
+	for _, m := range migrationsSince(version) {
+		if err := db.update(func(tx kvTx) error {
+			if err := m.migrate(tx); err != nil {
+				return err
+			}
+			return tx.Bucket(chainInfoBucket).Put(schemaVersionKey, encodeSchemaVersion(m.Version))
+		}); err != nil {
+			return fmt.Errorf("could not apply database migration %d: %v", m.Version, err)
+		}
+		log.WithField("version", m.Version).Infof("Applied database migration: %s", m.Description)
+	}
+	return nil
+}
+
+// schemaVersion returns the schema version stored in the database. A database without
+// a version is either new, in which case it is stamped with the latest schema version,
+// or was created before schema versioning was introduced, in which case it is at version 0.
+func schemaVersion(tx kvTx) (uint64, error) {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	if chainInfo == nil {
+		return 0, nil
+	}
+	if enc := chainInfo.Get(schemaVersionKey); enc != nil {
+		return binary.BigEndian.Uint64(enc), nil
+	}
+	blocks := tx.Bucket(blockBucket)
+	if k, _ := chainInfo.Cursor().First(); k != nil {
+		return 0, nil
+	}
+	if blocks != nil {
+		if k, _ := blocks.Cursor().First(); k != nil {
+			return 0, nil
+		}
+	}
+	if !tx.Writable() {
+		return LatestSchemaVersion(), nil
+	}
+	return LatestSchemaVersion(), chainInfo.Put(schemaVersionKey, encodeSchemaVersion(LatestSchemaVersion()))
+}
+
+func migrationsSince(version uint64) []*Migration {
+	var pending []*Migration
+	for _, m := range migrations {
+		if m.Version > version {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+func errNewerSchema(version uint64) error {
+	return fmt.Errorf(
+		"database schema version %d is newer than the latest known version %d, please upgrade your beacon node",
+		version,
+		LatestSchemaVersion(),
+	)
+}
+
+func encodeSchemaVersion(version uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, version)
+	return enc
+}
+
+// migrateBigEndianSlotKeys re-encodes the slot prefix of keys from little-endian to
+// big-endian, so that keys are sorted by slot number, and indexes the historical
+// states by block root.
+func migrateBigEndianSlotKeys(tx kvTx) error {
+	isSlotRootKey := func(k []byte) bool {
+		return len(k) == 40
+	}
+	isSlotKey := func(k []byte) bool {
+		return len(k) == 8 || len(k) == 40
+	}
+	if err := swapSlotKeys(tx.Bucket(blockBucket), isSlotRootKey); err != nil {
+		return err
+	}
+	if err := swapSlotKeys(tx.Bucket(mainChainBucket), isSlotKey); err != nil {
+		return err
+	}
+	if err := swapSlotKeys(tx.Bucket(histStateBucket), isSlotRootKey); err != nil {
+		return err
+	}
+
+	histStateRoots := tx.Bucket(histStateRootBucket)
+	if err := tx.Bucket(histStateBucket).ForEach(func(k, v []byte) error {
+		return histStateRoots.Put(k[8:], k[:8])
+	}); err != nil {
+		return err
+	}
+
+	chainInfo := tx.Bucket(chainInfoBucket)
+	if height := chainInfo.Get(mainChainHeightKey); height != nil {
+		return chainInfo.Put(mainChainHeightKey, swapSlotEndianness(height))
+	}
+	return nil
+}
+
+// swapSlotKeys replaces every key of the bucket matched by the filter with a key
+// that has the endianness of its slot prefix swapped.
+func swapSlotKeys(bkt kvBucket, filter func(k []byte) bool) error {
+	var keys, values [][]byte
+	if err := bkt.ForEach(func(k, v []byte) error {
+		if filter(k) {
+			keys = append(keys, append([]byte{}, k...))
+			values = append(values, append([]byte{}, v...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// All old keys are deleted before the new ones are written, as a swapped key
+	// may collide with an old key of a different slot.
+	for _, k := range keys {
+		if err := bkt.Delete(k); err != nil {
+			return err
+		}
+	}
+	for i, k := range keys {
+		if err := bkt.Put(swapSlotEndianness(k), values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func swapSlotEndianness(enc []byte) []byte {
+	swapped := append([]byte{}, enc...)
+	binary.BigEndian.PutUint64(swapped[:8], binary.LittleEndian.Uint64(enc[:8]))
+	return swapped
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestMigrate_NewDBStampedWithLatestVersion(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.view(func(tx kvTx) error {
+		enc := tx.Bucket(chainInfoBucket).Get(schemaVersionKey)
+		if enc == nil {
+			t.Fatal("Expected schema version to be saved")
+		}
+		if version := binary.BigEndian.Uint64(enc); version != LatestSchemaVersion() {
+			t.Errorf("Expected schema version %d, received %d", LatestSchemaVersion(), version)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrate_LittleEndianSlotKeys(t *testing.T) {
+	db := setupDB(t)
+	dirPath := db.DatabasePath
+	defer func() {
+		if err := ClearDB(dirPath); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	root := [32]byte{'A'}
+	legacySlotRoot := func(slot uint64) []byte {
+		enc := make([]byte, 8)
+		binary.LittleEndian.PutUint64(enc, slot)
+		return append(enc, root[:]...)
+	}
+	legacyHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(legacyHeight, 300)
+
+	if err := db.update(func(tx kvTx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		if err := chainInfo.Delete(schemaVersionKey); err != nil {
+			return err
+		}
+		if err := chainInfo.Put(mainChainHeightKey, legacyHeight); err != nil {
+			return err
+		}
+		if err := tx.Bucket(mainChainBucket).Put(legacyHeight, []byte("block")); err != nil {
+			return err
+		}
+		if err := tx.Bucket(blockBucket).Put(legacySlotRoot(300), []byte("block")); err != nil {
+			return err
+		}
+		return tx.Bucket(histStateBucket).Put(legacySlotRoot(300), []byte("state"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	version, pending, err := PendingMigrations(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 0 {
+		t.Errorf("Expected legacy schema version 0, received %d", version)
+	}
+	if len(pending) != len(migrations) {
+		t.Errorf("Expected %d pending migrations, received %d", len(migrations), len(pending))
+	}
+
+	db, err = NewDB(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.view(func(tx kvTx) error {
+		if v := tx.Bucket(blockBucket).Get(encodeSlotNumberRoot(300, root)); !bytes.Equal(v, []byte("block")) {
+			t.Errorf("Expected block to be stored under big-endian key, received %q", v)
+		}
+		if v := tx.Bucket(mainChainBucket).Get(encodeSlotNumber(300)); !bytes.Equal(v, []byte("block")) {
+			t.Errorf("Expected main chain block to be stored under big-endian key, received %q", v)
+		}
+		if v := tx.Bucket(histStateBucket).Get(encodeSlotNumberRoot(300, root)); !bytes.Equal(v, []byte("state")) {
+			t.Errorf("Expected historical state to be stored under big-endian key, received %q", v)
+		}
+		if v := tx.Bucket(histStateRootBucket).Get(root[:]); !bytes.Equal(v, encodeSlotNumber(300)) {
+			t.Errorf("Expected historical state to be indexed by root, received %#x", v)
+		}
+		if v := tx.Bucket(chainInfoBucket).Get(mainChainHeightKey); !bytes.Equal(v, encodeSlotNumber(300)) {
+			t.Errorf("Expected big-endian chain height, received %#x", v)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, pending, err = PendingMigrations(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("Expected no pending migrations after opening the database, received %d", len(pending))
+	}
+}
+
+func TestMigrate_RefusesNewerSchema(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.update(func(tx kvTx) error {
+		return tx.Bucket(chainInfoBucket).Put(schemaVersionKey, encodeSchemaVersion(LatestSchemaVersion()+1))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.migrate(); err == nil || !strings.Contains(err.Error(), "newer than the latest known version") {
+		t.Errorf("Expected error refusing newer schema, received %v", err)
+	}
+}
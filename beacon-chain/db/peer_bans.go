@@ -0,0 +1,34 @@
+package db
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// SavePeerBan records that the peer with the given ID is banned until the given time.
+func (db *BeaconDB) SavePeerBan(peerID string, until time.Time) error {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, uint64(until.Unix()))
+	return db.update(func(tx kvTx) error {
+		return tx.Bucket(peerBanBucket).Put([]byte(peerID), enc)
+	})
+}
+
+// DeletePeerBan lifts the ban of the peer with the given ID.
+func (db *BeaconDB) DeletePeerBan(peerID string) error {
+	return db.update(func(tx kvTx) error {
+		return tx.Bucket(peerBanBucket).Delete([]byte(peerID))
+	})
+}
+
+// PeerBans returns the expiry time of every recorded peer ban, keyed by peer ID.
+func (db *BeaconDB) PeerBans() (map[string]time.Time, error) {
+	bans := make(map[string]time.Time)
+	err := db.view(func(tx kvTx) error {
+		return tx.Bucket(peerBanBucket).ForEach(func(k, v []byte) error {
+			bans[string(k)] = time.Unix(int64(binary.BigEndian.Uint64(v)), 0)
+			return nil
+		})
+	})
+	return bans, err
+}
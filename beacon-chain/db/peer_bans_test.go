@@ -0,0 +1,41 @@
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeerBans_PersistAcrossRestart(t *testing.T) {
+	db := setupDB(t)
+
+	until := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	if err := db.SavePeerBan("peerA", until); err != nil {
+		t.Fatalf("Could not save peer ban: %v", err)
+	}
+	if err := db.SavePeerBan("peerB", until); err != nil {
+		t.Fatalf("Could not save peer ban: %v", err)
+	}
+	if err := db.DeletePeerBan("peerB"); err != nil {
+		t.Fatalf("Could not delete peer ban: %v", err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
+	reopened, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Failed to reopen database: %v", err)
+	}
+	defer teardownDB(t, reopened)
+
+	bans, err := reopened.PeerBans()
+	if err != nil {
+		t.Fatalf("Could not retrieve peer bans: %v", err)
+	}
+	if len(bans) != 1 {
+		t.Fatalf("Wanted 1 peer ban, got %d", len(bans))
+	}
+	if !bans["peerA"].Equal(until) {
+		t.Errorf("Wanted ban until %v, got %v", until, bans["peerA"])
+	}
+}
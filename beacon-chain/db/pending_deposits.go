@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"sort"
 
@@ -28,7 +29,7 @@ type depositContainer struct {
 
 // InsertPendingDeposit into the database. If deposit or block number are nil
 // then this method does nothing.
-func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) {
+func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.InsertPendingDeposit")
 	defer span.End()
 	if d == nil || blockNum == nil {
@@ -36,12 +37,17 @@ func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blo
 			"block":   blockNum,
 			"deposit": d,
 		}).Debug("Ignoring nil deposit insertion")
-		return
+		return nil
 	}
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
-	db.pendingDeposits = append(db.pendingDeposits, &depositContainer{deposit: d, block: blockNum})
+	ctnr := &depositContainer{deposit: d, block: blockNum}
+	if err := db.saveDepositContainer(pendingDepositBucket, ctnr); err != nil {
+		return fmt.Errorf("could not persist pending deposit: %v", err)
+	}
+	db.pendingDeposits = append(db.pendingDeposits, ctnr)
 	pendingDepositsCount.Inc()
+	return nil
 }
 
 // PendingDeposits returns a list of deposits until the given block number
@@ -89,6 +95,11 @@ func (db *BeaconDB) RemovePendingDeposit(ctx context.Context, d *pb.Deposit) {
 	}
 
 	if idx >= 0 {
+		if err := db.update(func(tx kvTx) error {
+			return tx.Bucket(pendingDepositBucket).Delete(encodeDepositIndex(d.MerkleTreeIndex))
+		}); err != nil {
+			log.Errorf("Could not delete persisted pending deposit: %v", err)
+		}
 		db.pendingDeposits = append(db.pendingDeposits[:idx], db.pendingDeposits[idx+1:]...)
 		pendingDepositsCount.Dec()
 	}
@@ -114,6 +125,20 @@ func (db *BeaconDB) PrunePendingDeposits(ctx context.Context, merkleTreeIndex ui
 		}
 	}
 
+	if err := db.update(func(tx kvTx) error {
+		bkt := tx.Bucket(pendingDepositBucket)
+		c := bkt.Cursor()
+		// Keys are sorted by Merkle tree index, so we delete from the first key onwards.
+		for k, _ := c.First(); k != nil && decodeDepositIndex(k) < merkleTreeIndex; k, _ = c.First() {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Errorf("Could not prune persisted pending deposits: %v", err)
+	}
+
 	db.pendingDeposits = cleanDeposits
 	pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
 }
@@ -11,7 +11,8 @@ import (
 )
 
 func TestInsertPendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	db.InsertPendingDeposit(context.Background(), &pb.Deposit{}, big.NewInt(111))
 
 	if len(db.pendingDeposits) != 1 {
@@ -29,7 +30,8 @@ func TestInsertPendingDeposit_ignoresNilDeposit(t *testing.T) {
 }
 
 func TestRemovePendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	depToRemove := &pb.Deposit{MerkleTreeIndex: 1}
 	otherDep := &pb.Deposit{MerkleTreeIndex: 5}
 	db.pendingDeposits = []*depositContainer{
@@ -53,7 +55,8 @@ func TestRemovePendingDeposit_IgnoresNilDeposit(t *testing.T) {
 }
 
 func TestPendingDeposit_RoundTrip(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	dep := &pb.Deposit{MerkleTreeIndex: 123}
 	db.InsertPendingDeposit(context.Background(), dep, big.NewInt(111))
 	db.RemovePendingDeposit(context.Background(), dep)
@@ -115,7 +118,8 @@ func TestPrunePendingDeposits_ZeroMerkleIndex(t *testing.T) {
 }
 
 func TestPrunePendingDeposits_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 
 	db.pendingDeposits = []*depositContainer{
 		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
@@ -158,3 +162,28 @@ func TestPrunePendingDeposits_OK(t *testing.T) {
 	}
 
 }
+
+func TestPendingDeposits_PersistAcrossRestart(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	db.InsertPendingDeposit(ctx, &pb.Deposit{MerkleTreeIndex: 2}, big.NewInt(2))
+	db.InsertPendingDeposit(ctx, &pb.Deposit{MerkleTreeIndex: 4}, big.NewInt(4))
+	db.InsertPendingDeposit(ctx, &pb.Deposit{MerkleTreeIndex: 6}, big.NewInt(6))
+	db.RemovePendingDeposit(ctx, &pb.Deposit{MerkleTreeIndex: 4})
+	db.PrunePendingDeposits(ctx, 3)
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
+	reopened, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Failed to reopen database: %v", err)
+	}
+	defer teardownDB(t, reopened)
+
+	deposits := reopened.PendingDeposits(ctx, nil)
+	if len(deposits) != 1 || deposits[0].MerkleTreeIndex != 6 {
+		t.Errorf("Unexpected deposits. got=%+v", deposits)
+	}
+}
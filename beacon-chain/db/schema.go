@@ -1,7 +1,7 @@
 package db
 
 import (
-	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"encoding/binary"
 )
 
 // The Schema will define how to store and retrieve data from the db.
@@ -18,11 +18,20 @@ var (
 	attestationBucket       = []byte("attestation-bucket")
 	attestationTargetBucket = []byte("attestation-target-bucket")
 	blockOperationsBucket   = []byte("block-operations-bucket")
+	proposerSlashingBucket  = []byte("proposer-slashing-bucket")
+	attesterSlashingBucket  = []byte("attester-slashing-bucket")
 	blockBucket             = []byte("block-bucket")
 	mainChainBucket         = []byte("main-chain-bucket")
 	histStateBucket         = []byte("historical-state-bucket")
+	histStateRootBucket     = []byte("historical-state-root-bucket")
+	histStateDiffBucket     = []byte("historical-state-diff-bucket")
+	archivedStateBucket     = []byte("archived-state-bucket")
 	chainInfoBucket         = []byte("chain-info")
 	validatorBucket         = []byte("validator")
+	depositBucket           = []byte("deposit-bucket")
+	pendingDepositBucket    = []byte("pending-deposit-bucket")
+	chainstartPubkeyBucket  = []byte("chainstart-pubkey-bucket")
+	peerBanBucket           = []byte("peer-ban-bucket")
 
 	mainChainHeightKey      = []byte("chain-height")
 	canonicalHeadKey        = []byte("canonical-head")
@@ -31,22 +40,37 @@ var (
 	justifiedStateLookupKey = []byte("justified-state")
 	finalizedBlockLookupKey = []byte("finalized-block")
 	justifiedBlockLookupKey = []byte("justified-block")
+	lastETH1BlockLookupKey  = []byte("last-processed-eth1-block")
+	schemaVersionKey        = []byte("schema-version")
 
 	// DB internal use
 	cleanupHistoryBucket = []byte("cleanup-history-bucket")
 )
 
+// allBuckets lists every bucket of the database, they are created when it is opened.
+var allBuckets = [][]byte{
+	blockBucket, attestationBucket, attestationTargetBucket, mainChainBucket,
+	histStateBucket, histStateRootBucket, histStateDiffBucket, archivedStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket,
+	depositBucket, pendingDepositBucket, chainstartPubkeyBucket, proposerSlashingBucket, attesterSlashingBucket,
+	peerBanBucket,
+}
+
+// encodeSlotNumberRoot encodes a slot number followed by a block root. As the
+// slot is big-endian encoded, keys sort by slot number in boltdb which allows
+// range lookups with a cursor.
 func encodeSlotNumberRoot(number uint64, root [32]byte) []byte {
-	return append(bytesutil.Bytes8(number), root[:]...)
+	return append(encodeSlotNumber(number), root[:]...)
 }
 
-// encodeSlotNumber encodes a slot number as little-endian uint32.
+// encodeSlotNumber encodes a slot number as big-endian uint64.
 func encodeSlotNumber(number uint64) []byte {
-	return bytesutil.Bytes8(number)
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, number)
+	return enc
 }
 
-// decodeSlotNumber returns a slot number which has been
-// encoded as a little-endian uint32 in the byte array.
+// decodeToSlotNumber returns a slot number which has been
+// encoded as a big-endian uint64 in the byte array.
 func decodeToSlotNumber(bytearray []byte) uint64 {
-	return bytesutil.FromBytes8(bytearray)
+	return binary.BigEndian.Uint64(bytearray)
 }
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveProposerSlashing puts the proposer slashing into the beacon chain db, where
+// it is kept until it gets included in a block.
+func (db *BeaconDB) SaveProposerSlashing(ctx context.Context, slashing *pb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveProposerSlashing")
+	defer span.End()
+
+	return db.saveOperation(proposerSlashingBucket, slashing)
+}
+
+// SaveAttesterSlashing puts the attester slashing into the beacon chain db, where
+// it is kept until it gets included in a block.
+func (db *BeaconDB) SaveAttesterSlashing(ctx context.Context, slashing *pb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveAttesterSlashing")
+	defer span.End()
+
+	return db.saveOperation(attesterSlashingBucket, slashing)
+}
+
+// DeleteProposerSlashing deletes the proposer slashing from the beacon chain db.
+func (db *BeaconDB) DeleteProposerSlashing(slashing *pb.ProposerSlashing) error {
+	return db.deleteOperation(proposerSlashingBucket, slashing)
+}
+
+// DeleteAttesterSlashing deletes the attester slashing from the beacon chain db.
+func (db *BeaconDB) DeleteAttesterSlashing(slashing *pb.AttesterSlashing) error {
+	return db.deleteOperation(attesterSlashingBucket, slashing)
+}
+
+// HasProposerSlashing checks if the proposer slashing exists.
+func (db *BeaconDB) HasProposerSlashing(hash [32]byte) bool {
+	return db.hasOperation(proposerSlashingBucket, hash)
+}
+
+// HasAttesterSlashing checks if the attester slashing exists.
+func (db *BeaconDB) HasAttesterSlashing(hash [32]byte) bool {
+	return db.hasOperation(attesterSlashingBucket, hash)
+}
+
+// ProposerSlashing retrieves a proposer slashing from the db using its hash.
+func (db *BeaconDB) ProposerSlashing(hash [32]byte) (*pb.ProposerSlashing, error) {
+	var slashing *pb.ProposerSlashing
+	err := db.view(func(tx kvTx) error {
+		enc := tx.Bucket(proposerSlashingBucket).Get(hash[:])
+		if enc == nil {
+			return nil
+		}
+		slashing = &pb.ProposerSlashing{}
+		return unmarshalOperation(enc, slashing)
+	})
+	return slashing, err
+}
+
+// AttesterSlashing retrieves an attester slashing from the db using its hash.
+func (db *BeaconDB) AttesterSlashing(hash [32]byte) (*pb.AttesterSlashing, error) {
+	var slashing *pb.AttesterSlashing
+	err := db.view(func(tx kvTx) error {
+		enc := tx.Bucket(attesterSlashingBucket).Get(hash[:])
+		if enc == nil {
+			return nil
+		}
+		slashing = &pb.AttesterSlashing{}
+		return unmarshalOperation(enc, slashing)
+	})
+	return slashing, err
+}
+
+// ProposerSlashings retrieves all the proposer slashings which have not been
+// included in a block yet.
+func (db *BeaconDB) ProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	var slashings []*pb.ProposerSlashing
+	err := db.view(func(tx kvTx) error {
+		return tx.Bucket(proposerSlashingBucket).ForEach(func(k, v []byte) error {
+			slashing := &pb.ProposerSlashing{}
+			if err := unmarshalOperation(v, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+// AttesterSlashings retrieves all the attester slashings which have not been
+// included in a block yet.
+func (db *BeaconDB) AttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	var slashings []*pb.AttesterSlashing
+	err := db.view(func(tx kvTx) error {
+		return tx.Bucket(attesterSlashingBucket).ForEach(func(k, v []byte) error {
+			slashing := &pb.AttesterSlashing{}
+			if err := unmarshalOperation(v, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+func (db *BeaconDB) saveOperation(bucket []byte, op proto.Message) error {
+	hash, err := hashutil.HashProto(op)
+	if err != nil {
+		return err
+	}
+	enc, err := proto.Marshal(op)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx kvTx) error {
+		return tx.Bucket(bucket).Put(hash[:], enc)
+	})
+}
+
+func (db *BeaconDB) deleteOperation(bucket []byte, op proto.Message) error {
+	hash, err := hashutil.HashProto(op)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx kvTx) error {
+		return tx.Bucket(bucket).Delete(hash[:])
+	})
+}
+
+func (db *BeaconDB) hasOperation(bucket []byte, hash [32]byte) bool {
+	exists := false
+	if err := db.view(func(tx kvTx) error {
+		exists = tx.Bucket(bucket).Get(hash[:]) != nil
+		return nil
+	}); err != nil {
+		return false
+	}
+	return exists
+}
+
+func unmarshalOperation(enc []byte, op proto.Message) error {
+	if err := proto.Unmarshal(enc, op); err != nil {
+		return fmt.Errorf("failed to unmarshal encoding: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestProposerSlashing_SaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:  3,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 10},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 10},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.HasProposerSlashing(hash) {
+		t.Fatal("Expected HasProposerSlashing to return false")
+	}
+	if err := db.SaveProposerSlashing(context.Background(), slashing); err != nil {
+		t.Fatalf("Failed to save proposer slashing: %v", err)
+	}
+	if !db.HasProposerSlashing(hash) {
+		t.Fatal("Expected HasProposerSlashing to return true")
+	}
+	retrieved, err := db.ProposerSlashing(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(slashing, retrieved) {
+		t.Errorf("Wanted %v, received %v", slashing, retrieved)
+	}
+	all, err := db.ProposerSlashings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Errorf("Expected 1 proposer slashing, received %d", len(all))
+	}
+
+	if err := db.DeleteProposerSlashing(slashing); err != nil {
+		t.Fatalf("Failed to delete proposer slashing: %v", err)
+	}
+	if db.HasProposerSlashing(hash) {
+		t.Error("Expected proposer slashing to be deleted")
+	}
+}
+
+func TestAttesterSlashing_SaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1, 2}},
+		SlashableAttestation_2: &pb.SlashableAttestation{ValidatorIndices: []uint64{2, 3}},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashing(context.Background(), slashing); err != nil {
+		t.Fatalf("Failed to save attester slashing: %v", err)
+	}
+	retrieved, err := db.AttesterSlashing(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(slashing, retrieved) {
+		t.Errorf("Wanted %v, received %v", slashing, retrieved)
+	}
+	if err := db.DeleteAttesterSlashing(slashing); err != nil {
+		t.Fatalf("Failed to delete attester slashing: %v", err)
+	}
+	all, err := db.AttesterSlashings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 0 {
+		t.Errorf("Expected no attester slashings left, received %d", len(all))
+	}
+}
@@ -6,10 +6,10 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/prysmaticlabs/prysm/shared/params"
 
-	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -56,7 +56,7 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 		return err
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		blockBkt := tx.Bucket(blockBucket)
 		validatorBkt := tx.Bucket(validatorBucket)
 		mainChain := tx.Bucket(mainChainBucket)
@@ -91,6 +91,9 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 		if err := chainInfo.Put(finalizedStateLookupKey, stateEnc); err != nil {
 			return err
 		}
+		if err := db.saveArchivedState(tx, beaconState); err != nil {
+			return err
+		}
 
 		return chainInfo.Put(stateLookupKey, stateEnc)
 	})
@@ -123,7 +126,7 @@ func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 	}
 
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		enc := chainInfo.Get(stateLookupKey)
 		if enc == nil {
@@ -186,7 +189,7 @@ func (db *BeaconDB) SaveState(ctx context.Context, beaconState *pb.BeaconState)
 		}
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 
 		stateBytes.Set(float64(len(enc)))
@@ -197,7 +200,7 @@ func (db *BeaconDB) SaveState(ctx context.Context, beaconState *pb.BeaconState)
 
 // SaveJustifiedState saves the last justified state in the db.
 func (db *BeaconDB) SaveJustifiedState(beaconState *pb.BeaconState) error {
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		beaconStateEnc, err := proto.Marshal(beaconState)
 		if err != nil {
@@ -214,12 +217,15 @@ func (db *BeaconDB) SaveFinalizedState(beaconState *pb.BeaconState) error {
 	if err := db.deleteHistoricalStates(beaconState.Slot); err != nil {
 		return err
 	}
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		beaconStateEnc, err := proto.Marshal(beaconState)
 		if err != nil {
 			return err
 		}
+		if err := db.saveArchivedState(tx, beaconState); err != nil {
+			return err
+		}
 		return chainInfo.Put(finalizedStateLookupKey, beaconStateEnc)
 	})
 }
@@ -235,24 +241,23 @@ func (db *BeaconDB) SaveHistoricalState(ctx context.Context, beaconState *pb.Bea
 		return err
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		histState := tx.Bucket(histStateBucket)
-		chainInfo := tx.Bucket(chainInfoBucket)
-		if err := histState.Put(slotRootBinary, stateHash[:]); err != nil {
+		histStateRoots := tx.Bucket(histStateRootBucket)
+		if err := db.putHistoricalState(tx, beaconState, stateHash); err != nil {
 			return err
 		}
-		beaconStateEnc, err := proto.Marshal(beaconState)
-		if err != nil {
+		if err := histState.Put(slotRootBinary, stateHash[:]); err != nil {
 			return err
 		}
-		return chainInfo.Put(stateHash[:], beaconStateEnc)
+		return histStateRoots.Put(blockRoot[:], encodeSlotNumber(beaconState.Slot))
 	})
 }
 
 // JustifiedState retrieves the justified state from the db.
 func (db *BeaconDB) JustifiedState() (*pb.BeaconState, error) {
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		encState := chainInfo.Get(justifiedStateLookupKey)
 		if encState == nil {
@@ -269,7 +274,7 @@ func (db *BeaconDB) JustifiedState() (*pb.BeaconState, error) {
 // FinalizedState retrieves the finalized state from the db.
 func (db *BeaconDB) FinalizedState() (*pb.BeaconState, error) {
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		encState := chainInfo.Get(finalizedStateLookupKey)
 		if encState == nil {
@@ -284,7 +289,8 @@ func (db *BeaconDB) FinalizedState() (*pb.BeaconState, error) {
 }
 
 // HistoricalStateFromSlot retrieves the state that is closest to the input slot,
-// while being smaller than or equal to the input slot.
+// while being smaller than or equal to the input slot. The state saved for the
+// given block root is returned when it exists at the requested slot.
 func (db *BeaconDB) HistoricalStateFromSlot(ctx context.Context, slot uint64, blockRoot [32]byte) (*pb.BeaconState, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
@@ -293,58 +299,54 @@ func (db *BeaconDB) HistoricalStateFromSlot(ctx context.Context, slot uint64, bl
 	defer span.End()
 	span.AddAttributes(trace.Int64Attribute("slotSinceGenesis", int64(slot)))
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		var err error
-		var highestStateSlot uint64
-		var stateExists bool
-		histStateKey := make([]byte, 32)
+		var histStateKey []byte
 
-		chainInfo := tx.Bucket(chainInfoBucket)
 		histState := tx.Bucket(histStateBucket)
-		hsCursor := histState.Cursor()
-
-		for k, v := hsCursor.First(); k != nil; k, v = hsCursor.Next() {
-			slotBinary := k[:8]
-			blockRootBinary := k[8:]
-			slotNumber := decodeToSlotNumber(slotBinary)
+		histStateRoots := tx.Bucket(histStateRootBucket)
 
-			if slotNumber == slot && bytes.Equal(blockRootBinary, blockRoot[:]) {
-				stateExists = true
-				highestStateSlot = slotNumber
-				histStateKey = v
-				break
-			}
+		// Look up the exact slot and block root through the root index first.
+		if enc := histStateRoots.Get(blockRoot[:]); enc != nil && decodeToSlotNumber(enc) == slot {
+			histStateKey = histState.Get(encodeSlotNumberRoot(slot, blockRoot))
 		}
 
-		// If no historical state exists, retrieve and decode the finalized state.
-		if !stateExists {
-			for k, v := hsCursor.First(); k != nil; k, v = hsCursor.Next() {
-				slotBinary := k[:8]
-				slotNumber := decodeToSlotNumber(slotBinary)
-				// find the state with slot closest to the requested slot
-				if slotNumber > highestStateSlot && slotNumber <= slot {
-					stateExists = true
-					highestStateSlot = slotNumber
-					histStateKey = v
-				}
-			}
-
-			if !stateExists {
+		// Otherwise, seek to the first key after the requested slot and step back
+		// to find the state with the slot closest to the requested slot.
+		if histStateKey == nil {
+			histStateKey = closestHistoricalStateKey(histState.Cursor(), slot)
+			if histStateKey == nil {
 				return errors.New("no historical states saved in db")
 			}
 		}
 
-		// If historical state exists, retrieve and decode it.
-		encState := chainInfo.Get(histStateKey)
-		if encState == nil {
-			return errors.New("no historical state saved")
-		}
-		beaconState, err = createState(encState)
+		// If historical state exists, retrieve and reconstruct it.
+		beaconState, err = historicalState(tx, histStateKey)
 		return err
 	})
 	return beaconState, err
 }
 
+// closestHistoricalStateKey returns the state hash saved for the highest slot
+// smaller than or equal to the input slot, or nil if there is none.
+func closestHistoricalStateKey(c kvCursor, slot uint64) []byte {
+	var k, v []byte
+	if slot == math.MaxUint64 {
+		k, v = c.Last()
+	} else {
+		k, _ = c.Seek(encodeSlotNumber(slot + 1))
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+	}
+	if k == nil || decodeToSlotNumber(k[:8]) > slot {
+		return nil
+	}
+	return v
+}
+
 // ValidatorRegistry fetches the current validator registry stored in state.
 func (db *BeaconDB) ValidatorRegistry(ctx context.Context) ([]*pb.Validator, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorRegistry")
@@ -365,7 +367,7 @@ func (db *BeaconDB) ValidatorRegistry(ctx context.Context) ([]*pb.Validator, err
 	}
 
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		enc := chainInfo.Get(stateLookupKey)
 		if enc == nil {
@@ -401,7 +403,7 @@ func (db *BeaconDB) ValidatorFromState(ctx context.Context, index uint64) (*pb.V
 	}
 
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		enc := chainInfo.Get(stateLookupKey)
 		if enc == nil {
@@ -442,7 +444,7 @@ func (db *BeaconDB) ValidatorBalances(ctx context.Context) ([]uint64, error) {
 	}
 
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		enc := chainInfo.Get(stateLookupKey)
 		if enc == nil {
@@ -473,19 +475,42 @@ func (db *BeaconDB) deleteHistoricalStates(slot uint64) error {
 	if featureconfig.FeatureConfig().DisableHistoricalStatePruning {
 		return nil
 	}
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		histState := tx.Bucket(histStateBucket)
+		histStateRoots := tx.Bucket(histStateRootBucket)
+		histStateDiffs := tx.Bucket(histStateDiffBucket)
 		chainInfo := tx.Bucket(chainInfoBucket)
 		hsCursor := histState.Cursor()
 
-		for k, v := hsCursor.First(); k != nil; k, v = hsCursor.Next() {
-			slotBinary := k[:8]
-			keySlotNumber := decodeToSlotNumber(slotBinary)
-			if keySlotNumber < slot {
-				if err := histState.Delete(k); err != nil {
-					return err
-				}
-				if err := chainInfo.Delete(v); err != nil {
+		// Snapshots which the remaining states of the period are reconstructed from are kept.
+		snapshots, err := db.referencedSnapshots(tx, slot)
+		if err != nil {
+			return err
+		}
+
+		// Keys are sorted by slot, so we can stop at the first key past the given slot.
+		var staleKeys [][]byte
+		for k, _ := hsCursor.First(); k != nil && decodeToSlotNumber(k[:8]) < slot; k, _ = hsCursor.Next() {
+			staleKeys = append(staleKeys, append([]byte{}, k...))
+		}
+
+		for _, k := range staleKeys {
+			stateHash := histState.Get(k)
+			if snapshots[string(stateHash)] {
+				continue
+			}
+			if err := chainInfo.Delete(stateHash); err != nil {
+				return err
+			}
+			if err := histStateDiffs.Delete(stateHash); err != nil {
+				return err
+			}
+			if err := histState.Delete(k); err != nil {
+				return err
+			}
+			blockRoot := k[8:]
+			if bytes.Equal(histStateRoots.Get(blockRoot), k[:8]) {
+				if err := histStateRoots.Delete(blockRoot); err != nil {
 					return err
 				}
 			}
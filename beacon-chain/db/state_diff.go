@@ -0,0 +1,236 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// defaultStateSnapshotEpochs is the default number of epochs between full
+// historical state snapshots.
+const defaultStateSnapshotEpochs = 8
+
+// SetStateSnapshotInterval sets the number of epochs between full historical state
+// snapshots. Historical states in between are stored as diffs from the snapshot of
+// their period, so longer intervals use less disk and take longer to read.
+func (db *BeaconDB) SetStateSnapshotInterval(snapshotEpochs uint64) {
+	if snapshotEpochs == 0 {
+		snapshotEpochs = 1
+	}
+	db.stateSnapshotEpochs = snapshotEpochs
+}
+
+// putHistoricalState stores the encoding of a historical state under its hash,
+// either as a full snapshot in the chain info bucket or as a diff from the
+// snapshot of its period in the historical state diff bucket.
+func (db *BeaconDB) putHistoricalState(tx kvTx, beaconState *pb.BeaconState, stateHash [32]byte) error {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	histStateDiffs := tx.Bucket(histStateDiffBucket)
+
+	snapshotHash := db.snapshotHashForSlot(tx, beaconState.Slot)
+	if snapshotHash == nil || bytes.Equal(snapshotHash, stateHash[:]) {
+		enc, err := proto.Marshal(beaconState)
+		if err != nil {
+			return err
+		}
+		if err := histStateDiffs.Delete(stateHash[:]); err != nil {
+			return err
+		}
+		return chainInfo.Put(stateHash[:], enc)
+	}
+
+	snapshot, err := createState(chainInfo.Get(snapshotHash))
+	if err != nil {
+		return err
+	}
+	enc, err := proto.Marshal(diffState(snapshot, beaconState, snapshotHash))
+	if err != nil {
+		return err
+	}
+	return histStateDiffs.Put(stateHash[:], enc)
+}
+
+// historicalState retrieves the historical state saved under the given hash,
+// applying its diff to the snapshot it was taken from if it is not a snapshot.
+func historicalState(tx kvTx, stateHash []byte) (*pb.BeaconState, error) {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	histStateDiffs := tx.Bucket(histStateDiffBucket)
+
+	if enc := chainInfo.Get(stateHash); enc != nil {
+		return createState(enc)
+	}
+	enc := histStateDiffs.Get(stateHash)
+	if enc == nil {
+		return nil, errors.New("no historical state saved")
+	}
+	diff := &pb.BeaconStateDiff{}
+	if err := proto.Unmarshal(enc, diff); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal encoding: %v", err)
+	}
+	snapshotEnc := chainInfo.Get(diff.SnapshotHash32)
+	if snapshotEnc == nil {
+		return nil, fmt.Errorf("no state snapshot saved for historical state %#x", stateHash)
+	}
+	snapshot, err := createState(snapshotEnc)
+	if err != nil {
+		return nil, err
+	}
+	return applyStateDiff(snapshot, diff), nil
+}
+
+// snapshotHashForSlot returns the hash of the latest state snapshot saved in the
+// snapshot period of the given slot, or nil if the period has no snapshot yet.
+func (db *BeaconDB) snapshotHashForSlot(tx kvTx, slot uint64) []byte {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	c := tx.Bucket(histStateBucket).Cursor()
+
+	period := db.snapshotPeriod(slot)
+	k, v := c.Seek(encodeSlotNumber(slot + 1))
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+	for ; k != nil; k, v = c.Prev() {
+		if db.snapshotPeriod(decodeToSlotNumber(k[:8])) != period {
+			return nil
+		}
+		if chainInfo.Get(v) != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// referencedSnapshots returns the hashes of the snapshots which the diffs saved from
+// the given slot until the end of its snapshot period are taken from.
+func (db *BeaconDB) referencedSnapshots(tx kvTx, slot uint64) (map[string]bool, error) {
+	histStateDiffs := tx.Bucket(histStateDiffBucket)
+	c := tx.Bucket(histStateBucket).Cursor()
+
+	period := db.snapshotPeriod(slot)
+	snapshots := make(map[string]bool)
+	for k, v := c.Seek(encodeSlotNumber(slot)); k != nil && db.snapshotPeriod(decodeToSlotNumber(k[:8])) == period; k, v = c.Next() {
+		enc := histStateDiffs.Get(v)
+		if enc == nil {
+			continue
+		}
+		diff := &pb.BeaconStateDiff{}
+		if err := proto.Unmarshal(enc, diff); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal encoding: %v", err)
+		}
+		snapshots[string(diff.SnapshotHash32)] = true
+	}
+	return snapshots, nil
+}
+
+func (db *BeaconDB) snapshotPeriod(slot uint64) uint64 {
+	return helpers.SlotToEpoch(slot) / db.stateSnapshotEpochs
+}
+
+// diffState returns the difference of the given state from the snapshot.
+func diffState(snapshot *pb.BeaconState, beaconState *pb.BeaconState, snapshotHash []byte) *pb.BeaconStateDiff {
+	partialState := proto.Clone(beaconState).(*pb.BeaconState)
+	partialState.ValidatorRegistry = nil
+	partialState.ValidatorBalances = nil
+	partialState.LatestRandaoMixes = nil
+	partialState.LatestBlockRootHash32S = nil
+	partialState.LatestIndexRootHash32S = nil
+	partialState.LatestSlashedBalances = nil
+
+	return &pb.BeaconStateDiff{
+		SnapshotHash32:         snapshotHash,
+		PartialState:           partialState,
+		ValidatorRegistry:      diffValidators(snapshot.ValidatorRegistry, beaconState.ValidatorRegistry),
+		ValidatorBalances:      diffUint64s(snapshot.ValidatorBalances, beaconState.ValidatorBalances),
+		LatestRandaoMixes:      diffBytes(snapshot.LatestRandaoMixes, beaconState.LatestRandaoMixes),
+		LatestBlockRootHash32S: diffBytes(snapshot.LatestBlockRootHash32S, beaconState.LatestBlockRootHash32S),
+		LatestIndexRootHash32S: diffBytes(snapshot.LatestIndexRootHash32S, beaconState.LatestIndexRootHash32S),
+		LatestSlashedBalances:  diffUint64s(snapshot.LatestSlashedBalances, beaconState.LatestSlashedBalances),
+	}
+}
+
+// applyStateDiff reconstructs a state from the snapshot and the diff taken from it.
+func applyStateDiff(snapshot *pb.BeaconState, diff *pb.BeaconStateDiff) *pb.BeaconState {
+	beaconState := proto.Clone(diff.PartialState).(*pb.BeaconState)
+	beaconState.ValidatorRegistry = applyValidatorsDiff(snapshot.ValidatorRegistry, diff.ValidatorRegistry)
+	beaconState.ValidatorBalances = applyUint64sDiff(snapshot.ValidatorBalances, diff.ValidatorBalances)
+	beaconState.LatestRandaoMixes = applyBytesDiff(snapshot.LatestRandaoMixes, diff.LatestRandaoMixes)
+	beaconState.LatestBlockRootHash32S = applyBytesDiff(snapshot.LatestBlockRootHash32S, diff.LatestBlockRootHash32S)
+	beaconState.LatestIndexRootHash32S = applyBytesDiff(snapshot.LatestIndexRootHash32S, diff.LatestIndexRootHash32S)
+	beaconState.LatestSlashedBalances = applyUint64sDiff(snapshot.LatestSlashedBalances, diff.LatestSlashedBalances)
+	return beaconState
+}
+
+func diffValidators(base []*pb.Validator, list []*pb.Validator) *pb.ValidatorListDiff {
+	diff := &pb.ValidatorListDiff{Length: uint64(len(list))}
+	for i, v := range list {
+		if i >= len(base) || !proto.Equal(base[i], v) {
+			diff.Indices = append(diff.Indices, uint64(i))
+			diff.Values = append(diff.Values, v)
+		}
+	}
+	return diff
+}
+
+func applyValidatorsDiff(base []*pb.Validator, diff *pb.ValidatorListDiff) []*pb.Validator {
+	if diff == nil || diff.Length == 0 {
+		return nil
+	}
+	list := make([]*pb.Validator, diff.Length)
+	copy(list, base)
+	for i, index := range diff.Indices {
+		list[index] = diff.Values[i]
+	}
+	return list
+}
+
+func diffUint64s(base []uint64, list []uint64) *pb.Uint64ListDiff {
+	diff := &pb.Uint64ListDiff{Length: uint64(len(list))}
+	for i, v := range list {
+		if i >= len(base) || base[i] != v {
+			diff.Indices = append(diff.Indices, uint64(i))
+			diff.Values = append(diff.Values, v)
+		}
+	}
+	return diff
+}
+
+func applyUint64sDiff(base []uint64, diff *pb.Uint64ListDiff) []uint64 {
+	if diff == nil || diff.Length == 0 {
+		return nil
+	}
+	list := make([]uint64, diff.Length)
+	copy(list, base)
+	for i, index := range diff.Indices {
+		list[index] = diff.Values[i]
+	}
+	return list
+}
+
+func diffBytes(base [][]byte, list [][]byte) *pb.BytesListDiff {
+	diff := &pb.BytesListDiff{Length: uint64(len(list))}
+	for i, v := range list {
+		if i >= len(base) || !bytes.Equal(base[i], v) {
+			diff.Indices = append(diff.Indices, uint64(i))
+			diff.Values = append(diff.Values, v)
+		}
+	}
+	return diff
+}
+
+func applyBytesDiff(base [][]byte, diff *pb.BytesListDiff) [][]byte {
+	if diff == nil || diff.Length == 0 {
+		return nil
+	}
+	list := make([][]byte, diff.Length)
+	copy(list, base)
+	for i, index := range diff.Indices {
+		list[index] = diff.Values[i]
+	}
+	return list
+}
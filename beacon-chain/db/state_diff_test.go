@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestHistoricalState_SavedAsDiffFromSnapshot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	snapshot := &pb.BeaconState{
+		Slot: genesisSlot,
+		ValidatorRegistry: []*pb.Validator{
+			{Pubkey: []byte{'A'}, ExitEpoch: 10},
+			{Pubkey: []byte{'B'}, ExitEpoch: 10},
+		},
+		ValidatorBalances:      []uint64{32, 32},
+		LatestRandaoMixes:      [][]byte{{1}, {2}, {3}},
+		LatestBlockRootHash32S: [][]byte{{4}, {5}, {6}},
+		LatestSlashedBalances:  []uint64{0, 0, 0},
+	}
+	state := proto.Clone(snapshot).(*pb.BeaconState)
+	state.Slot = genesisSlot + 1
+	state.ValidatorRegistry[1].ExitEpoch = 5
+	state.ValidatorRegistry = append(state.ValidatorRegistry, &pb.Validator{Pubkey: []byte{'C'}})
+	state.ValidatorBalances = []uint64{31, 32, 32}
+	state.LatestRandaoMixes[1] = []byte{7}
+	state.LatestSlashedBalances = nil
+
+	if err := db.SaveHistoricalState(ctx, snapshot, [32]byte{'A'}); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+	if err := db.SaveHistoricalState(ctx, state, [32]byte{'B'}); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+
+	var snapshots, diffs int
+	if err := db.view(func(tx kvTx) error {
+		return tx.Bucket(histStateBucket).ForEach(func(k, v []byte) error {
+			if tx.Bucket(chainInfoBucket).Get(v) != nil {
+				snapshots++
+			}
+			if tx.Bucket(histStateDiffBucket).Get(v) != nil {
+				diffs++
+			}
+			return nil
+		})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if snapshots != 1 || diffs != 1 {
+		t.Errorf("Expected one snapshot and one diff, received %d snapshots and %d diffs", snapshots, diffs)
+	}
+
+	retState, err := db.HistoricalStateFromSlot(ctx, state.Slot, [32]byte{'B'})
+	if err != nil {
+		t.Fatalf("Unable to retrieve state %v", err)
+	}
+	if !proto.Equal(state, retState) {
+		t.Errorf("Saved and retrieved states are not equal got\n %v but wanted\n %v", proto.MarshalTextString(retState), proto.MarshalTextString(state))
+	}
+	retState, err = db.HistoricalStateFromSlot(ctx, snapshot.Slot, [32]byte{'A'})
+	if err != nil {
+		t.Fatalf("Unable to retrieve state %v", err)
+	}
+	if !proto.Equal(snapshot, retState) {
+		t.Errorf("Saved and retrieved states are not equal got\n %v but wanted\n %v", proto.MarshalTextString(retState), proto.MarshalTextString(snapshot))
+	}
+}
+
+func TestHistoricalState_SnapshotEveryPeriod(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	periodSlots := db.stateSnapshotEpochs * params.BeaconConfig().SlotsPerEpoch
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	slots := []uint64{genesisSlot, genesisSlot + 1, genesisSlot + periodSlots + 3, genesisSlot + periodSlots + 4}
+	for i, slot := range slots {
+		if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: slot}, [32]byte{byte(i)}); err != nil {
+			t.Fatalf("could not save historical state: %v", err)
+		}
+	}
+
+	wantSnapshot := []bool{true, false, true, false}
+	if err := db.view(func(tx kvTx) error {
+		for i, slot := range slots {
+			stateHash := tx.Bucket(histStateBucket).Get(encodeSlotNumberRoot(slot, [32]byte{byte(i)}))
+			if isSnapshot := tx.Bucket(chainInfoBucket).Get(stateHash) != nil; isSnapshot != wantSnapshot[i] {
+				t.Errorf("Expected snapshot %t for state at slot %d, received %t", wantSnapshot[i], slot-genesisSlot, isSnapshot)
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHistoricalState_ConfiguredSnapshotInterval(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	db.SetStateSnapshotInterval(2)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	slot := genesisSlot + 2*params.BeaconConfig().SlotsPerEpoch
+	if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: genesisSlot}, [32]byte{'A'}); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+	if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: slot}, [32]byte{'B'}); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+
+	if err := db.view(func(tx kvTx) error {
+		stateHash := tx.Bucket(histStateBucket).Get(encodeSlotNumberRoot(slot, [32]byte{'B'}))
+		if tx.Bucket(chainInfoBucket).Get(stateHash) == nil {
+			t.Errorf("Expected a snapshot at the start of the second period, at slot %d", slot-genesisSlot)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHistoricalState_PruningKeepsReferencedSnapshot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	snapshot := &pb.BeaconState{Slot: genesisSlot, ValidatorBalances: []uint64{1, 2, 3}}
+	state := &pb.BeaconState{Slot: genesisSlot + 10, ValidatorBalances: []uint64{1, 2, 4}}
+	if err := db.SaveHistoricalState(ctx, snapshot, [32]byte{'A'}); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+	if err := db.SaveHistoricalState(ctx, state, [32]byte{'B'}); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+
+	if err := db.deleteHistoricalStates(state.Slot); err != nil {
+		t.Fatalf("Could not delete historical states %v", err)
+	}
+
+	retState, err := db.HistoricalStateFromSlot(ctx, state.Slot, [32]byte{'B'})
+	if err != nil {
+		t.Fatalf("Unable to retrieve state %v", err)
+	}
+	if !proto.Equal(state, retState) {
+		t.Errorf("Saved and retrieved states are not equal got\n %v but wanted\n %v", proto.MarshalTextString(retState), proto.MarshalTextString(state))
+	}
+}
@@ -384,3 +384,83 @@ func TestHistoricalState_Pruning(t *testing.T) {
 
 	}
 }
+
+func TestHistoricalState_ClosestLowerSlot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	savedSlots := []uint64{10, 20, 30, 256, 257, 1 << 20}
+	for i, slot := range savedSlots {
+		root := [32]byte{byte(i + 1)}
+		if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: slot}, root); err != nil {
+			t.Fatalf("could not save historical state: %v", err)
+		}
+	}
+
+	tests := []struct {
+		slot     uint64
+		wantSlot uint64
+	}{
+		{slot: 10, wantSlot: 10},
+		{slot: 19, wantSlot: 10},
+		{slot: 255, wantSlot: 30},
+		{slot: 256, wantSlot: 256},
+		{slot: 1000, wantSlot: 257},
+		{slot: 1 << 30, wantSlot: 1 << 20},
+	}
+	for _, tt := range tests {
+		// Use a root that was never saved so that only the slot is used for the lookup.
+		retState, err := db.HistoricalStateFromSlot(ctx, tt.slot, [32]byte{'a'})
+		if err != nil {
+			t.Fatalf("Unable to retrieve state %v", err)
+		}
+		if retState.Slot != tt.wantSlot {
+			t.Errorf("Wanted state at slot %d for slot %d, got %d", tt.wantSlot, tt.slot, retState.Slot)
+		}
+	}
+
+	if _, err := db.HistoricalStateFromSlot(ctx, 9, [32]byte{}); err == nil {
+		t.Error("Expected error when no state exists below the requested slot")
+	}
+}
+
+func TestHistoricalState_ExactBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// Two forks saving a state at the same slot.
+	state1 := &pb.BeaconState{Slot: 64, FinalizedEpoch: 1}
+	state2 := &pb.BeaconState{Slot: 64, FinalizedEpoch: 2}
+	root1 := [32]byte{'A'}
+	root2 := [32]byte{'B'}
+	if err := db.SaveHistoricalState(ctx, state1, root1); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+	if err := db.SaveHistoricalState(ctx, state2, root2); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+
+	retState, err := db.HistoricalStateFromSlot(ctx, 64, root1)
+	if err != nil {
+		t.Fatalf("Unable to retrieve state %v", err)
+	}
+	if !proto.Equal(state1, retState) {
+		t.Errorf("Wanted state %v, got %v", state1, retState)
+	}
+	retState, err = db.HistoricalStateFromSlot(ctx, 64, root2)
+	if err != nil {
+		t.Fatalf("Unable to retrieve state %v", err)
+	}
+	if !proto.Equal(state2, retState) {
+		t.Errorf("Wanted state %v, got %v", state2, retState)
+	}
+
+	if err := db.deleteHistoricalStates(65); err != nil {
+		t.Fatalf("Could not delete historical states %v", err)
+	}
+	if _, err := db.HistoricalStateFromSlot(ctx, 64, root1); err == nil {
+		t.Error("Expected error after pruning historical states")
+	}
+}
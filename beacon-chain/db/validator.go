@@ -6,7 +6,6 @@ import (
 	"encoding/binary"
 	"fmt"
 
-	"github.com/boltdb/bolt"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
@@ -15,7 +14,7 @@ import (
 func (db *BeaconDB) SaveValidatorIndex(pubKey []byte, index int) error {
 	h := hashutil.Hash(pubKey)
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		bucket := tx.Bucket(validatorBucket)
 
 		buf := make([]byte, binary.MaxVarintLen64)
@@ -29,7 +28,7 @@ func (db *BeaconDB) SaveValidatorIndex(pubKey []byte, index int) error {
 func (db *BeaconDB) SaveValidatorIndexBatch(pubKey []byte, index int) error {
 	h := hashutil.Hash(pubKey)
 
-	return db.batch(func(tx *bolt.Tx) error {
+	return db.batch(func(tx kvTx) error {
 		bucket := tx.Bucket(validatorBucket)
 		buf := make([]byte, binary.MaxVarintLen64)
 		n := binary.PutUvarint(buf, uint64(index))
@@ -61,7 +60,7 @@ func (db *BeaconDB) ValidatorIndex(pubKey []byte) (uint64, error) {
 
 	var index uint64
 	h := hashutil.Hash(pubKey)
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx kvTx) error {
 		bucket := tx.Bucket(validatorBucket)
 
 		enc := bucket.Get(h[:])
@@ -81,7 +80,7 @@ func (db *BeaconDB) ValidatorIndex(pubKey []byte) (uint64, error) {
 func (db *BeaconDB) DeleteValidatorIndex(pubKey []byte) error {
 	h := hashutil.Hash(pubKey)
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		bkt := tx.Bucket(validatorBucket)
 
 		return bkt.Delete(h[:])
@@ -93,7 +92,7 @@ func (db *BeaconDB) HasValidator(pubKey []byte) bool {
 	exists := false
 	h := hashutil.Hash(pubKey)
 	// #nosec G104, similar to HasBlock, HasAttestation... etc
-	db.view(func(tx *bolt.Tx) error {
+	db.view(func(tx kvTx) error {
 		bkt := tx.Bucket(validatorBucket)
 
 		exists = bkt.Get(h[:]) != nil
@@ -107,7 +106,7 @@ func (db *BeaconDB) HasValidator(pubKey []byte) bool {
 func (db *BeaconDB) HasAnyValidators(state *pb.BeaconState, pubKeys [][]byte) (bool, error) {
 	exists := false
 	// #nosec G104, similar to HasBlock, HasAttestation... etc
-	db.view(func(tx *bolt.Tx) error {
+	db.view(func(tx kvTx) error {
 		bkt := tx.Bucket(validatorBucket)
 		for _, pk := range pubKeys {
 			h := hashutil.Hash(pk)
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/boltdb/bolt"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
@@ -79,7 +78,7 @@ func TestHasValidator(t *testing.T) {
 	pk := []byte("pk")
 
 	// Populate the db with some public key
-	if err := db.db.Update(func(tx *bolt.Tx) error {
+	if err := db.db.Update(func(tx kvTx) error {
 		bkt := tx.Bucket(validatorBucket)
 		h := hashutil.Hash(pk)
 		return bkt.Put(h[:], []byte("data"))
@@ -110,7 +109,7 @@ func TestHasAnyValidator(t *testing.T) {
 	}
 
 	// Populate the db with some public key
-	if err := db.db.Update(func(tx *bolt.Tx) error {
+	if err := db.db.Update(func(tx kvTx) error {
 		bkt := tx.Bucket(validatorBucket)
 		for _, pk := range knownPubKeys {
 			h := hashutil.Hash(pk)
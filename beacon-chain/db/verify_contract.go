@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/boltdb/bolt"
 	"github.com/ethereum/go-ethereum/common"
 	"go.opencensus.io/trace"
 )
@@ -20,7 +19,7 @@ func (db *BeaconDB) VerifyContractAddress(ctx context.Context, addr common.Addre
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyContractAddress")
 	defer span.End()
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx kvTx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 
 		expectedAddress := chainInfo.Get(depositContractAddressKey)
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/urfave/cli"
+)
+
+var (
+	dryRunFlag = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "List the pending migrations without applying them",
+	}
+	startSlotFlag = cli.Uint64Flag{
+		Name:  "start-slot",
+		Usage: "First slot of the block range, counted from genesis",
+	}
+	endSlotFlag = cli.Uint64Flag{
+		Name:  "end-slot",
+		Usage: "Last slot of the block range, counted from genesis. Defaults to the slot of the chain head",
+	}
+	blockRootFlag = cli.StringFlag{
+		Name:  "root",
+		Usage: "Hex encoded root of the block to dump",
+	}
+	blockFileFlag = cli.StringFlag{
+		Name:  "file",
+		Usage: "Path of the block file to export to or import from",
+	}
+)
+
+var dbCommand = cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for maintaining the beacon chain database",
+	Subcommands: cli.Commands{
+		cli.Command{
+			Name: "migrate",
+			Description: `applies all pending schema migrations to the beacon chain database in the data directory.
+Migrations are also applied automatically when the beacon node starts`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				dryRunFlag,
+			},
+			Action: migrateDB,
+		},
+		cli.Command{
+			Name:        "inspect",
+			Description: `lists the buckets of the beacon chain database with their number of keys, then prints the chain head, justified and finalized blocks and states`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+			},
+			Action: inspectDB,
+		},
+		cli.Command{
+			Name:        "blocks",
+			Description: `dumps as JSON the block with the given root, or all the blocks, including forks, in the given slot range`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				startSlotFlag,
+				endSlotFlag,
+				blockRootFlag,
+			},
+			Action: dumpBlocks,
+		},
+		cli.Command{
+			Name:        "export",
+			Description: `writes the canonical blocks in the given slot range to a block file`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				startSlotFlag,
+				endSlotFlag,
+				blockFileFlag,
+			},
+			Action: exportBlocks,
+		},
+		cli.Command{
+			Name: "import",
+			Description: `saves the blocks of a block file to the beacon chain database.
+The blocks are stored as is, without being processed or updating the chain head`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				blockFileFlag,
+			},
+			Action: importBlocks,
+		},
+	},
+}
+
+func beaconDBPath(ctx *cli.Context) string {
+	dataDir := ctx.String(cmd.DataDirFlag.Name)
+	if ctx.GlobalIsSet(cmd.DataDirFlag.Name) {
+		dataDir = ctx.GlobalString(cmd.DataDirFlag.Name)
+	}
+	return path.Join(dataDir, node.BeaconChainDBName)
+}
+
+// openDB opens the beacon chain database of a stopped node. It refuses to open a
+// database with pending migrations, so inspecting it never changes its schema.
+func openDB(ctx *cli.Context) (*db.BeaconDB, error) {
+	dbPath := beaconDBPath(ctx)
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("no beacon chain database at %s", dbPath)
+	}
+	_, pending, err := db.PendingMigrations(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		return nil, fmt.Errorf("database has %d pending migration(s), run the db migrate command first", len(pending))
+	}
+	return db.NewDB(dbPath)
+}
+
+func migrateDB(ctx *cli.Context) error {
+	dbPath := beaconDBPath(ctx)
+	version, pending, err := db.PendingMigrations(dbPath)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		fmt.Printf("Database schema is up to date at version %d\n", version)
+		return nil
+	}
+	fmt.Printf("Database schema version %d, %d pending migration(s):\n", version, len(pending))
+	for _, m := range pending {
+		fmt.Printf("  %d: %s\n", m.Version, m.Description)
+	}
+	if ctx.Bool(dryRunFlag.Name) {
+		return nil
+	}
+
+	beaconDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+	fmt.Printf("Database schema migrated to version %d\n", db.LatestSchemaVersion())
+	return nil
+}
+
+func inspectDB(ctx *cli.Context) error {
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	stats, err := beaconDB.BucketStats()
+	if err != nil {
+		return err
+	}
+	fmt.Println("Buckets:")
+	for _, stat := range stats {
+		fmt.Printf("  %-30s %d\n", stat.Name, stat.Keys)
+	}
+
+	head, err := beaconDB.ChainHead()
+	printBlockSummary("Chain head", head, err)
+	justifiedBlock, err := beaconDB.JustifiedBlock()
+	printBlockSummary("Justified block", justifiedBlock, err)
+	finalizedBlock, err := beaconDB.FinalizedBlock()
+	printBlockSummary("Finalized block", finalizedBlock, err)
+
+	headState, err := beaconDB.HeadState(context.Background())
+	printStateSummary("Head state", headState, err)
+	justifiedState, err := beaconDB.JustifiedState()
+	printStateSummary("Justified state", justifiedState, err)
+	finalizedState, err := beaconDB.FinalizedState()
+	printStateSummary("Finalized state", finalizedState, err)
+	return nil
+}
+
+func printBlockSummary(name string, block *pb.BeaconBlock, err error) {
+	if err != nil || block == nil {
+		fmt.Printf("%-16s unavailable: %v\n", name+":", err)
+		return
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		fmt.Printf("%-16s could not hash block: %v\n", name+":", err)
+		return
+	}
+	fmt.Printf("%-16s slot %d, root %#x, parent %#x\n", name+":",
+		block.Slot-params.BeaconConfig().GenesisSlot, root, block.ParentRootHash32)
+}
+
+func printStateSummary(name string, state *pb.BeaconState, err error) {
+	if err != nil || state == nil {
+		fmt.Printf("%-16s unavailable: %v\n", name+":", err)
+		return
+	}
+	fmt.Printf("%-16s slot %d, justified epoch %d, finalized epoch %d, %d validators\n", name+":",
+		state.Slot-params.BeaconConfig().GenesisSlot,
+		state.JustifiedEpoch-params.BeaconConfig().GenesisEpoch,
+		state.FinalizedEpoch-params.BeaconConfig().GenesisEpoch,
+		len(state.ValidatorRegistry))
+}
+
+// slotRange returns the absolute slots of the range given by the start and end
+// slot flags, the end slot defaults to the slot of the chain head.
+func slotRange(ctx *cli.Context, beaconDB *db.BeaconDB) (uint64, uint64, error) {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	start := genesisSlot + ctx.Uint64(startSlotFlag.Name)
+	end := genesisSlot + ctx.Uint64(endSlotFlag.Name)
+	if !ctx.IsSet(endSlotFlag.Name) {
+		head, err := beaconDB.ChainHead()
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not retrieve chain head: %v", err)
+		}
+		end = head.Slot
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("start slot %d is after end slot %d", start-genesisSlot, end-genesisSlot)
+	}
+	return start, end, nil
+}
+
+func dumpBlocks(ctx *cli.Context) error {
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	if ctx.IsSet(blockRootFlag.Name) {
+		root, err := hex.DecodeString(strings.TrimPrefix(ctx.String(blockRootFlag.Name), "0x"))
+		if err != nil {
+			return fmt.Errorf("could not decode block root: %v", err)
+		}
+		block, err := beaconDB.Block(bytesutil.ToBytes32(root))
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			return fmt.Errorf("no block with root %#x", root)
+		}
+		return printBlockJSON(block)
+	}
+
+	start, end, err := slotRange(ctx, beaconDB)
+	if err != nil {
+		return err
+	}
+	for slot := start; slot <= end; slot++ {
+		blocks, err := beaconDB.BlocksBySlot(context.Background(), slot)
+		if err != nil {
+			return err
+		}
+		for _, block := range blocks {
+			if err := printBlockJSON(block); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// printBlockJSON prints a block and its root as a single line JSON object.
+func printBlockJSON(block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	m := jsonpb.Marshaler{}
+	enc, err := m.MarshalToString(block)
+	if err != nil {
+		return fmt.Errorf("could not marshal block: %v", err)
+	}
+	fmt.Printf("{\"root\":\"%#x\",\"block\":%s}\n", root, enc)
+	return nil
+}
+
+func exportBlocks(ctx *cli.Context) error {
+	if !ctx.IsSet(blockFileFlag.Name) {
+		return errors.New("the path of the block file is required")
+	}
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	start, end, err := slotRange(ctx, beaconDB)
+	if err != nil {
+		return err
+	}
+	exported, err := writeCanonicalBlocks(beaconDB, ctx.String(blockFileFlag.Name), start, end)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Exported %d blocks to %s\n", exported, ctx.String(blockFileFlag.Name))
+	return nil
+}
+
+// writeCanonicalBlocks writes the canonical blocks from the start to the end slot,
+// both inclusive, to a new block file and returns the number of blocks written.
+func writeCanonicalBlocks(beaconDB *db.BeaconDB, filePath string, start uint64, end uint64) (int, error) {
+	f, err := os.Create(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	written := 0
+	for slot := start; slot <= end; slot++ {
+		block, err := beaconDB.CanonicalBlockBySlot(context.Background(), slot)
+		if err != nil {
+			return written, err
+		}
+		if block == nil {
+			continue
+		}
+		if err := writeBlock(w, block); err != nil {
+			return written, err
+		}
+		written++
+	}
+	return written, w.Flush()
+}
+
+func importBlocks(ctx *cli.Context) error {
+	if !ctx.IsSet(blockFileFlag.Name) {
+		return errors.New("the path of the block file is required")
+	}
+	f, err := os.Open(ctx.String(blockFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	r := bufio.NewReader(f)
+	imported := 0
+	for {
+		block, err := readBlock(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not read block %d: %v", imported, err)
+		}
+		if err := beaconDB.SaveBlock(block); err != nil {
+			return err
+		}
+		imported++
+	}
+	fmt.Printf("Imported %d blocks from %s\n", imported, f.Name())
+	return nil
+}
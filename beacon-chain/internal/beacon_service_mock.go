@@ -98,6 +98,21 @@ func (mr *MockBeaconServiceServerMockRecorder) Eth1Data(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1Data), arg0, arg1)
 }
 
+// ForkChoiceStore mocks base method
+func (m *MockBeaconServiceServer) ForkChoiceStore(arg0 context.Context, arg1 *types.Empty) (*v10.ForkChoiceStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkChoiceStore", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ForkChoiceStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkChoiceStore indicates an expected call of ForkChoiceStore
+func (mr *MockBeaconServiceServerMockRecorder) ForkChoiceStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkChoiceStore", reflect.TypeOf((*MockBeaconServiceServer)(nil).ForkChoiceStore), arg0, arg1)
+}
+
 // ForkData mocks base method
 func (m *MockBeaconServiceServer) ForkData(arg0 context.Context, arg1 *types.Empty) (*v1.Fork, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +157,21 @@ func (mr *MockBeaconServiceServerMockRecorder) PendingDeposits(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceServer)(nil).PendingDeposits), arg0, arg1)
 }
 
+// StateAtSlot mocks base method
+func (m *MockBeaconServiceServer) StateAtSlot(arg0 context.Context, arg1 *v10.StateAtSlotRequest) (*v1.BeaconState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateAtSlot", arg0, arg1)
+	ret0, _ := ret[0].(*v1.BeaconState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateAtSlot indicates an expected call of StateAtSlot
+func (mr *MockBeaconServiceServerMockRecorder) StateAtSlot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateAtSlot", reflect.TypeOf((*MockBeaconServiceServer)(nil).StateAtSlot), arg0, arg1)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceServer) WaitForChainStart(arg0 *types.Empty, arg1 v10.BeaconService_WaitForChainStartServer) error {
 	m.ctrl.T.Helper()
@@ -26,10 +26,26 @@ var appFlags = []cli.Flag{
 	utils.Web3ProviderFlag,
 	utils.HTTPWeb3ProviderFlag,
 	utils.RPCPort,
+	utils.AdminRPCPort,
 	utils.CertFlag,
 	utils.KeyFlag,
 	utils.EnableDBCleanup,
 	utils.GRPCGatewayPort,
+	utils.ArchiveFlag,
+	utils.ArchiveCheckpointIntervalFlag,
+	utils.StateSnapshotIntervalFlag,
+	utils.ForkChoiceDumpDirFlag,
+	utils.SlasherFlag,
+	utils.SlasherHistoryFlag,
+	utils.CheckpointRootFlag,
+	utils.CheckpointStateFileFlag,
+	utils.BlockRequestRateLimitFlag,
+	utils.BlockRequestBurstFlag,
+	utils.BatchedBlockRequestRateLimitFlag,
+	utils.BatchedBlockRequestBurstFlag,
+	utils.StateRequestRateLimitFlag,
+	utils.StateRequestBurstFlag,
+	utils.MaxBatchedBlocksFlag,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
@@ -39,6 +55,9 @@ var appFlags = []cli.Flag{
 	cmd.P2PMaxPeers,
 	cmd.P2PPrivKey,
 	cmd.P2PWhitelist,
+	cmd.P2PBanThreshold,
+	cmd.P2PBanDuration,
+	cmd.P2PScoreHalfLife,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -65,12 +84,16 @@ func init() {
 }
 
 func main() {
-	log := logrus.WithField("prefix", "main")
 	app := cli.NewApp()
 	app.Name = "beacon-chain"
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []cli.Command{
+		dbCommand,
+		exportChainCommand,
+		importChainCommand,
+	}
 
 	app.Flags = appFlags
 
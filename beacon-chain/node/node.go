@@ -2,17 +2,23 @@
 package node
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"sync"
 	"syscall"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -20,12 +26,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -37,7 +46,8 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const beaconChainDBName = "beaconchaindata"
+// BeaconChainDBName is the name of the beacon chain database directory within the data directory.
+const BeaconChainDBName = "beaconchaindata"
 const testSkipPowFlag = "test-skip-pow"
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -107,6 +117,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalBool(utils.SlasherFlag.Name) {
+		if err := beacon.registerSlasherService(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(ctx); err != nil {
 		return nil, err
 	}
@@ -173,7 +189,7 @@ func (b *BeaconNode) Close() {
 
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
-	dbPath := path.Join(baseDir, beaconChainDBName)
+	dbPath := path.Join(baseDir, BeaconChainDBName)
 	if b.ctx.GlobalBool(cmd.ClearDB.Name) {
 		if err := db.ClearDB(dbPath); err != nil {
 			return err
@@ -184,6 +200,12 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	db.SetStateSnapshotInterval(ctx.GlobalUint64(utils.StateSnapshotIntervalFlag.Name))
+	if ctx.GlobalBool(utils.ArchiveFlag.Name) {
+		interval := ctx.GlobalUint64(utils.ArchiveCheckpointIntervalFlag.Name)
+		log.WithField("checkpointInterval", interval).Info("Running in archive mode")
+		db.EnableArchiveMode(interval)
+	}
 
 	log.WithField("path", dbPath).Info("Checking db")
 	b.db = db
@@ -191,7 +213,7 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 }
 
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
-	beaconp2p, err := configureP2P(ctx)
+	beaconp2p, err := configureP2P(ctx, b.db)
 	if err != nil {
 		return fmt.Errorf("could not register p2p service: %v", err)
 	}
@@ -219,12 +241,13 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 	maxRoutines := ctx.GlobalInt64(cmd.MaxGoroutines.Name)
 
 	blockchainService, err := blockchain.NewChainService(context.Background(), &blockchain.Config{
-		BeaconDB:       b.db,
-		Web3Service:    web3Service,
-		OpsPoolService: opsService,
-		AttsService:    attsService,
-		P2p:            p2pService,
-		MaxRoutines:    maxRoutines,
+		BeaconDB:          b.db,
+		Web3Service:       web3Service,
+		OpsPoolService:    opsService,
+		AttsService:       attsService,
+		P2p:               p2pService,
+		MaxRoutines:       maxRoutines,
+		ForkChoiceDumpDir: ctx.GlobalString(utils.ForkChoiceDumpDirFlag.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("could not register blockchain service: %v", err)
@@ -251,29 +274,39 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 		return b.services.RegisterService(&powchain.Web3Service{})
 	}
 
+	web3Service, err := NewWeb3Service(cliCtx, b.db)
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(web3Service)
+}
+
+// NewWeb3Service connects to the proof-of-work chain endpoints and deposit contract
+// given by the command line flags, and returns the web3 service of the beacon node.
+func NewWeb3Service(cliCtx *cli.Context, beaconDB *db.BeaconDB) (*powchain.Web3Service, error) {
 	depAddress := cliCtx.GlobalString(utils.DepositContractFlag.Name)
 
 	if depAddress == "" {
 		var err error
 		depAddress, err = fetchDepositContract()
 		if err != nil {
-			log.WithError(err).Fatal("Cannot fetch deposit contract")
+			return nil, fmt.Errorf("cannot fetch deposit contract: %v", err)
 		}
 	}
 
 	if !common.IsHexAddress(depAddress) {
-		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
+		return nil, fmt.Errorf("invalid deposit contract address given: %s", depAddress)
 	}
 
 	rpcClient, err := gethRPC.Dial(cliCtx.GlobalString(utils.Web3ProviderFlag.Name))
 	if err != nil {
-		log.Fatalf("Access to PoW chain is required for validator. Unable to connect to Geth node: %v", err)
+		return nil, fmt.Errorf("access to PoW chain is required for validator, unable to connect to Geth node: %v", err)
 	}
 	powClient := ethclient.NewClient(rpcClient)
 
 	httpRPCClient, err := gethRPC.Dial(cliCtx.GlobalString(utils.HTTPWeb3ProviderFlag.Name))
 	if err != nil {
-		log.Fatalf("Access to PoW chain is required for validator. Unable to connect to Geth node: %v", err)
+		return nil, fmt.Errorf("access to PoW chain is required for validator, unable to connect to Geth node: %v", err)
 	}
 	httpClient := ethclient.NewClient(httpRPCClient)
 
@@ -287,21 +320,21 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 		HTTPLogger:      httpClient,
 		BlockFetcher:    httpClient,
 		ContractBackend: powClient,
-		BeaconDB:        b.db,
+		BeaconDB:        beaconDB,
 	}
 	web3Service, err := powchain.NewWeb3Service(ctx, cfg)
 	if err != nil {
-		return fmt.Errorf("could not register proof-of-work chain web3Service: %v", err)
+		return nil, fmt.Errorf("could not register proof-of-work chain web3Service: %v", err)
 	}
 
-	if err := b.db.VerifyContractAddress(ctx, cfg.DepositContract); err != nil {
-		return err
+	if err := beaconDB.VerifyContractAddress(ctx, cfg.DepositContract); err != nil {
+		return nil, err
 	}
 
-	return b.services.RegisterService(web3Service)
+	return web3Service, nil
 }
 
-func (b *BeaconNode) registerSyncService(_ *cli.Context) error {
+func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	var chainService *blockchain.ChainService
 	if err := b.services.FetchService(&chainService); err != nil {
 		return err
@@ -327,19 +360,108 @@ func (b *BeaconNode) registerSyncService(_ *cli.Context) error {
 		return err
 	}
 
+	checkpointRoot, checkpointState, err := loadCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
 	cfg := &rbcsync.Config{
-		ChainService:     chainService,
-		P2P:              p2pService,
-		BeaconDB:         b.db,
-		OperationService: operationService,
-		PowChainService:  web3Service,
-		AttsService:      attsService,
+		ChainService:        chainService,
+		P2P:                 p2pService,
+		BeaconDB:            b.db,
+		OperationService:    operationService,
+		PowChainService:     web3Service,
+		AttsService:         attsService,
+		CheckpointRoot:      checkpointRoot,
+		CheckpointState:     checkpointState,
+		BlockReqRateLimit:   ctx.GlobalFloat64(utils.BlockRequestRateLimitFlag.Name),
+		BlockReqBurst:       ctx.GlobalInt(utils.BlockRequestBurstFlag.Name),
+		BatchedReqRateLimit: ctx.GlobalFloat64(utils.BatchedBlockRequestRateLimitFlag.Name),
+		BatchedReqBurst:     ctx.GlobalInt(utils.BatchedBlockRequestBurstFlag.Name),
+		StateReqRateLimit:   ctx.GlobalFloat64(utils.StateRequestRateLimitFlag.Name),
+		StateReqBurst:       ctx.GlobalInt(utils.StateRequestBurstFlag.Name),
+		MaxBatchedBlocks:    ctx.GlobalUint64(utils.MaxBatchedBlocksFlag.Name),
 	}
 
 	syncService := rbcsync.NewSyncService(context.Background(), cfg)
 	return b.services.RegisterService(syncService)
 }
 
+// loadCheckpoint reads the trusted finalized block root and state from the checkpoint flags.
+// When only the state file is given, the root of its latest block is trusted. A root without
+// a state file is rejected, as peers stop serving the state of the checkpoint once the chain
+// finalizes past it.
+func loadCheckpoint(ctx *cli.Context) ([]byte, *pb.BeaconState, error) {
+	rootFlag := ctx.GlobalString(utils.CheckpointRootFlag.Name)
+	stateFile := ctx.GlobalString(utils.CheckpointStateFileFlag.Name)
+	if rootFlag == "" && stateFile == "" {
+		return nil, nil, nil
+	}
+
+	var root []byte
+	if rootFlag != "" {
+		var err error
+		root, err = hex.DecodeString(strings.TrimPrefix(rootFlag, "0x"))
+		if err != nil || len(root) != 32 {
+			return nil, nil, fmt.Errorf("invalid checkpoint root %q, expected 32 hex encoded bytes", rootFlag)
+		}
+	}
+	if stateFile == "" {
+		return nil, nil, fmt.Errorf("--%s requires --%s with the state of the checkpoint",
+			utils.CheckpointRootFlag.Name, utils.CheckpointStateFileFlag.Name)
+	}
+
+	enc, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read checkpoint state file: %v", err)
+	}
+	state := &pb.BeaconState{}
+	if err := proto.Unmarshal(enc, state); err != nil {
+		return nil, nil, fmt.Errorf("could not decode checkpoint state: %v", err)
+	}
+	if state.LatestBlock == nil {
+		return nil, nil, errors.New("checkpoint state has no latest block")
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(state.LatestBlock)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not hash checkpoint block: %v", err)
+	}
+	if root != nil && !bytes.Equal(root, blockRoot[:]) {
+		return nil, nil, fmt.Errorf("checkpoint state file is for block %#x, not the checkpoint root %#x", blockRoot, root)
+	}
+	log.WithFields(logrus.Fields{
+		"checkpointRoot": fmt.Sprintf("%#x", blockRoot),
+		"slot":           state.Slot - params.BeaconConfig().GenesisSlot,
+	}).Info("Syncing from trusted checkpoint state")
+	return blockRoot[:], state, nil
+}
+
+func (b *BeaconNode) registerSlasherService(ctx *cli.Context) error {
+	var attsService *attestation.Service
+	if err := b.services.FetchService(&attsService); err != nil {
+		return err
+	}
+
+	var syncService *rbcsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
+	var operationService *operations.Service
+	if err := b.services.FetchService(&operationService); err != nil {
+		return err
+	}
+
+	slasherService := slasher.NewSlasherService(context.Background(), &slasher.Config{
+		BeaconDB:      b.db,
+		AttsService:   attsService,
+		SyncService:   syncService.RegularSync,
+		OpsService:    operationService,
+		HistoryEpochs: ctx.GlobalUint64(utils.SlasherHistoryFlag.Name),
+	})
+	return b.services.RegisterService(slasherService)
+}
+
 func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	var chainService *blockchain.ChainService
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -369,12 +491,18 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	port := ctx.GlobalString(utils.RPCPort.Name)
 	cert := ctx.GlobalString(utils.CertFlag.Name)
 	key := ctx.GlobalString(utils.KeyFlag.Name)
+	var adminPort string
+	if p := ctx.GlobalInt(utils.AdminRPCPort.Name); p > 0 {
+		adminPort = fmt.Sprintf("%d", p)
+	}
 	rpcService := rpc.NewRPCService(context.Background(), &rpc.Config{
 		Port:             port,
+		AdminPort:        adminPort,
 		CertFlag:         cert,
 		KeyFlag:          key,
 		BeaconDB:         b.db,
 		Broadcaster:      p2pService,
+		PeerScorer:       p2pService,
 		ChainService:     chainService,
 		OperationService: operationService,
 		POWChainService:  web3Service,
@@ -27,9 +27,16 @@ var topicMappings = map[pb.Topic]proto.Message{
 	pb.Topic_ATTESTATION_ANNOUNCE:                &pb.AttestationAnnounce{},
 	pb.Topic_ATTESTATION_REQUEST:                 &pb.AttestationRequest{},
 	pb.Topic_ATTESTATION_RESPONSE:                &pb.AttestationResponse{},
+	pb.Topic_PROPOSER_SLASHING_ANNOUNCE:          &pb.ProposerSlashingAnnounce{},
+	pb.Topic_PROPOSER_SLASHING_REQUEST:           &pb.ProposerSlashingRequest{},
+	pb.Topic_PROPOSER_SLASHING_RESPONSE:          &pb.ProposerSlashingResponse{},
+	pb.Topic_ATTESTER_SLASHING_ANNOUNCE:          &pb.AttesterSlashingAnnounce{},
+	pb.Topic_ATTESTER_SLASHING_REQUEST:           &pb.AttesterSlashingRequest{},
+	pb.Topic_ATTESTER_SLASHING_RESPONSE:          &pb.AttesterSlashingResponse{},
+	pb.Topic_VOLUNTARY_EXIT:                      &pb.VoluntaryExit{},
 }
 
-func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
+func configureP2P(ctx *cli.Context, banStore p2p.BanStore) (*p2p.Server, error) {
 	contractAddress := ctx.GlobalString(utils.DepositContractFlag.Name)
 	if contractAddress == "" {
 		var err error
@@ -56,6 +63,10 @@ func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 		DepositContractAddress: contractAddress,
 		WhitelistCIDR:          ctx.GlobalString(cmd.P2PWhitelist.Name),
 		EnableUPnP:             ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
+		BanStore:               banStore,
+		BanThreshold:           ctx.GlobalFloat64(cmd.P2PBanThreshold.Name),
+		BanDuration:            ctx.GlobalDuration(cmd.P2PBanDuration.Name),
+		ScoreHalfLife:          ctx.GlobalDuration(cmd.P2PScoreHalfLife.Name),
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,33 @@
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestTopicMappings_BroadcastMessagesHaveTopics(t *testing.T) {
+	topics := make(map[reflect.Type]pb.Topic)
+	for topic, msg := range topicMappings {
+		topics[reflect.TypeOf(msg)] = topic
+	}
+
+	// Messages which the beacon node broadcasts to the network.
+	broadcasts := []proto.Message{
+		&pb.BeaconBlockAnnounce{},
+		&pb.BeaconBlockRequest{},
+		&pb.AttestationAnnounce{},
+		&pb.ProposerSlashingAnnounce{},
+		&pb.AttesterSlashingAnnounce{},
+		&pb.ChainHeadRequest{},
+		&pb.VoluntaryExit{},
+	}
+	for _, msg := range broadcasts {
+		topic, ok := topics[reflect.TypeOf(msg)]
+		if !ok || topic == pb.Topic_UNKNOWN {
+			t.Errorf("No topic registered for broadcast message %T", msg)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package operations
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// groupByData groups the attestations attesting to the same data, the groups
+// are returned in the order their first attestation appears in.
+func groupByData(atts []*pb.Attestation) ([][]*pb.Attestation, error) {
+	var groups [][]*pb.Attestation
+	groupIndex := make(map[[32]byte]int)
+	for _, att := range atts {
+		root, err := hashutil.HashProto(att.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash attestation data: %v", err)
+		}
+		idx, ok := groupIndex[root]
+		if !ok {
+			idx = len(groups)
+			groupIndex[root] = idx
+			groups = append(groups, nil)
+		}
+		groups[idx] = append(groups[idx], att)
+	}
+	return groups, nil
+}
+
+// aggregateAttestations merges attestations to the same data which have no
+// participant in common into aggregate attestations. Attestations whose
+// participants are all covered by an aggregate already are dropped. The
+// attestations with the most participants are merged first, and an attestation
+// which cannot be merged anywhere is returned as is. Merging always creates a new
+// attestation so the attestations of the pool are left untouched.
+func aggregateAttestations(atts []*pb.Attestation) []*pb.Attestation {
+	sorted := make([]*pb.Attestation, len(atts))
+	copy(sorted, atts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bitutil.BitSetCount(sorted[i].AggregationBitfield) > bitutil.BitSetCount(sorted[j].AggregationBitfield)
+	})
+
+	var aggregates []*pb.Attestation
+	for _, att := range sorted {
+		if isCovered(att, aggregates) {
+			continue
+		}
+		added := false
+		for i, agg := range aggregates {
+			if len(agg.AggregationBitfield) != len(att.AggregationBitfield) ||
+				bitutil.BitfieldsOverlap(agg.AggregationBitfield, att.AggregationBitfield) {
+				continue
+			}
+			aggregate, err := mergeAttestations(agg, att)
+			if err != nil {
+				log.WithError(err).Debug("Could not aggregate attestations")
+				continue
+			}
+			aggregates[i] = aggregate
+			added = true
+			break
+		}
+		if !added {
+			aggregates = append(aggregates, att)
+		}
+	}
+	return aggregates
+}
+
+// isCovered checks if an aggregate includes every participant of the attestation.
+func isCovered(att *pb.Attestation, aggregates []*pb.Attestation) bool {
+	for _, agg := range aggregates {
+		union, err := bitutil.OrBitfields(agg.AggregationBitfield, att.AggregationBitfield)
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(union, agg.AggregationBitfield) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeAttestations returns a new attestation combining the participants and
+// signatures of two attestations to the same data with disjoint participants.
+func mergeAttestations(a *pb.Attestation, b *pb.Attestation) (*pb.Attestation, error) {
+	aggregationBitfield, err := bitutil.OrBitfields(a.AggregationBitfield, b.AggregationBitfield)
+	if err != nil {
+		return nil, err
+	}
+	custodyBitfield, err := bitutil.OrBitfields(a.CustodyBitfield, b.CustodyBitfield)
+	if err != nil {
+		return nil, err
+	}
+	sigA, err := bls.SignatureFromBytes(a.AggregateSignature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize signature: %v", err)
+	}
+	sigB, err := bls.SignatureFromBytes(b.AggregateSignature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize signature: %v", err)
+	}
+	return &pb.Attestation{
+		Data:                a.Data,
+		AggregationBitfield: aggregationBitfield,
+		CustodyBitfield:     custodyBitfield,
+		AggregateSignature:  bls.AggregateSignatures([]*bls.Signature{sigA, sigB}).Marshal(),
+	}, nil
+}
@@ -0,0 +1,133 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// signedAttestation returns an attestation to the data by the participants of
+// the bitfield, each signing with their own key.
+func signedAttestation(t *testing.T, data *pb.AttestationData, bitfield []byte, keys []*bls.SecretKey) *pb.Attestation {
+	var sigs []*bls.Signature
+	for i, key := range keys {
+		if bitfield[i/8]&(1<<(7-uint(i%8))) != 0 {
+			sigs = append(sigs, key.Sign([]byte("attestation"), 0))
+		}
+	}
+	return &pb.Attestation{
+		Data:                data,
+		AggregationBitfield: bitfield,
+		CustodyBitfield:     make([]byte, len(bitfield)),
+		AggregateSignature:  bls.AggregateSignatures(sigs).Marshal(),
+	}
+}
+
+func committeeKeys(t *testing.T, n int) []*bls.SecretKey {
+	keys := make([]*bls.SecretKey, n)
+	for i := range keys {
+		key, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = key
+	}
+	return keys
+}
+
+func TestAggregateAttestations_MergesDisjointParticipants(t *testing.T) {
+	keys := committeeKeys(t, 8)
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	atts := []*pb.Attestation{
+		signedAttestation(t, data, []byte{0x80}, keys),
+		signedAttestation(t, data, []byte{0x40}, keys),
+		signedAttestation(t, data, []byte{0x30}, keys),
+		// Overlaps with 0x30, so it cannot be merged with it.
+		signedAttestation(t, data, []byte{0x60}, keys),
+		// Every participant is covered by an aggregate already.
+		signedAttestation(t, data, []byte{0x10}, keys),
+		signedAttestation(t, data, []byte{0x01}, keys),
+	}
+
+	aggregates := aggregateAttestations(atts)
+	if len(aggregates) != 2 {
+		t.Fatalf("Expected 2 aggregates, received %d", len(aggregates))
+	}
+	// The attestations with the most participants are merged first.
+	if !bytes.Equal(aggregates[0].AggregationBitfield, []byte{0xb1}) {
+		t.Errorf("Expected aggregation bitfield %08b, received %08b", 0xb1, aggregates[0].AggregationBitfield)
+	}
+	if aggregates[1] != atts[3] {
+		t.Errorf("Expected attestation which could not be merged to be returned as is, received %v", aggregates[1])
+	}
+	want := signedAttestation(t, data, []byte{0xb1}, keys)
+	if !bytes.Equal(aggregates[0].AggregateSignature, want.AggregateSignature) {
+		t.Error("Expected the aggregate signature of every participant")
+	}
+	if !bytes.Equal(atts[2].AggregationBitfield, []byte{0x30}) {
+		t.Error("Expected the attestations of the pool not to be modified")
+	}
+}
+
+func TestPendingAttestations_ReturnsAggregates(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	keys := committeeKeys(t, 8)
+	data := &pb.AttestationData{
+		Slot:                    params.BeaconConfig().GenesisSlot + 1,
+		CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
+	}
+	otherData := &pb.AttestationData{
+		Slot:                    params.BeaconConfig().GenesisSlot + 2,
+		CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
+	}
+	atts := []*pb.Attestation{
+		signedAttestation(t, data, []byte{0x80}, keys),
+		signedAttestation(t, data, []byte{0x01}, keys),
+		signedAttestation(t, otherData, []byte{0x80}, keys),
+	}
+	for _, att := range atts {
+		if err := beaconDB.SaveAttestation(context.Background(), att); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot + 4,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := service.PendingAttestations(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 2 {
+		t.Fatalf("Expected 2 pending attestations, received %d", len(pending))
+	}
+	if pending[0].Data.Slot != data.Slot || !bytes.Equal(pending[0].AggregationBitfield, []byte{0x81}) {
+		t.Errorf("Expected the attestations to slot 1 to be aggregated, received %v", pending[0])
+	}
+
+	// Including the aggregate removes the attestations it covers from the pool.
+	if err := service.removePendingAttestations(pending[:1]); err != nil {
+		t.Fatal(err)
+	}
+	for i, att := range atts {
+		hash, err := hashutil.HashProto(att)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if has := beaconDB.HasAttestation(hash); has != (i == 2) {
+			t.Errorf("Expected attestation %d in the pool to be %v, received %v", i, i == 2, has)
+		}
+	}
+}
@@ -8,10 +8,13 @@ import (
 	"sort"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
@@ -27,28 +30,34 @@ var log = logrus.WithField("prefix", "operation")
 type OperationFeeds interface {
 	IncomingAttFeed() *event.Feed
 	IncomingExitFeed() *event.Feed
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
 	IncomingProcessedBlockFeed() *event.Feed
 }
 
 // Service represents a service that handles the internal
 // logic of beacon block operations.
 type Service struct {
-	ctx                        context.Context
-	cancel                     context.CancelFunc
-	beaconDB                   *db.BeaconDB
-	incomingExitFeed           *event.Feed
-	incomingValidatorExits     chan *pb.VoluntaryExit
-	incomingAttFeed            *event.Feed
-	incomingAtt                chan *pb.Attestation
-	incomingProcessedBlockFeed *event.Feed
-	incomingProcessedBlock     chan *pb.BeaconBlock
-	p2p                        p2p.Broadcaster
-	error                      error
+	ctx                          context.Context
+	cancel                       context.CancelFunc
+	beaconDB                     db.Database
+	incomingExitFeed             *event.Feed
+	incomingValidatorExits       chan *pb.VoluntaryExit
+	incomingAttFeed              *event.Feed
+	incomingAtt                  chan *pb.Attestation
+	incomingProposerSlashingFeed *event.Feed
+	incomingProposerSlashings    chan *pb.ProposerSlashing
+	incomingAttesterSlashingFeed *event.Feed
+	incomingAttesterSlashings    chan *pb.AttesterSlashing
+	incomingProcessedBlockFeed   *event.Feed
+	incomingProcessedBlock       chan *pb.BeaconBlock
+	p2p                          p2p.Broadcaster
+	error                        error
 }
 
 // Config options for the service.
 type Config struct {
-	BeaconDB *db.BeaconDB
+	BeaconDB db.Database
 	P2P      p2p.Broadcaster
 }
 
@@ -57,16 +66,20 @@ type Config struct {
 func NewOpsPoolService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
-		ctx:                        ctx,
-		cancel:                     cancel,
-		beaconDB:                   cfg.BeaconDB,
-		incomingExitFeed:           new(event.Feed),
-		incomingValidatorExits:     make(chan *pb.VoluntaryExit, params.BeaconConfig().DefaultBufferSize),
-		incomingAttFeed:            new(event.Feed),
-		incomingAtt:                make(chan *pb.Attestation, params.BeaconConfig().DefaultBufferSize),
-		incomingProcessedBlockFeed: new(event.Feed),
-		incomingProcessedBlock:     make(chan *pb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
-		p2p:                        cfg.P2P,
+		ctx:                          ctx,
+		cancel:                       cancel,
+		beaconDB:                     cfg.BeaconDB,
+		incomingExitFeed:             new(event.Feed),
+		incomingValidatorExits:       make(chan *pb.VoluntaryExit, params.BeaconConfig().DefaultBufferSize),
+		incomingAttFeed:              new(event.Feed),
+		incomingAtt:                  make(chan *pb.Attestation, params.BeaconConfig().DefaultBufferSize),
+		incomingProposerSlashingFeed: new(event.Feed),
+		incomingProposerSlashings:    make(chan *pb.ProposerSlashing, params.BeaconConfig().DefaultBufferSize),
+		incomingAttesterSlashingFeed: new(event.Feed),
+		incomingAttesterSlashings:    make(chan *pb.AttesterSlashing, params.BeaconConfig().DefaultBufferSize),
+		incomingProcessedBlockFeed:   new(event.Feed),
+		incomingProcessedBlock:       make(chan *pb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
+		p2p:                          cfg.P2P,
 	}
 }
 
@@ -105,6 +118,18 @@ func (s *Service) IncomingAttFeed() *event.Feed {
 	return s.incomingAttFeed
 }
 
+// IncomingProposerSlashingFeed returns a feed that any service can send incoming p2p proposer slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to keep them until inclusion.
+func (s *Service) IncomingProposerSlashingFeed() *event.Feed {
+	return s.incomingProposerSlashingFeed
+}
+
+// IncomingAttesterSlashingFeed returns a feed that any service can send incoming p2p attester slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to keep them until inclusion.
+func (s *Service) IncomingAttesterSlashingFeed() *event.Feed {
+	return s.incomingAttesterSlashingFeed
+}
+
 // IncomingProcessedBlockFeed returns a feed that any service can send incoming p2p beacon blocks into.
 // The beacon block operation pool service will subscribe to this feed in order to receive incoming beacon blocks.
 func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
@@ -112,10 +137,10 @@ func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
 }
 
 // PendingAttestations returns the attestations that have not seen on the beacon chain, the attestations are
-// returns in slot ascending order and up to MaxAttestations capacity. The attestations get
-// deleted in DB after they have been retrieved.
+// returned in slot ascending order. Attestations to the same data with disjoint participants are merged
+// into aggregates. All of them are returned, the proposer filters them and selects the ones which fit
+// in a block. The attestations get deleted in DB once they are one epoch older than the head state.
 func (s *Service) PendingAttestations(ctx context.Context) ([]*pb.Attestation, error) {
-	var attestations []*pb.Attestation
 	attestationsFromDB, err := s.beaconDB.Attestations()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve attestations from DB")
@@ -127,7 +152,7 @@ func (s *Service) PendingAttestations(ctx context.Context) ([]*pb.Attestation, e
 	sort.Slice(attestationsFromDB, func(i, j int) bool {
 		return attestationsFromDB[i].Data.Slot < attestationsFromDB[j].Data.Slot
 	})
-	var validAttsCount uint64
+	var pending []*pb.Attestation
 	for _, att := range attestationsFromDB {
 		// Delete the attestation if the attestation is one epoch older than head state,
 		// we don't want to pass these attestations to RPC for proposer to include.
@@ -137,25 +162,228 @@ func (s *Service) PendingAttestations(ctx context.Context) ([]*pb.Attestation, e
 			}
 			continue
 		}
+		pending = append(pending, att)
+	}
+
+	groups, err := groupByData(pending)
+	if err != nil {
+		return nil, err
+	}
+	var aggregates []*pb.Attestation
+	for _, group := range groups {
+		if len(group) > 1 {
+			// A single invalid signature would invalidate every aggregate it is merged into.
+			group, err = s.validAttestationSignatures(state, group)
+			if err != nil {
+				return nil, err
+			}
+		}
+		aggregates = append(aggregates, aggregateAttestations(group)...)
+	}
+	sort.SliceStable(aggregates, func(i, j int) bool {
+		return aggregates[i].Data.Slot < aggregates[j].Data.Slot
+	})
+	return aggregates, nil
+}
+
+// DeleteAttestation deletes an attestation returned by PendingAttestations from the DB. Aggregates
+// are not stored themselves, so every stored attestation to the same data whose participants are
+// covered by the attestation is deleted.
+func (s *Service) DeleteAttestation(att *pb.Attestation) error {
+	return s.removePendingAttestations([]*pb.Attestation{att})
+}
+
+// validAttestationSignatures deletes the attestations whose signature does not verify against the
+// state from the DB and returns the others.
+func (s *Service) validAttestationSignatures(state *pb.BeaconState, atts []*pb.Attestation) ([]*pb.Attestation, error) {
+	if featureconfig.FeatureConfig().DisableSignatureVerification {
+		return atts, nil
+	}
+	valid := make([]*pb.Attestation, 0, len(atts))
+	for _, att := range atts {
+		if err := blocks.VerifyAttestationSignature(state, att); err != nil {
+			log.WithError(err).WithField("slot", att.Data.Slot-params.BeaconConfig().GenesisSlot).Debug(
+				"Deleting attestation with invalid signature from DB")
+			if err := s.beaconDB.DeleteAttestation(att); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		valid = append(valid, att)
+	}
+	return valid, nil
+}
+
+// PendingProposerSlashings returns the proposer slashings which have not been seen on the beacon
+// chain, ordered by proposer index and up to MaxProposerSlashings capacity. Slashings which can no
+// longer be included, because they are invalid or the proposer is already slashed, get deleted from
+// the DB.
+func (s *Service) PendingProposerSlashings(ctx context.Context) ([]*pb.ProposerSlashing, error) {
+	slashingsFromDB, err := s.beaconDB.ProposerSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve proposer slashings from DB: %v", err)
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state from DB: %v", err)
+	}
+	sort.Slice(slashingsFromDB, func(i, j int) bool {
+		return slashingsFromDB[i].ProposerIndex < slashingsFromDB[j].ProposerIndex
+	})
+	verifySignatures := !featureconfig.FeatureConfig().DisableSignatureVerification
+	var slashings []*pb.ProposerSlashing
+	included := make(map[uint64]bool)
+	for _, slashing := range slashingsFromDB {
+		if uint64(len(slashings)) == params.BeaconConfig().MaxProposerSlashings {
+			break
+		}
+		if err := blocks.VerifyProposerSlashing(state, slashing, verifySignatures); err != nil ||
+			isSlashed(state, slashing.ProposerIndex) {
+			if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// A second slashing of the same proposer would slash it twice.
+		if included[slashing.ProposerIndex] {
+			continue
+		}
+		included[slashing.ProposerIndex] = true
+		slashings = append(slashings, slashing)
+	}
+	return slashings, nil
+}
+
+// PendingAttesterSlashings returns the attester slashings which have not been seen on the beacon
+// chain, up to MaxAttesterSlashings capacity. Each returned slashing slashes at least one validator
+// which neither is already slashed nor slashed by a previously returned slashing. Slashings which
+// can no longer be included get deleted from the DB.
+func (s *Service) PendingAttesterSlashings(ctx context.Context) ([]*pb.AttesterSlashing, error) {
+	slashingsFromDB, err := s.beaconDB.AttesterSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attester slashings from DB: %v", err)
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state from DB: %v", err)
+	}
+	verifySignatures := !featureconfig.FeatureConfig().DisableSignatureVerification
+	var slashings []*pb.AttesterSlashing
+	included := make(map[uint64]bool)
+	for _, slashing := range slashingsFromDB {
+		if uint64(len(slashings)) == params.BeaconConfig().MaxAttesterSlashings {
+			break
+		}
+		if err := blocks.VerifyAttesterSlashing(state, slashing, verifySignatures); err != nil {
+			if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var unslashed, slashable []uint64
+		for _, idx := range slashedIndicesIntersection(slashing) {
+			if isSlashed(state, idx) {
+				continue
+			}
+			unslashed = append(unslashed, idx)
+			if !included[idx] {
+				slashable = append(slashable, idx)
+			}
+		}
+		if len(unslashed) == 0 {
+			if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if len(slashable) == 0 {
+			continue
+		}
+		for _, idx := range slashable {
+			included[idx] = true
+		}
+		slashings = append(slashings, slashing)
+	}
+	return slashings, nil
+}
+
+// PendingExits returns the voluntary exits which have not been seen on the beacon chain, ordered
+// by validator index and up to MaxVoluntaryExits capacity. Exits for a future epoch are kept in the
+// DB until they can be included, other exits which fail verification against the head state, such
+// as the exit of a validator which is already exiting, get deleted from the DB.
+func (s *Service) PendingExits(ctx context.Context) ([]*pb.VoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingExits")
+	defer span.End()
 
-		validAttsCount++
-		// Stop the max attestation number per beacon block is reached.
-		if validAttsCount == params.BeaconConfig().MaxAttestations {
+	exitsFromDB, err := s.beaconDB.Exits()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exits from DB: %v", err)
+	}
+	state, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state from DB: %v", err)
+	}
+	sort.Slice(exitsFromDB, func(i, j int) bool {
+		return exitsFromDB[i].ValidatorIndex < exitsFromDB[j].ValidatorIndex
+	})
+	verifySignatures := !featureconfig.FeatureConfig().DisableSignatureVerification
+	currentEpoch := helpers.CurrentEpoch(state)
+	var exits []*pb.VoluntaryExit
+	included := make(map[uint64]bool)
+	for _, exit := range exitsFromDB {
+		if uint64(len(exits)) == params.BeaconConfig().MaxVoluntaryExits {
 			break
 		}
-		attestations = append(attestations, att)
+		if exit.Epoch > currentEpoch {
+			continue
+		}
+		if err := blocks.VerifyExit(state, exit, verifySignatures); err != nil {
+			if err := s.beaconDB.DeleteExit(exit); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// A second exit of the same validator fails once the first one is processed.
+		if included[exit.ValidatorIndex] {
+			continue
+		}
+		included[exit.ValidatorIndex] = true
+		exits = append(exits, exit)
 	}
-	return attestations, nil
+	return exits, nil
+}
+
+// slashedIndicesIntersection returns the validator indices which are part of
+// both slashable attestations of an attester slashing.
+func slashedIndicesIntersection(slashing *pb.AttesterSlashing) []uint64 {
+	indices2 := make(map[uint64]bool)
+	for _, idx := range slashing.SlashableAttestation_2.ValidatorIndices {
+		indices2[idx] = true
+	}
+	var intersection []uint64
+	for _, idx := range slashing.SlashableAttestation_1.ValidatorIndices {
+		if indices2[idx] {
+			intersection = append(intersection, idx)
+		}
+	}
+	return intersection
+}
+
+func isSlashed(state *pb.BeaconState, idx uint64) bool {
+	return state.ValidatorRegistry[idx].SlashedEpoch != params.BeaconConfig().FarFutureEpoch
 }
 
 // saveOperations saves the newly broadcasted beacon block operations
 // that was received from sync service.
 func (s *Service) saveOperations() {
-	// TODO(1438): Add rest of operations (slashings, attestation, exists...etc)
 	incomingSub := s.incomingExitFeed.Subscribe(s.incomingValidatorExits)
 	defer incomingSub.Unsubscribe()
 	incomingAttSub := s.incomingAttFeed.Subscribe(s.incomingAtt)
 	defer incomingAttSub.Unsubscribe()
+	incomingProposerSlashingSub := s.incomingProposerSlashingFeed.Subscribe(s.incomingProposerSlashings)
+	defer incomingProposerSlashingSub.Unsubscribe()
+	incomingAttesterSlashingSub := s.incomingAttesterSlashingFeed.Subscribe(s.incomingAttesterSlashings)
+	defer incomingAttesterSlashingSub.Unsubscribe()
 
 	for {
 		select {
@@ -170,6 +398,10 @@ func (s *Service) saveOperations() {
 			handler.SafelyHandleMessage(s.ctx, s.HandleValidatorExits, exit)
 		case attestation := <-s.incomingAtt:
 			handler.SafelyHandleMessage(s.ctx, s.HandleAttestations, attestation)
+		case slashing := <-s.incomingProposerSlashings:
+			handler.SafelyHandleMessage(s.ctx, s.HandleProposerSlashing, slashing)
+		case slashing := <-s.incomingAttesterSlashings:
+			handler.SafelyHandleMessage(s.ctx, s.HandleAttesterSlashing, slashing)
 		}
 	}
 }
@@ -210,8 +442,56 @@ func (s *Service) HandleAttestations(ctx context.Context, message proto.Message)
 	return nil
 }
 
+// HandleProposerSlashing saves a received proposer slashing in the DB until a
+// proposer includes it in a block, and announces newly saved slashings to peers.
+func (s *Service) HandleProposerSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleProposerSlashing")
+	defer span.End()
+
+	slashing := message.(*pb.ProposerSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasProposerSlashing(hash) {
+		return nil
+	}
+	if err := s.beaconDB.SaveProposerSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"hash":          fmt.Sprintf("%#x", bytesutil.Trunc(hash[:])),
+		"proposerIndex": slashing.ProposerIndex,
+	}).Info("Proposer slashing saved in DB")
+	s.p2p.Broadcast(ctx, &pb.ProposerSlashingAnnounce{Hash: hash[:]})
+	return nil
+}
+
+// HandleAttesterSlashing saves a received attester slashing in the DB until a
+// proposer includes it in a block, and announces newly saved slashings to peers.
+func (s *Service) HandleAttesterSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleAttesterSlashing")
+	defer span.End()
+
+	slashing := message.(*pb.AttesterSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasAttesterSlashing(hash) {
+		return nil
+	}
+	if err := s.beaconDB.SaveAttesterSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.WithField("hash", fmt.Sprintf("%#x", bytesutil.Trunc(hash[:]))).Info("Attester slashing saved in DB")
+	s.p2p.Broadcast(ctx, &pb.AttesterSlashingAnnounce{Hash: hash[:]})
+	return nil
+}
+
 // IsAttCanonical returns true if the input attestation is voting on the canonical chain, false
 // otherwise. The steps to verify are:
+//
 //	1.) retrieve the voted block
 //	2.) retrieve the canonical block by using voted block's slot number
 //	3.) return true if voted block root and the canonical block root are the same
@@ -272,21 +552,49 @@ func (s *Service) handleProcessedBlock(_ context.Context, message proto.Message)
 	if err := s.removePendingAttestations(block.Body.Attestations); err != nil {
 		return fmt.Errorf("could not remove processed attestations from DB: %v", err)
 	}
+	if err := s.removePendingSlashings(block.Body); err != nil {
+		return fmt.Errorf("could not remove processed slashings from DB: %v", err)
+	}
+	for _, exit := range block.Body.VoluntaryExits {
+		if err := s.beaconDB.DeleteExit(exit); err != nil {
+			return fmt.Errorf("could not remove processed exits from DB: %v", err)
+		}
+	}
 	return nil
 }
 
-// removePendingAttestations removes a list of attestations from DB.
-func (s *Service) removePendingAttestations(attestations []*pb.Attestation) error {
-	for _, attestation := range attestations {
-		hash, err := hashutil.HashProto(attestation)
-		if err != nil {
+// removePendingSlashings removes the slashings included in a block body from DB.
+func (s *Service) removePendingSlashings(body *pb.BeaconBlockBody) error {
+	for _, slashing := range body.ProposerSlashings {
+		if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+			return err
+		}
+	}
+	for _, slashing := range body.AttesterSlashings {
+		if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
 			return err
 		}
-		if s.beaconDB.HasAttestation(hash) {
+	}
+	return nil
+}
+
+// removePendingAttestations removes the attestations whose participants are covered by a list of
+// attestations from DB, these may have been aggregated before their inclusion.
+func (s *Service) removePendingAttestations(attestations []*pb.Attestation) error {
+	pending, err := s.beaconDB.Attestations()
+	if err != nil {
+		return err
+	}
+	for _, attestation := range pending {
+		for _, included := range attestations {
+			if !proto.Equal(attestation.Data, included.Data) || !isCovered(attestation, []*pb.Attestation{included}) {
+				continue
+			}
 			if err := s.beaconDB.DeleteAttestation(attestation); err != nil {
 				return err
 			}
 			log.WithField("slot", attestation.Data.Slot-params.BeaconConfig().GenesisSlot).Debug("Attestation removed")
+			break
 		}
 	}
 	return nil
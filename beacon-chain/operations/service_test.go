@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -21,13 +23,18 @@ import (
 var _ = OperationFeeds(&Service{})
 
 type mockBroadcaster struct {
+	broadcastMsgs []proto.Message
 }
 
-func (mb *mockBroadcaster) Broadcast(_ context.Context, _ proto.Message) {
+func (mb *mockBroadcaster) Broadcast(_ context.Context, msg proto.Message) {
+	mb.broadcastMsgs = append(mb.broadcastMsgs, msg)
 }
 
 func init() {
 	logrus.SetLevel(logrus.DebugLevel)
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+		DisableSignatureVerification: true,
+	})
 }
 
 func TestStop_OK(t *testing.T) {
@@ -121,8 +128,8 @@ func TestRetrieveAttestations_OK(t *testing.T) {
 	defer internal.TeardownDB(t, beaconDB)
 	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
 
-	// Save 140 attestations for test. During 1st retrieval we should get slot:1 - slot:61 attestations.
-	// The 1st retrieval is set at slot 64.
+	// Save 140 attestations for test. During 1st retrieval we should get slot:1 - slot:139 attestations,
+	// more than fit in a block as the proposer selects among them. The 1st retrieval is set at slot 64.
 	origAttestations := make([]*pb.Attestation, 140)
 	for i := 0; i < len(origAttestations); i++ {
 		origAttestations[i] = &pb.Attestation{
@@ -143,13 +150,13 @@ func TestRetrieveAttestations_OK(t *testing.T) {
 			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:]}}}); err != nil {
 		t.Fatal(err)
 	}
-	// Test we can retrieve attestations from slot1 - slot61.
+	// Test we can retrieve attestations from slot1 - slot139.
 	attestations, err := service.PendingAttestations(context.Background())
 	if err != nil {
 		t.Fatalf("Could not retrieve attestations: %v", err)
 	}
 
-	if !reflect.DeepEqual(attestations, origAttestations[1:128]) {
+	if !reflect.DeepEqual(attestations, origAttestations[1:]) {
 		t.Error("Retrieved attestations did not match")
 	}
 }
@@ -398,3 +405,256 @@ func TestIsCanonical_NilBlocks(t *testing.T) {
 		t.Error("Attestation shouldn't be canonical")
 	}
 }
+
+func TestPendingExits_SkipsInvalidAndFutureExits(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	validators := make([]*pb.Validator, 4)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch}
+	}
+	validators[2].ExitEpoch = params.BeaconConfig().GenesisEpoch
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: validators,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	future := &pb.VoluntaryExit{Epoch: genesisEpoch + 1, ValidatorIndex: 0}
+	exiting := &pb.VoluntaryExit{Epoch: genesisEpoch, ValidatorIndex: 2}
+	exits := []*pb.VoluntaryExit{
+		{Epoch: genesisEpoch, ValidatorIndex: 3},
+		{Epoch: genesisEpoch, ValidatorIndex: 1},
+		{Epoch: genesisEpoch, ValidatorIndex: 1, Signature: []byte{1}},
+		future,
+		// Already exiting.
+		exiting,
+		// Out of the validator registry.
+		{Epoch: genesisEpoch, ValidatorIndex: 10},
+	}
+	for _, exit := range exits {
+		if err := service.HandleValidatorExits(context.Background(), exit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pending, err := service.PendingExits(context.Background())
+	if err != nil {
+		t.Fatalf("Could not retrieve exits: %v", err)
+	}
+	if len(pending) != 2 || pending[0].ValidatorIndex != 1 || pending[1].ValidatorIndex != 3 {
+		t.Errorf("Expected exits of validators 1 and 3, received %v", pending)
+	}
+	for _, exit := range []*pb.VoluntaryExit{future, exiting} {
+		hash, err := hashutil.HashProto(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := exit == future; beaconDB.HasExit(hash) != want {
+			t.Errorf("Expected exit of validator %d to be kept in DB: %v", exit.ValidatorIndex, want)
+		}
+	}
+}
+
+func TestHandleProposerSlashing_AnnouncesNewSlashings(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	broadcaster := &mockBroadcaster{}
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      broadcaster,
+	})
+
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:  1,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{1}},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{2}},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Slashings already in the pool are not announced again.
+	for i := 0; i < 2; i++ {
+		if err := service.HandleProposerSlashing(context.Background(), slashing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(broadcaster.broadcastMsgs) != 1 {
+		t.Fatalf("Expected 1 announcement, received %d", len(broadcaster.broadcastMsgs))
+	}
+	announce, ok := broadcaster.broadcastMsgs[0].(*pb.ProposerSlashingAnnounce)
+	if !ok || !bytes.Equal(announce.Hash, hash[:]) {
+		t.Errorf("Expected announcement of slashing %#x, received %v", hash, broadcaster.broadcastMsgs[0])
+	}
+}
+
+func TestPendingProposerSlashings_SkipsSlashedAndDuplicates(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      &mockBroadcaster{},
+	})
+
+	validators := make([]*pb.Validator, 4)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{SlashedEpoch: params.BeaconConfig().FarFutureEpoch}
+	}
+	validators[2].SlashedEpoch = params.BeaconConfig().GenesisEpoch
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: validators,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	slashing := func(index uint64, slot uint64) *pb.ProposerSlashing {
+		return &pb.ProposerSlashing{
+			ProposerIndex:  index,
+			ProposalData_1: &pb.ProposalSignedData{Slot: slot, BlockRootHash32: []byte{1}},
+			ProposalData_2: &pb.ProposalSignedData{Slot: slot, BlockRootHash32: []byte{2}},
+		}
+	}
+	slashings := []*pb.ProposerSlashing{
+		slashing(3, 1),
+		slashing(1, 1),
+		slashing(1, 2),
+		// Already slashed.
+		slashing(2, 1),
+		// Out of the validator registry.
+		slashing(10, 1),
+	}
+	for _, s := range slashings {
+		if err := service.HandleProposerSlashing(context.Background(), s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pending, err := service.PendingProposerSlashings(context.Background())
+	if err != nil {
+		t.Fatalf("Could not retrieve proposer slashings: %v", err)
+	}
+	if len(pending) != 2 || pending[0].ProposerIndex != 1 || pending[1].ProposerIndex != 3 {
+		t.Errorf("Expected slashings of proposers 1 and 3, received %v", pending)
+	}
+	for _, s := range slashings[3:] {
+		hash, err := hashutil.HashProto(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if beaconDB.HasProposerSlashing(hash) {
+			t.Errorf("Expected slashing of proposer %d to be pruned", s.ProposerIndex)
+		}
+	}
+}
+
+func TestPendingAttesterSlashings_SkipsCoveredIndices(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      &mockBroadcaster{},
+	})
+
+	validators := make([]*pb.Validator, 4)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{SlashedEpoch: params.BeaconConfig().FarFutureEpoch}
+	}
+	validators[0].SlashedEpoch = params.BeaconConfig().GenesisEpoch + 10
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: validators,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	slashing := func(justifiedEpoch uint64, indices ...uint64) *pb.AttesterSlashing {
+		return &pb.AttesterSlashing{
+			SlashableAttestation_1: &pb.SlashableAttestation{
+				Data:             &pb.AttestationData{Slot: 5, JustifiedEpoch: justifiedEpoch},
+				ValidatorIndices: indices,
+				CustodyBitfield:  []byte{0x00},
+			},
+			SlashableAttestation_2: &pb.SlashableAttestation{
+				Data:             &pb.AttestationData{Slot: 5},
+				ValidatorIndices: indices,
+				CustodyBitfield:  []byte{0x00},
+			},
+		}
+	}
+	for _, s := range []*pb.AttesterSlashing{slashing(1, 1), slashing(2, 1), slashing(3, 0)} {
+		if err := service.HandleAttesterSlashing(context.Background(), s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pending, err := service.PendingAttesterSlashings(context.Background())
+	if err != nil {
+		t.Fatalf("Could not retrieve attester slashings: %v", err)
+	}
+	if len(pending) != 1 || pending[0].SlashableAttestation_1.ValidatorIndices[0] != 1 {
+		t.Errorf("Expected a single slashing of validator 1, received %v", pending)
+	}
+	remaining, err := beaconDB.AttesterSlashings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The slashing of the already slashed validator 0 is pruned, the
+	// duplicate slashing of validator 1 is kept for a later block.
+	if len(remaining) != 2 {
+		t.Errorf("Expected 2 attester slashings left in DB, received %d", len(remaining))
+	}
+}
+
+func TestDeleteAttestation_DeletesAggregatedAttestations(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	data := &pb.AttestationData{
+		Slot:                    params.BeaconConfig().GenesisSlot + 1,
+		CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
+	}
+	otherData := &pb.AttestationData{
+		Slot:                    params.BeaconConfig().GenesisSlot + 2,
+		CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
+	}
+	covered1 := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x80}}
+	covered2 := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x40}}
+	uncovered := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x20}}
+	other := &pb.Attestation{Data: otherData, AggregationBitfield: []byte{0x80}}
+	for _, att := range []*pb.Attestation{covered1, covered2, uncovered, other} {
+		if err := beaconDB.SaveAttestation(context.Background(), att); err != nil {
+			t.Fatalf("Failed to save attestation: %v", err)
+		}
+	}
+
+	aggregate := &pb.Attestation{Data: data, AggregationBitfield: []byte{0xC0}}
+	if err := service.DeleteAttestation(aggregate); err != nil {
+		t.Fatalf("Could not delete attestation: %v", err)
+	}
+
+	for _, tt := range []struct {
+		att     *pb.Attestation
+		deleted bool
+	}{
+		{covered1, true},
+		{covered2, true},
+		{uncovered, false},
+		{other, false},
+	} {
+		hash, err := hashutil.HashProto(tt.att)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if beaconDB.HasAttestation(hash) == tt.deleted {
+			t.Errorf("Wanted attestation with bitfield %#x and slot %d deleted: %v",
+				tt.att.AggregationBitfield, tt.att.Data.Slot-params.BeaconConfig().GenesisSlot, tt.deleted)
+		}
+	}
+}
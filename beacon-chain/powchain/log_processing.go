@@ -41,28 +41,29 @@ func (w *Web3Service) HasChainStartLogOccurred() (bool, uint64, error) {
 }
 
 // ProcessLog is the main method which handles the processing of all
-// logs from the deposit contract on the ETH1.0 chain.
-func (w *Web3Service) ProcessLog(depositLog gethTypes.Log) {
+// logs from the deposit contract on the ETH1.0 chain. It returns an error
+// if a deposit could not be persisted, so the log is processed again later.
+func (w *Web3Service) ProcessLog(depositLog gethTypes.Log) error {
 	// Process logs according to their event signature.
 	if depositLog.Topics[0] == hashutil.Hash(depositEventSignature) {
-		w.ProcessDepositLog(depositLog)
-		return
+		return w.ProcessDepositLog(depositLog)
 	}
 	if depositLog.Topics[0] == hashutil.Hash(chainStartEventSignature) && !w.chainStarted {
 		w.ProcessChainStartLog(depositLog)
-		return
+		return nil
 	}
 	log.WithField("signature", fmt.Sprintf("%#x", depositLog.Topics[0])).Debug("Not a valid signature")
+	return nil
 }
 
 // ProcessDepositLog processes the log which had been received from
 // the ETH1.0 chain by trying to ascertain which participant deposited
 // in the contract.
-func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
+func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) error {
 	_, depositData, merkleTreeIndex, _, err := contracts.UnpackDepositLogData(depositLog.Data)
 	if err != nil {
 		log.Errorf("Could not unpack log %v", err)
-		return
+		return nil
 	}
 	// If we have already seen this Merkle index, skip processing the log.
 	// This can happen sometimes when we receive the same log twice from the
@@ -70,9 +71,8 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 	// with the same log twice, causing an inconsistent state root.
 	index := binary.LittleEndian.Uint64(merkleTreeIndex)
 	if int64(index) <= w.lastReceivedMerkleIndex {
-		return
+		return nil
 	}
-	w.lastReceivedMerkleIndex = int64(index)
 
 	// We then decode the deposit input in order to create a deposit object
 	// we can store in our persistent DB.
@@ -99,13 +99,16 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 	}
 
 	// We always store all historical deposits in the DB.
-	w.beaconDB.InsertDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
+	if err := w.beaconDB.InsertDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber))); err != nil {
+		return fmt.Errorf("could not insert deposit %d: %v", index, err)
+	}
 
 	if !w.chainStarted {
 		w.chainStartDeposits = append(w.chainStartDeposits, depositData)
-	} else {
-		w.beaconDB.InsertPendingDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
+	} else if err := w.beaconDB.InsertPendingDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber))); err != nil {
+		return fmt.Errorf("could not insert pending deposit %d: %v", index, err)
 	}
+	w.lastReceivedMerkleIndex = int64(index)
 	if validData {
 		log.WithFields(logrus.Fields{
 			"publicKey":       fmt.Sprintf("%#x", depositInput.Pubkey),
@@ -117,27 +120,32 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 			"merkleTreeIndex": index,
 		}).Debug("Invalid deposit registered in deposit contract")
 	}
+	return nil
 }
 
 // ProcessChainStartLog processes the log which had been received from
 // the ETH1.0 chain by trying to determine when to start the beacon chain.
 func (w *Web3Service) ProcessChainStartLog(depositLog gethTypes.Log) {
 	chainStartCount.Inc()
-	chainStartDepositRoot, timestampData, err := contracts.UnpackChainStartLogData(depositLog.Data)
+	chainStartTime, err := w.setChainStart(depositLog)
 	if err != nil {
-		log.Errorf("Unable to unpack ChainStart log data %v", err)
+		log.Errorf("Unable to process ChainStart log: %v", err)
 		return
 	}
 
-	w.chainStartETH1Data = &pb.Eth1Data{
-		BlockHash32:       depositLog.BlockHash[:],
-		DepositRootHash32: chainStartDepositRoot[:],
-	}
+	log.WithFields(logrus.Fields{
+		"ChainStartTime": chainStartTime,
+	}).Info("Minimum number of validators reached for beacon-chain to start")
+	w.chainStartFeed.Send(chainStartTime)
+}
 
-	timestamp := binary.LittleEndian.Uint64(timestampData)
-	w.chainStarted = true
-	w.depositRoot = chainStartDepositRoot[:]
-	chainStartTime := time.Unix(int64(timestamp), 0)
+// setChainStart records the data of the ChainStart log and returns the chain
+// start time, without announcing the chain start.
+func (w *Web3Service) setChainStart(depositLog gethTypes.Log) (time.Time, error) {
+	chainStartDepositRoot, timestampData, err := contracts.UnpackChainStartLogData(depositLog.Data)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to unpack ChainStart log data: %v", err)
+	}
 
 	// We then update the in-memory deposit trie from the chain start
 	// deposits at this point, as this trie will be later needed for
@@ -147,18 +155,24 @@ func (w *Web3Service) ProcessChainStartLog(depositLog gethTypes.Log) {
 		int(params.BeaconConfig().DepositContractTreeDepth),
 	)
 	if err != nil {
-		log.Fatalf("Unable to generate deposit trie from ChainStart deposits: %v", err)
+		return time.Time{}, fmt.Errorf("unable to generate deposit trie from ChainStart deposits: %v", err)
 	}
+
+	w.chainStartETH1Data = &pb.Eth1Data{
+		BlockHash32:       depositLog.BlockHash[:],
+		DepositRootHash32: chainStartDepositRoot[:],
+	}
+	w.chainStarted = true
+	w.depositRoot = chainStartDepositRoot[:]
 	w.depositTrie = sparseMerkleTrie
 
-	log.WithFields(logrus.Fields{
-		"ChainStartTime": chainStartTime,
-	}).Info("Minimum number of validators reached for beacon-chain to start")
-	w.chainStartFeed.Send(chainStartTime)
+	timestamp := binary.LittleEndian.Uint64(timestampData)
+	return time.Unix(int64(timestamp), 0), nil
 }
 
 // processPastLogs processes all the past logs from the deposit contract and
-// updates the deposit trie with the data from each individual log.
+// updates the deposit trie with the data from each individual log. If deposits
+// were persisted by a previous run, it resumes from the last processed block.
 func (w *Web3Service) processPastLogs() error {
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{
@@ -166,15 +180,34 @@ func (w *Web3Service) processPastLogs() error {
 		},
 	}
 
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
-	if err != nil {
-		return err
+	lastProcessedBlock := w.beaconDB.LastProcessedETH1Block(w.ctx)
+	if lastProcessedBlock != nil {
+		if err := w.restoreDeposits(lastProcessedBlock); err != nil {
+			return fmt.Errorf("could not restore deposits: %v", err)
+		}
+		query.FromBlock = big.NewInt(0).Add(lastProcessedBlock, big.NewInt(1))
+		log.WithField("blockNumber", lastProcessedBlock).Info("Resuming deposit log processing")
 	}
 
-	for _, log := range logs {
-		w.ProcessLog(log)
+	if lastProcessedBlock == nil || lastProcessedBlock.Cmp(w.blockHeight) < 0 {
+		logs, err := w.httpLogger.FilterLogs(w.ctx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			if err := w.ProcessLog(log); err != nil {
+				return err
+			}
+		}
+		w.lastRequestedBlock.Set(w.blockHeight)
+	} else {
+		w.lastRequestedBlock.Set(lastProcessedBlock)
+	}
+
+	if err := w.beaconDB.SaveLastProcessedETH1Block(w.ctx, w.lastRequestedBlock); err != nil {
+		return fmt.Errorf("could not save last processed block: %v", err)
 	}
-	w.lastRequestedBlock.Set(w.blockHeight)
 
 	currentState, err := w.beaconDB.HeadState(w.ctx)
 	if err != nil {
@@ -187,6 +220,55 @@ func (w *Web3Service) processPastLogs() error {
 	return nil
 }
 
+// restoreDeposits rebuilds the in-memory deposit processing state of the service
+// from the deposits persisted in the beacon DB, which were processed up to the
+// given block number.
+func (w *Web3Service) restoreDeposits(lastProcessedBlock *big.Int) error {
+	deposits := w.beaconDB.AllDeposits(w.ctx, nil)
+	if len(deposits) > 0 {
+		w.lastReceivedMerkleIndex = int64(deposits[len(deposits)-1].MerkleTreeIndex)
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{
+			w.depositContractAddress,
+		},
+		Topics:  [][]common.Hash{{hashutil.Hash(chainStartEventSignature)}},
+		ToBlock: lastProcessedBlock,
+	}
+	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
+	if err != nil {
+		return err
+	}
+
+	// If the ChainStart log has not been emitted yet, all deposits are chain start deposits.
+	if len(logs) == 0 {
+		for _, deposit := range deposits {
+			w.chainStartDeposits = append(w.chainStartDeposits, deposit.DepositData)
+		}
+		return nil
+	}
+
+	chainStartLog := logs[0]
+	chainStartBlock := big.NewInt(int64(chainStartLog.BlockNumber))
+	for _, deposit := range w.beaconDB.AllDeposits(w.ctx, chainStartBlock) {
+		w.chainStartDeposits = append(w.chainStartDeposits, deposit.DepositData)
+	}
+
+	// Once the beacon chain has been initialized from the ChainStart log, the chain
+	// start is only restored and not announced again.
+	headState, err := w.beaconDB.HeadState(w.ctx)
+	if err != nil {
+		return fmt.Errorf("could not get head state: %v", err)
+	}
+	if headState != nil {
+		_, err := w.setChainStart(chainStartLog)
+		return err
+	}
+	w.ProcessChainStartLog(chainStartLog)
+	return nil
+}
+
 // requestBatchedLogs requests and processes all the logs from the period
 // last polled to now.
 func (w *Web3Service) requestBatchedLogs() error {
@@ -197,7 +279,7 @@ func (w *Web3Service) requestBatchedLogs() error {
 		Addresses: []common.Address{
 			w.depositContractAddress,
 		},
-		FromBlock: w.lastRequestedBlock.Add(w.lastRequestedBlock, big.NewInt(1)),
+		FromBlock: big.NewInt(0).Add(w.lastRequestedBlock, big.NewInt(1)),
 		ToBlock:   requestedBlock,
 	}
 	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
@@ -209,10 +291,12 @@ func (w *Web3Service) requestBatchedLogs() error {
 	if len(logs) > 0 {
 		log.Debug("Processing Batched Logs")
 		for _, log := range logs {
-			w.ProcessLog(log)
+			if err := w.ProcessLog(log); err != nil {
+				return err
+			}
 		}
 	}
 
 	w.lastRequestedBlock.Set(requestedBlock)
-	return nil
+	return w.beaconDB.SaveLastProcessedETH1Block(w.ctx, requestedBlock)
 }
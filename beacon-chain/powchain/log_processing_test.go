@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"math/big"
 	"testing"
 	"time"
 
@@ -34,6 +35,11 @@ func TestProcessDepositLog_OK(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -41,7 +47,7 @@ func TestProcessDepositLog_OK(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -97,6 +103,11 @@ func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -104,7 +115,7 @@ func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -153,8 +164,11 @@ func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 
 	web3Service.chainStarted = true
 
-	web3Service.ProcessDepositLog(logs[0])
-	web3Service.ProcessDepositLog(logs[1])
+	for _, log := range logs[:2] {
+		if err := web3Service.ProcessDepositLog(log); err != nil {
+			t.Fatalf("Could not process deposit log: %v", err)
+		}
+	}
 	pendingDeposits := web3Service.beaconDB.PendingDeposits(context.Background(), nil /*blockNum*/)
 	if len(pendingDeposits) != 2 {
 		t.Errorf("Unexpected number of deposits. Wanted 1 deposit, got %+v", pendingDeposits)
@@ -251,6 +265,11 @@ func TestProcessChainStartLog_8DuplicatePubkeys(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -258,7 +277,7 @@ func TestProcessChainStartLog_8DuplicatePubkeys(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -336,6 +355,11 @@ func TestProcessChainStartLog_8UniquePubkeys(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -343,7 +367,7 @@ func TestProcessChainStartLog_8UniquePubkeys(t *testing.T) {
 		Logger:          &goodLogger{},
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -415,6 +439,102 @@ func TestProcessChainStartLog_8UniquePubkeys(t *testing.T) {
 	hook.Reset()
 }
 
+func TestRestoreDeposits_DoesNotAnnounceRecordedChainStart(t *testing.T) {
+	hook := logTest.NewGlobal()
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	config := &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		HTTPLogger:      testAcc.backend,
+		ContractBackend: testAcc.backend,
+		BeaconDB:        beaconDB,
+	}
+	web3Service, err := NewWeb3Service(context.Background(), config)
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	testAcc.backend.Commit()
+	testAcc.backend.AdjustTime(time.Duration(int64(time.Now().Nanosecond())))
+
+	for i := 0; i < depositsReqForChainStart; i++ {
+		var stub [48]byte
+		binary.LittleEndian.PutUint64(stub[:], uint64(i))
+
+		data := &pb.DepositInput{
+			Pubkey:                      stub[:],
+			ProofOfPossession:           stub[:],
+			WithdrawalCredentialsHash32: []byte("withdraw"),
+		}
+
+		serializedData := new(bytes.Buffer)
+		if err := ssz.Encode(serializedData, data); err != nil {
+			t.Fatalf("Could not serialize data %v", err)
+		}
+
+		testAcc.txOpts.Value = amount32Eth
+		if _, err := testAcc.contract.Deposit(testAcc.txOpts, serializedData.Bytes()); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+
+		testAcc.backend.Commit()
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{
+			web3Service.depositContractAddress,
+		},
+	}
+	logs, err := testAcc.backend.FilterLogs(web3Service.ctx, query)
+	if err != nil {
+		t.Fatalf("Unable to retrieve logs %v", err)
+	}
+	for _, log := range logs {
+		if err := web3Service.ProcessLog(log); err != nil {
+			t.Fatalf("Could not process log: %v", err)
+		}
+	}
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{Slot: 1}); err != nil {
+		t.Fatal(err)
+	}
+	hook.Reset()
+
+	// A restarted service restores the chain start without announcing it again.
+	restarted, err := NewWeb3Service(context.Background(), config)
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	genesisTimeChan := make(chan time.Time, 1)
+	sub := restarted.chainStartFeed.Subscribe(genesisTimeChan)
+	defer sub.Unsubscribe()
+
+	if err := restarted.restoreDeposits(big.NewInt(int64(logs[len(logs)-1].BlockNumber))); err != nil {
+		t.Fatalf("Could not restore deposits: %v", err)
+	}
+	if !restarted.chainStarted || restarted.depositTrie == nil {
+		t.Error("Expected the chain start to be restored")
+	}
+	if !bytes.Equal(restarted.depositRoot, web3Service.depositRoot) {
+		t.Errorf("Wanted deposit root %#x, received %#x", web3Service.depositRoot, restarted.depositRoot)
+	}
+	select {
+	case <-genesisTimeChan:
+		t.Error("Expected the recorded chain start not to be announced again")
+	default:
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "Minimum number of validators reached for beacon-chain to start")
+}
+
 func TestUnpackChainStartLogData_OK(t *testing.T) {
 	testAcc, err := setup()
 	if err != nil {
@@ -548,6 +668,11 @@ func TestETH1DataGenesis_OK(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to set up simulated backend %v", err)
 	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
 	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
 		Endpoint:        endpoint,
 		DepositContract: testAcc.contractAddr,
@@ -555,7 +680,7 @@ func TestETH1DataGenesis_OK(t *testing.T) {
 		Logger:          testAcc.backend,
 		HTTPLogger:      &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        beaconDB,
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -91,7 +91,7 @@ type Web3Service struct {
 	chainStartDeposits      [][]byte
 	chainStarted            bool
 	chainStartETH1Data      *pb.Eth1Data
-	beaconDB                *db.BeaconDB
+	beaconDB                db.Database
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	isRunning               bool
 	runError                error
@@ -108,7 +108,7 @@ type Web3ServiceConfig struct {
 	HTTPLogger      bind.ContractFilterer
 	BlockFetcher    POWBlockFetcher
 	ContractBackend bind.ContractBackend
-	BeaconDB        *db.BeaconDB
+	BeaconDB        db.Database
 }
 
 // NewWeb3Service sets up a new instance with an ethclient when
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminServer defines a server implementation of the gRPC Admin service,
+// providing operators with insight into the p2p layer of the beacon node.
+// It is only served on its own loopback listener when an admin port is set.
+type AdminServer struct {
+	peerScorer peerScorer
+}
+
+// PeerScores returns the score of every peer tracked by the p2p server, lowest first,
+// with the number of times each behaviour was reported and the expiry of its ban.
+func (as *AdminServer) PeerScores(ctx context.Context, _ *ptypes.Empty) (*pb.PeerScoresResponse, error) {
+	if as.peerScorer == nil {
+		return nil, status.Error(codes.Unavailable, "peer scores are not available")
+	}
+	scores := as.peerScorer.PeerScores()
+	res := &pb.PeerScoresResponse{
+		Scores: make([]*pb.PeerScore, len(scores)),
+	}
+	for i, score := range scores {
+		res.Scores[i] = peerScoreToProto(score)
+	}
+	return res, nil
+}
+
+func peerScoreToProto(score *p2p.PeerScore) *pb.PeerScore {
+	behaviours := make(map[string]uint64, len(score.Behaviours))
+	for behaviour, count := range score.Behaviours {
+		behaviours[behaviour.String()] = count
+	}
+	pbScore := &pb.PeerScore{
+		PeerId:     score.Peer.Pretty(),
+		Score:      score.Score,
+		Behaviours: behaviours,
+	}
+	if !score.BannedUntil.IsZero() {
+		pbScore.Banned = true
+		pbScore.BannedUntil = score.BannedUntil.Unix()
+	}
+	return pbScore
+}
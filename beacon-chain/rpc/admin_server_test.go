@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockPeerScorer struct {
+	scores []*p2p.PeerScore
+}
+
+func (ms *mockPeerScorer) PeerScores() []*p2p.PeerScore {
+	return ms.scores
+}
+
+func TestPeerScores_ConvertsScores(t *testing.T) {
+	bannedUntil := time.Unix(1000, 0)
+	as := &AdminServer{
+		peerScorer: &mockPeerScorer{scores: []*p2p.PeerScore{
+			{
+				Peer:        peer.ID("a"),
+				Score:       -2500,
+				Behaviours:  map[p2p.Behaviour]uint64{p2p.RepPenalityInvalidProtobuf: 2},
+				BannedUntil: bannedUntil,
+			},
+			{
+				Peer:       peer.ID("b"),
+				Score:      8,
+				Behaviours: map[p2p.Behaviour]uint64{p2p.RepRewardValidBlock: 2},
+			},
+		}},
+	}
+
+	res, err := as.PeerScores(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.PeerScore{
+		{
+			PeerId:      peer.ID("a").Pretty(),
+			Score:       -2500,
+			Behaviours:  map[string]uint64{"invalid_protobuf": 2},
+			Banned:      true,
+			BannedUntil: bannedUntil.Unix(),
+		},
+		{
+			PeerId:     peer.ID("b").Pretty(),
+			Score:      8,
+			Behaviours: map[string]uint64{"valid_block": 2},
+		},
+	}
+	if !reflect.DeepEqual(res.Scores, want) {
+		t.Errorf("Wanted scores %v, received %v", want, res.Scores)
+	}
+}
+
+func TestPeerScores_UnavailableWithoutScorer(t *testing.T) {
+	as := &AdminServer{}
+	_, err := as.PeerScores(context.Background(), &ptypes.Empty{})
+	statusErr, ok := status.FromError(err)
+	if !ok {
+		t.Fatal(err)
+	}
+	if statusErr.Code() != codes.Unavailable {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// packingCandidate is an attestation along with the validators it covers.
+type packingCandidate struct {
+	att          *pbp2p.Attestation
+	epoch        uint64
+	participants []uint64
+	selected     bool
+}
+
+// packAttestations selects up to MaxAttestations attestations for a block proposed on top of
+// the state among all the valid pending attestations, which may be more than fit in a block.
+// Proposers are rewarded for every attester they include first within an epoch, so attestations
+// are scored by the number of their participants which are not included by the state's latest
+// attestations nor by an attestation selected before, and the one with the highest score is
+// selected until no attestation covers a new validator. The selected attestations are returned
+// in slot ascending order along with the expected proposer reward in Gwei.
+func packAttestations(beaconState *pbp2p.BeaconState, atts []*pbp2p.Attestation) ([]*pbp2p.Attestation, uint64, error) {
+	// Validators included per target epoch.
+	included := make(map[uint64]map[uint64]bool)
+	include := func(epoch uint64, participants []uint64) {
+		if included[epoch] == nil {
+			included[epoch] = make(map[uint64]bool)
+		}
+		for _, idx := range participants {
+			included[epoch][idx] = true
+		}
+	}
+	for _, pending := range beaconState.LatestAttestations {
+		participants, err := helpers.AttestationParticipants(beaconState, pending.Data, pending.AggregationBitfield)
+		if err != nil {
+			log.WithError(err).Debug("Could not get participants of included attestation")
+			continue
+		}
+		include(helpers.SlotToEpoch(pending.Data.Slot), participants)
+	}
+
+	candidates := make([]*packingCandidate, 0, len(atts))
+	for _, att := range atts {
+		participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+		if err != nil {
+			return nil, 0, err
+		}
+		candidates = append(candidates, &packingCandidate{
+			att:          att,
+			epoch:        helpers.SlotToEpoch(att.Data.Slot),
+			participants: participants,
+		})
+	}
+	newParticipants := func(c *packingCandidate) []uint64 {
+		var uncovered []uint64
+		for _, idx := range c.participants {
+			if !included[c.epoch][idx] {
+				uncovered = append(uncovered, idx)
+			}
+		}
+		return uncovered
+	}
+
+	activeIndices := helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, helpers.PrevEpoch(beaconState))
+	baseRewardQuotient := helpers.BaseRewardQuotient(helpers.TotalBalance(beaconState, activeIndices))
+
+	var packed []*pbp2p.Attestation
+	var expectedReward uint64
+	for uint64(len(packed)) < params.BeaconConfig().MaxAttestations {
+		var best *packingCandidate
+		var bestParticipants []uint64
+		for _, c := range candidates {
+			if c.selected {
+				continue
+			}
+			if uncovered := newParticipants(c); len(uncovered) > len(bestParticipants) {
+				best = c
+				bestParticipants = uncovered
+			}
+		}
+		if best == nil {
+			break
+		}
+		best.selected = true
+		include(best.epoch, bestParticipants)
+		packed = append(packed, best.att)
+		if baseRewardQuotient == 0 {
+			continue
+		}
+		for _, idx := range bestParticipants {
+			expectedReward += helpers.BaseReward(beaconState, idx, baseRewardQuotient) /
+				params.BeaconConfig().AttestationInclusionRewardQuotient
+		}
+	}
+	sort.SliceStable(packed, func(i, j int) bool {
+		return packed[i].Data.Slot < packed[j].Data.Slot
+	})
+	return packed, expectedReward, nil
+}
@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// packingState returns a state with committees of 16 validators along with the data of an
+// attestation of the first committee of the previous slot.
+func packingState(t *testing.T) (*pbp2p.BeaconState, *pbp2p.AttestationData) {
+	validators := make([]*pbp2p.Validator, 16*params.BeaconConfig().SlotsPerEpoch)
+	balances := make([]uint64, len(validators))
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pbp2p.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	beaconState := &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 5,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, beaconState.Slot-1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return beaconState, &pbp2p.AttestationData{
+		Slot:  beaconState.Slot - 1,
+		Shard: committees[0].Shard,
+	}
+}
+
+func TestPackAttestations_MaximisesNewlyIncludedValidators(t *testing.T) {
+	beaconState, data := packingState(t)
+	validators := beaconState.ValidatorRegistry
+	attestation := func(bitfield []byte) *pbp2p.Attestation {
+		return &pbp2p.Attestation{Data: data, AggregationBitfield: bitfield}
+	}
+	// The first 4 members of the committee are included already.
+	beaconState.LatestAttestations = []*pbp2p.PendingAttestation{
+		{Data: data, AggregationBitfield: []byte{0xf0, 0x00}},
+	}
+
+	firstHalf := attestation([]byte{0xff, 0x00})
+	middle := attestation([]byte{0x0f, 0xf0})
+	last := attestation([]byte{0x00, 0x0f})
+	includedAlready := attestation([]byte{0xf0, 0x00})
+	packed, reward, err := packAttestations(beaconState, []*pbp2p.Attestation{firstHalf, includedAlready, last, middle})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The middle attestation covers 8 new validators, after which the first half
+	// does not cover any validator which is not included.
+	want := []*pbp2p.Attestation{middle, last}
+	if !reflect.DeepEqual(packed, want) {
+		t.Errorf("Expected attestations %v, received %v", want, packed)
+	}
+
+	activeIndices := helpers.ActiveValidatorIndices(validators, helpers.PrevEpoch(beaconState))
+	baseRewardQuotient := helpers.BaseRewardQuotient(helpers.TotalBalance(beaconState, activeIndices))
+	wantReward := 12 * (helpers.BaseReward(beaconState, 0, baseRewardQuotient) /
+		params.BeaconConfig().AttestationInclusionRewardQuotient)
+	if reward != wantReward {
+		t.Errorf("Expected reward %d, received %d", wantReward, reward)
+	}
+}
+
+func TestPackAttestations_SelectsAmongAllCandidates(t *testing.T) {
+	prevConfig := params.BeaconConfig()
+	cfg := *prevConfig
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(&cfg)
+	defer params.OverrideBeaconConfig(prevConfig)
+
+	beaconState, data := packingState(t)
+	attestation := func(bitfield []byte) *pbp2p.Attestation {
+		return &pbp2p.Attestation{Data: data, AggregationBitfield: bitfield}
+	}
+	// The pool returns more candidates than fit in a block, and the ones covering
+	// the most validators come last.
+	first := attestation([]byte{0x80, 0x00})
+	second := attestation([]byte{0x40, 0x00})
+	wide := attestation([]byte{0x3f, 0xc0})
+	rest := attestation([]byte{0x00, 0x3f})
+	packed, _, err := packAttestations(beaconState, []*pbp2p.Attestation{first, second, wide, rest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pbp2p.Attestation{wide, rest}
+	if !reflect.DeepEqual(packed, want) {
+		t.Errorf("Expected attestations %v, received %v", want, packed)
+	}
+}
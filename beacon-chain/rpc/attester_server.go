@@ -22,7 +22,7 @@ import (
 // providing RPC methods for validators acting as attesters to broadcast votes on beacon blocks.
 type AttesterServer struct {
 	p2p              p2p.Broadcaster
-	beaconDB         *db.BeaconDB
+	beaconDB         db.Database
 	operationService operationService
 	cache            *cache.AttestationCache
 }
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -24,11 +26,12 @@ import (
 // providing RPC endpoints for obtaining the canonical beacon chain head,
 // fetching latest observed attestations, and more.
 type BeaconServer struct {
-	beaconDB            *db.BeaconDB
+	beaconDB            db.Database
 	ctx                 context.Context
 	powChainService     powChainService
 	chainService        chainService
 	targetsFetcher      blockchain.TargetsFetcher
+	forkChoiceFetcher   blockchain.ForkChoiceStoreFetcher
 	operationService    operationService
 	incomingAttestation chan *pbp2p.Attestation
 	canonicalStateChan  chan *pbp2p.BeaconState
@@ -410,6 +413,79 @@ func (bs *BeaconServer) BlockTreeBySlots(ctx context.Context, req *pb.TreeBlockS
 	}, nil
 }
 
+// StateAtSlot returns the canonical beacon state at the requested slot. States since the
+// last finalized state are regenerated from the finalized state, older ones from the
+// archived state checkpoints, which are only kept by nodes running in archive mode.
+func (bs *BeaconServer) StateAtSlot(ctx context.Context, req *pb.StateAtSlotRequest) (*pbp2p.BeaconState, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if req.Slot > headState.Slot {
+		return nil, fmt.Errorf(
+			"requested slot %d is after the head slot %d",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			headState.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	finalizedState, err := bs.beaconDB.FinalizedState()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve finalized state: %v", err)
+	}
+	if req.Slot >= finalizedState.Slot {
+		return stategenerator.GenerateStateFromBlock(ctx, bs.beaconDB, req.Slot)
+	}
+	if !bs.beaconDB.ArchiveMode() {
+		return nil, fmt.Errorf(
+			"state at slot %d is before the finalized slot %d and only available in archive mode",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			finalizedState.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	return stategenerator.GenerateStateFromArchive(ctx, bs.beaconDB, req.Slot)
+}
+
+// ForkChoiceStore returns the fork choice store as of the last run of the fork choice
+// rule, including every block since the justified block with its vote weight and the
+// latest attestation target of each validator.
+func (bs *BeaconServer) ForkChoiceStore(ctx context.Context, _ *ptypes.Empty) (*pb.ForkChoiceStoreResponse, error) {
+	store, err := bs.forkChoiceFetcher.ForkChoiceStore()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve fork choice store: %v", err)
+	}
+	res := &pb.ForkChoiceStoreResponse{
+		JustifiedCheckpoint: &pb.ForkChoiceStoreResponse_Checkpoint{
+			Epoch:     store.JustifiedEpoch,
+			BlockRoot: store.JustifiedRoot[:],
+		},
+		FinalizedCheckpoint: &pb.ForkChoiceStoreResponse_Checkpoint{
+			Epoch:     store.FinalizedEpoch,
+			BlockRoot: store.FinalizedRoot[:],
+		},
+		HeadSlot:      store.HeadSlot,
+		HeadBlockRoot: store.HeadRoot[:],
+	}
+	for _, n := range store.Nodes {
+		root, parentRoot := n.Root, n.ParentRoot
+		res.Nodes = append(res.Nodes, &pb.ForkChoiceStoreResponse_ForkChoiceNode{
+			Slot:       n.Slot,
+			BlockRoot:  root[:],
+			ParentRoot: parentRoot[:],
+			Weight:     n.Weight,
+		})
+	}
+	for index, target := range store.AttestationTargets {
+		res.AttestationTargets = append(res.AttestationTargets, &pb.ForkChoiceStoreResponse_ValidatorTarget{
+			ValidatorIndex: index,
+			Target:         target,
+		})
+	}
+	sort.Slice(res.AttestationTargets, func(i, j int) bool {
+		return res.AttestationTargets[i].ValidatorIndex < res.AttestationTargets[j].ValidatorIndex
+	})
+	return res, nil
+}
+
 func (bs *BeaconServer) defaultDataResponse(ctx context.Context, currentHeight *big.Int, eth1FollowDistance int64) (*pb.Eth1DataResponse, error) {
 	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
 	blockHash, err := bs.powChainService.BlockHashByHeight(ctx, ancestorHeight)
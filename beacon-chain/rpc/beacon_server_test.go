@@ -15,6 +15,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/blocktree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -1397,3 +1399,71 @@ func Benchmark_Eth1Data(b *testing.B) {
 		}
 	}
 }
+
+func TestStateAtSlot_ArgsValidation(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	headState := &pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 100}
+	if err := db.SaveState(ctx, headState); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveFinalizedState(&pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 64}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{
+		beaconDB: db,
+	}
+
+	want := "is after the head slot"
+	if _, err := bs.StateAtSlot(ctx, &pb.StateAtSlotRequest{Slot: headState.Slot + 1}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+	want = "only available in archive mode"
+	if _, err := bs.StateAtSlot(ctx, &pb.StateAtSlotRequest{Slot: params.BeaconConfig().GenesisSlot + 10}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestForkChoiceStore_ConvertsStore(t *testing.T) {
+	bs := &BeaconServer{forkChoiceFetcher: &mockChainService{}}
+	if _, err := bs.ForkChoiceStore(context.Background(), &ptypes.Empty{}); err == nil {
+		t.Error("Expected error before the fork choice rule ran")
+	}
+
+	justifiedRoot := [32]byte{'A'}
+	headRoot := [32]byte{'B'}
+	store := &blockchain.ForkChoiceStore{
+		JustifiedEpoch: params.BeaconConfig().GenesisEpoch + 1,
+		JustifiedRoot:  justifiedRoot,
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch,
+		HeadSlot:       params.BeaconConfig().GenesisSlot + 65,
+		HeadRoot:       headRoot,
+		Nodes: []*blocktree.NodeInfo{
+			{Slot: params.BeaconConfig().GenesisSlot + 64, Root: justifiedRoot, Weight: 64},
+			{Slot: params.BeaconConfig().GenesisSlot + 65, Root: headRoot, ParentRoot: justifiedRoot, Weight: 32},
+		},
+		AttestationTargets: map[uint64]*pbp2p.AttestationTarget{
+			2: {Slot: params.BeaconConfig().GenesisSlot + 64, BlockRoot: justifiedRoot[:]},
+			1: {Slot: params.BeaconConfig().GenesisSlot + 65, BlockRoot: headRoot[:]},
+		},
+	}
+	bs.forkChoiceFetcher = &mockChainService{forkChoiceStore: store}
+	res, err := bs.ForkChoiceStore(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not retrieve fork choice store: %v", err)
+	}
+	if res.JustifiedCheckpoint.Epoch != store.JustifiedEpoch || !bytes.Equal(res.JustifiedCheckpoint.BlockRoot, justifiedRoot[:]) {
+		t.Errorf("Wanted justified checkpoint %d %#x, received %v", store.JustifiedEpoch, justifiedRoot, res.JustifiedCheckpoint)
+	}
+	if res.HeadSlot != store.HeadSlot || !bytes.Equal(res.HeadBlockRoot, headRoot[:]) {
+		t.Errorf("Wanted head %d %#x, received %d %#x", store.HeadSlot, headRoot, res.HeadSlot, res.HeadBlockRoot)
+	}
+	if len(res.Nodes) != 2 || res.Nodes[1].Weight != 32 || !bytes.Equal(res.Nodes[1].ParentRoot, justifiedRoot[:]) {
+		t.Errorf("Wanted the nodes of the store, received %v", res.Nodes)
+	}
+	if len(res.AttestationTargets) != 2 || res.AttestationTargets[0].ValidatorIndex != 1 {
+		t.Errorf("Wanted attestation targets sorted by validator index, received %v", res.AttestationTargets)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -21,7 +22,7 @@ import (
 // providing RPC endpoints for computing state transitions and state roots, proposing
 // beacon blocks to a beacon node, and more.
 type ProposerServer struct {
-	beaconDB           *db.BeaconDB
+	beaconDB           db.Database
 	chainService       chainService
 	powChainService    powChainService
 	operationService   operationService
@@ -100,6 +101,10 @@ func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *pbp2p.BeaconBlo
 // proposed blocks when performing their responsibility. If desired, callers can choose to filter pending
 // attestations which are ready for inclusion. That is, attestations that satisfy:
 // attestation.slot + MIN_ATTESTATION_INCLUSION_DELAY <= state.slot.
+// Among the valid attestations, up to MaxAttestations covering the most validators not included yet
+// are packed, see packAttestations, and the response reports the reward expected for them. The pool
+// hands out aggregates, so failed attestations are deleted through the operations service which
+// deletes the stored attestations they were aggregated from.
 func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.PendingAttestationsRequest) (*pb.PendingAttestationsResponse, error) {
 	beaconState, err := ps.beaconDB.HeadState(ctx)
 	if err != nil {
@@ -139,9 +144,11 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 		}
 	}
 
+	// Attestations with invalid signatures would make the proposed block fail processing.
+	verifySignatures := !featureconfig.FeatureConfig().DisableSignatureVerification
 	validAtts := make([]*pbp2p.Attestation, 0, len(attsReadyForInclusion))
 	for _, att := range attsReadyForInclusion {
-		if err := blocks.VerifyAttestation(beaconState, att, false); err != nil {
+		if err := blocks.VerifyAttestation(beaconState, att, verifySignatures); err != nil {
 			if ctx.Err() != nil {
 				return nil, ctx.Err()
 			}
@@ -150,7 +157,7 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 				"slot":     att.Data.Slot - params.BeaconConfig().GenesisSlot,
 				"headRoot": fmt.Sprintf("%#x", bytesutil.Trunc(att.Data.BeaconBlockRootHash32))}).Info(
 				"Deleting failed pending attestation from DB")
-			if err := ps.beaconDB.DeleteAttestation(att); err != nil {
+			if err := ps.operationService.DeleteAttestation(att); err != nil {
 				return nil, fmt.Errorf("could not delete failed attestation: %v", err)
 			}
 			continue
@@ -166,15 +173,15 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 				"slot":     att.Data.Slot - params.BeaconConfig().GenesisSlot,
 				"headRoot": fmt.Sprintf("%#x", bytesutil.Trunc(att.Data.BeaconBlockRootHash32))}).Info(
 				"Deleting failed pending attestation from DB")
-			if err := ps.beaconDB.DeleteAttestation(att); err != nil {
+			if err := ps.operationService.DeleteAttestation(att); err != nil {
 				return nil, fmt.Errorf("could not delete failed attestation: %v", err)
 			}
 			continue
 		}
 		canonical, err := ps.operationService.IsAttCanonical(ctx, att)
 		if err != nil {
-			// Delete attestation that failed to verify as canonical.
-			if err := ps.beaconDB.DeleteAttestation(att); err != nil {
+			// Delete the attestations aggregated into the one that failed to verify as canonical.
+			if err := ps.operationService.DeleteAttestation(att); err != nil {
 				return nil, fmt.Errorf("could not delete failed attestation: %v", err)
 			}
 			return nil, fmt.Errorf("could not verify canonical attestation: %v", err)
@@ -187,8 +194,54 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 		validAtts = append(validAtts, att)
 	}
 
+	packedAtts, expectedReward, err := packAttestations(beaconState, validAtts)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack attestations: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"attestations":   len(packedAtts),
+		"expectedReward": expectedReward,
+	}).Debug("Packed pending attestations")
+
 	return &pb.PendingAttestationsResponse{
-		PendingAttestations: validAtts,
+		PendingAttestations: packedAtts,
+		ExpectedReward:      expectedReward,
+	}, nil
+}
+
+// PendingProposerSlashings retrieves the proposer slashings kept in the beacon node's operations pool
+// which have not yet been included into the beacon chain, up to the maximum a block can include.
+func (ps *ProposerServer) PendingProposerSlashings(ctx context.Context, _ *ptypes.Empty) (*pb.PendingProposerSlashingsResponse, error) {
+	slashings, err := ps.operationService.PendingProposerSlashings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending proposer slashings from operations service: %v", err)
+	}
+	return &pb.PendingProposerSlashingsResponse{
+		PendingProposerSlashings: slashings,
+	}, nil
+}
+
+// PendingAttesterSlashings retrieves the attester slashings kept in the beacon node's operations pool
+// which have not yet been included into the beacon chain, up to the maximum a block can include.
+func (ps *ProposerServer) PendingAttesterSlashings(ctx context.Context, _ *ptypes.Empty) (*pb.PendingAttesterSlashingsResponse, error) {
+	slashings, err := ps.operationService.PendingAttesterSlashings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attester slashings from operations service: %v", err)
+	}
+	return &pb.PendingAttesterSlashingsResponse{
+		PendingAttesterSlashings: slashings,
+	}, nil
+}
+
+// PendingExits retrieves the voluntary exits kept in the beacon node's operations pool which have
+// not yet been included into the beacon chain, up to the maximum a block can include.
+func (ps *ProposerServer) PendingExits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingExitsResponse, error) {
+	exits, err := ps.operationService.PendingExits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending exits from operations service: %v", err)
+	}
+	return &pb.PendingExitsResponse{
+		PendingExits: exits,
 	}, nil
 }
 
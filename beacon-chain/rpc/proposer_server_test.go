@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -18,8 +19,10 @@ import (
 )
 
 func init() {
+	// The attestations built by these tests are not signed.
 	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableComputeStateRoot: true,
+		EnableComputeStateRoot:       true,
+		DisableSignatureVerification: true,
 	})
 }
 
@@ -377,6 +380,10 @@ func TestPendingAttestations_FiltersExpiredAttestations(t *testing.T) {
 	if !reflect.DeepEqual(res.PendingAttestations, expectedAtts) {
 		t.Error("Did not receive expected attestations")
 	}
+	// The expired attestations and the one with an incorrect justified epoch are deleted.
+	if deleted := len(opService.deletedAttestations); deleted != len(opService.pendingAttestations)-expectedNumberOfAttestations {
+		t.Errorf("Expected the failed attestations to be deleted, %d were deleted", deleted)
+	}
 }
 
 func TestPendingAttestations_OK(t *testing.T) {
@@ -436,3 +443,31 @@ func TestPendingAttestations_OK(t *testing.T) {
 		t.Error("Expected pending attestations list to be non-empty")
 	}
 }
+
+func TestPendingSlashings_ReturnsPoolSlashings(t *testing.T) {
+	proposerSlashings := []*pbp2p.ProposerSlashing{{ProposerIndex: 3}}
+	attesterSlashings := []*pbp2p.AttesterSlashing{{
+		SlashableAttestation_1: &pbp2p.SlashableAttestation{ValidatorIndices: []uint64{1}},
+	}}
+	proposerServer := &ProposerServer{
+		operationService: &mockOperationService{
+			pendingProposerSlashings: proposerSlashings,
+			pendingAttesterSlashings: attesterSlashings,
+		},
+	}
+
+	proposerResp, err := proposerServer.PendingProposerSlashings(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(proposerResp.PendingProposerSlashings, proposerSlashings) {
+		t.Errorf("Wanted %v, received %v", proposerSlashings, proposerResp.PendingProposerSlashings)
+	}
+	attesterResp, err := proposerServer.PendingAttesterSlashings(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(attesterResp.PendingAttesterSlashings, attesterSlashings) {
+		t.Errorf("Wanted %v, received %v", attesterSlashings, attesterResp.PendingAttesterSlashings)
+	}
+}
@@ -40,12 +40,18 @@ type chainService interface {
 	blockchain.BlockReceiver
 	blockchain.ForkChoice
 	blockchain.TargetsFetcher
+	blockchain.ForkChoiceStoreFetcher
 }
 
 type operationService interface {
 	PendingAttestations(ctx context.Context) ([]*pbp2p.Attestation, error)
+	PendingProposerSlashings(ctx context.Context) ([]*pbp2p.ProposerSlashing, error)
+	PendingAttesterSlashings(ctx context.Context) ([]*pbp2p.AttesterSlashing, error)
+	PendingExits(ctx context.Context) ([]*pbp2p.VoluntaryExit, error)
 	IsAttCanonical(ctx context.Context, att *pbp2p.Attestation) (bool, error)
+	DeleteAttestation(att *pbp2p.Attestation) error
 	HandleAttestations(context.Context, proto.Message) error
+	HandleValidatorExits(context.Context, proto.Message) error
 	IncomingAttFeed() *event.Feed
 }
 
@@ -65,17 +71,24 @@ type syncService interface {
 	Status() error
 }
 
+type peerScorer interface {
+	PeerScores() []*p2p.PeerScore
+}
+
 // Service defining an RPC server for a beacon node.
 type Service struct {
 	ctx                 context.Context
 	cancel              context.CancelFunc
-	beaconDB            *db.BeaconDB
+	beaconDB            db.Database
 	chainService        chainService
 	powChainService     powChainService
 	operationService    operationService
 	syncService         syncService
 	port                string
 	listener            net.Listener
+	adminPort           string
+	adminListener       net.Listener
+	adminServer         *grpc.Server
 	withCert            string
 	withKey             string
 	grpcServer          *grpc.Server
@@ -83,19 +96,22 @@ type Service struct {
 	incomingAttestation chan *pbp2p.Attestation
 	credentialError     error
 	p2p                 p2p.Broadcaster
+	peerScorer          peerScorer
 }
 
 // Config options for the beacon node RPC server.
 type Config struct {
 	Port             string
+	AdminPort        string // Port of the loopback admin service, the service is disabled when empty.
 	CertFlag         string
 	KeyFlag          string
-	BeaconDB         *db.BeaconDB
+	BeaconDB         db.Database
 	ChainService     chainService
 	POWChainService  powChainService
 	OperationService operationService
 	SyncService      syncService
 	Broadcaster      p2p.Broadcaster
+	PeerScorer       peerScorer
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -111,7 +127,9 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		powChainService:     cfg.POWChainService,
 		operationService:    cfg.OperationService,
 		syncService:         cfg.SyncService,
+		peerScorer:          cfg.PeerScorer,
 		port:                cfg.Port,
+		adminPort:           cfg.AdminPort,
 		withCert:            cfg.CertFlag,
 		withKey:             cfg.KeyFlag,
 		canonicalStateChan:  make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
@@ -160,6 +178,7 @@ func (s *Service) Start() {
 		powChainService:     s.powChainService,
 		chainService:        s.chainService,
 		targetsFetcher:      s.chainService,
+		forkChoiceFetcher:   s.chainService,
 		operationService:    s.operationService,
 		incomingAttestation: s.incomingAttestation,
 		canonicalStateChan:  s.canonicalStateChan,
@@ -184,6 +203,8 @@ func (s *Service) Start() {
 		chainService:       s.chainService,
 		canonicalStateChan: s.canonicalStateChan,
 		powChainService:    s.powChainService,
+		operationService:   s.operationService,
+		p2p:                s.p2p,
 	}
 	pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
 	pb.RegisterProposerServiceServer(s.grpcServer, proposerServer)
@@ -203,6 +224,38 @@ func (s *Service) Start() {
 			}
 		}
 	}()
+
+	if s.adminPort != "" {
+		s.startAdminServer()
+	}
+}
+
+// startAdminServer serves the admin service on its own listener bound to the loopback
+// interface, so the p2p internals it exposes are not reachable from the public RPC port
+// or the gateway.
+func (s *Service) startAdminServer() {
+	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%s", s.adminPort))
+	if err != nil {
+		log.Errorf("Could not listen to admin port 127.0.0.1:%s: %v", s.adminPort, err)
+		return
+	}
+	s.adminListener = lis
+	log.WithField("port", s.adminPort).Info("Serving admin service on localhost")
+
+	s.adminServer = grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(),
+			grpc_prometheus.UnaryServerInterceptor,
+		)),
+	)
+	pb.RegisterAdminServiceServer(s.adminServer, &AdminServer{
+		peerScorer: s.peerScorer,
+	})
+	go func() {
+		if err := s.adminServer.Serve(lis); err != nil {
+			log.Errorf("Could not serve admin gRPC: %v", err)
+		}
+	}()
 }
 
 // Stop the service.
@@ -213,6 +266,9 @@ func (s *Service) Stop() error {
 		s.grpcServer.GracefulStop()
 		log.Debug("Initiated graceful stop of gRPC server")
 	}
+	if s.adminListener != nil {
+		s.adminServer.GracefulStop()
+	}
 	return nil
 }
 
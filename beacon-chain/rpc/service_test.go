@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -32,7 +33,12 @@ func (t *TestLogger) Errorf(format string, args ...interface{}) {
 }
 
 type mockOperationService struct {
-	pendingAttestations []*pb.Attestation
+	pendingAttestations      []*pb.Attestation
+	pendingProposerSlashings []*pb.ProposerSlashing
+	pendingAttesterSlashings []*pb.AttesterSlashing
+	pendingExits             []*pb.VoluntaryExit
+	handledExits             []*pb.VoluntaryExit
+	deletedAttestations      []*pb.Attestation
 }
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
@@ -47,10 +53,32 @@ func (ms *mockOperationService) HandleAttestations(_ context.Context, _ proto.Me
 	return nil
 }
 
+func (ms *mockOperationService) HandleValidatorExits(_ context.Context, message proto.Message) error {
+	ms.handledExits = append(ms.handledExits, message.(*pb.VoluntaryExit))
+	return nil
+}
+
 func (ms *mockOperationService) IsAttCanonical(_ context.Context, att *pb.Attestation) (bool, error) {
 	return true, nil
 }
 
+func (ms *mockOperationService) DeleteAttestation(att *pb.Attestation) error {
+	ms.deletedAttestations = append(ms.deletedAttestations, att)
+	return nil
+}
+
+func (ms *mockOperationService) PendingProposerSlashings(_ context.Context) ([]*pb.ProposerSlashing, error) {
+	return ms.pendingProposerSlashings, nil
+}
+
+func (ms *mockOperationService) PendingAttesterSlashings(_ context.Context) ([]*pb.AttesterSlashing, error) {
+	return ms.pendingAttesterSlashings, nil
+}
+
+func (ms *mockOperationService) PendingExits(_ context.Context) ([]*pb.VoluntaryExit, error) {
+	return ms.pendingExits, nil
+}
+
 func (ms *mockOperationService) PendingAttestations(_ context.Context) ([]*pb.Attestation, error) {
 	if ms.pendingAttestations != nil {
 		return ms.pendingAttestations, nil
@@ -88,6 +116,7 @@ type mockChainService struct {
 	stateInitializedFeed *event.Feed
 	canonicalBlocks      map[uint64][]byte
 	targets              map[uint64]*pb.AttestationTarget
+	forkChoiceStore      *blockchain.ForkChoiceStore
 }
 
 func (m *mockChainService) StateInitializedFeed() *event.Feed {
@@ -122,6 +151,13 @@ func (m *mockChainService) AttestationTargets(justifiedState *pb.BeaconState) (m
 	return m.targets, nil
 }
 
+func (m *mockChainService) ForkChoiceStore() (*blockchain.ForkChoiceStore, error) {
+	if m.forkChoiceStore == nil {
+		return nil, errors.New("fork choice rule has not run yet")
+	}
+	return m.forkChoiceStore, nil
+}
+
 func newMockChainService() *mockChainService {
 	return &mockChainService{
 		blockFeed:            new(event.Feed),
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
@@ -16,8 +17,11 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 )
 
 // ValidatorServer defines a server implementation of the gRPC Validator service,
@@ -26,10 +30,12 @@ import (
 // and more.
 type ValidatorServer struct {
 	ctx                context.Context
-	beaconDB           *db.BeaconDB
+	beaconDB           db.Database
 	chainService       chainService
 	canonicalStateChan chan *pbp2p.BeaconState
 	powChainService    powChainService
+	operationService   operationService
+	p2p                p2p.Broadcaster
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -304,6 +310,103 @@ func (vs *ValidatorServer) ExitedValidators(
 	return resp, nil
 }
 
+// ProposeExit verifies a signed voluntary exit against the head state with the rules of block
+// processing, saves it in the operations pool for inclusion in a block and broadcasts it to peers.
+func (vs *ValidatorServer) ProposeExit(ctx context.Context, exit *pbp2p.VoluntaryExit) (*pb.ProposeExitResponse, error) {
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	verifySignatures := !featureconfig.FeatureConfig().DisableSignatureVerification
+	if err := blocks.VerifyExit(beaconState, exit, verifySignatures); err != nil {
+		return nil, fmt.Errorf("invalid exit: %v", err)
+	}
+	h, err := hashutil.HashProto(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	if err := vs.operationService.HandleValidatorExits(ctx, exit); err != nil {
+		return nil, fmt.Errorf("could not save exit: %v", err)
+	}
+	vs.p2p.Broadcast(ctx, exit)
+	log.WithFields(logrus.Fields{
+		"validatorIndex": exit.ValidatorIndex,
+		"exitRoot":       fmt.Sprintf("%#x", bytesutil.Trunc(h[:])),
+	}).Info("Broadcasting voluntary exit")
+	return &pb.ProposeExitResponse{ExitRootHash32: h[:]}, nil
+}
+
+// ValidatorLiveness reports which of the requested validators were seen attesting or proposing a
+// block in the epoch. Attestations are taken from the head state and from the operations pool, so
+// attestations which are not yet included in a block count as well, and the proposers of the blocks
+// received at the slots of the epoch are derived from the head state's shuffling. Only the current
+// and previous epochs of the head state can be checked.
+func (vs *ValidatorServer) ValidatorLiveness(ctx context.Context, req *pb.ValidatorLivenessRequest) (*pb.ValidatorLivenessResponse, error) {
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	if req.Epoch > currentEpoch || req.Epoch < helpers.PrevEpoch(beaconState) {
+		return nil, fmt.Errorf(
+			"can only check the liveness of validators in epochs %d and %d, received %d",
+			helpers.PrevEpoch(beaconState)-params.BeaconConfig().GenesisEpoch,
+			currentEpoch-params.BeaconConfig().GenesisEpoch,
+			req.Epoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+
+	live := make(map[uint64]bool)
+	markParticipants := func(data *pbp2p.AttestationData, bitfield []byte) {
+		if helpers.SlotToEpoch(data.Slot) != req.Epoch {
+			return
+		}
+		participants, err := helpers.AttestationParticipants(beaconState, data, bitfield)
+		if err != nil {
+			log.WithError(err).Debug("Could not get attestation participants")
+			return
+		}
+		for _, idx := range participants {
+			live[idx] = true
+		}
+	}
+	for _, att := range beaconState.LatestAttestations {
+		markParticipants(att.Data, att.AggregationBitfield)
+	}
+	poolAtts, err := vs.beaconDB.Attestations()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attestations: %v", err)
+	}
+	for _, att := range poolAtts {
+		markParticipants(att.Data, att.AggregationBitfield)
+	}
+
+	epochStart := helpers.StartSlot(req.Epoch)
+	for slot := epochStart; slot < epochStart+params.BeaconConfig().SlotsPerEpoch && slot <= beaconState.Slot; slot++ {
+		blocks, err := vs.beaconDB.BlocksBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve blocks at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if len(blocks) == 0 {
+			continue
+		}
+		proposerIdx, err := helpers.BeaconProposerIndex(beaconState, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get beacon proposer index: %v", err)
+		}
+		live[proposerIdx] = true
+	}
+
+	statuses := make([]*pb.ValidatorLivenessResponse_Liveness, len(req.Indices))
+	for i, idx := range req.Indices {
+		statuses[i] = &pb.ValidatorLivenessResponse_Liveness{
+			Index:  idx,
+			IsLive: live[idx],
+		}
+	}
+	return &pb.ValidatorLivenessResponse{Statuses: statuses}, nil
+}
+
 func (vs *ValidatorServer) validatorStatus(
 	ctx context.Context, pubKey []byte, chainStarted bool,
 	chainStartKeys map[[96]byte]bool, idxMap map[[32]byte]int,
@@ -1002,3 +1002,116 @@ func TestAddNonActivePublicKeysAssignmentStatus(t *testing.T) {
 		t.Errorf("Unknown public key status wasn't returned: %v", assignments)
 	}
 }
+
+func TestProposeExit_BroadcastsValidExit(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: []*pbp2p.Validator{
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			{ExitEpoch: params.BeaconConfig().GenesisEpoch},
+		},
+	}
+	if err := db.SaveState(context.Background(), beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	mockOperationService := &mockOperationService{}
+	validatorServer := &ValidatorServer{
+		beaconDB:         db,
+		operationService: mockOperationService,
+		p2p:              &mockBroadcaster{},
+	}
+
+	exit := &pbp2p.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 0,
+	}
+	if _, err := validatorServer.ProposeExit(context.Background(), exit); err != nil {
+		t.Fatalf("Could not propose exit: %v", err)
+	}
+	if len(mockOperationService.handledExits) != 1 {
+		t.Fatalf("Expected exit to be sent to the operations service, received %v", mockOperationService.handledExits)
+	}
+
+	// The second validator is already exiting.
+	exit = &pbp2p.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 1,
+	}
+	want := "validator exit epoch should be > entry_exit_effect_epoch"
+	if _, err := validatorServer.ProposeExit(context.Background(), exit); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if len(mockOperationService.handledExits) != 1 {
+		t.Errorf("Expected invalid exit not to be sent to the operations service")
+	}
+}
+
+func TestValidatorLiveness_AttestersAndProposers(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not get genesis state: %v", err)
+	}
+	beaconState.Slot = params.BeaconConfig().GenesisSlot + 2
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+
+	// The first member of a committee attested, its attestation is still in the pool.
+	attSlot := params.BeaconConfig().GenesisSlot + 1
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attSlot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0]
+	bitfield := make([]byte, (len(committee.Committee)+7)/8)
+	bitfield[0] = 0x80
+	if err := db.SaveAttestation(ctx, &pbp2p.Attestation{
+		Data:                &pbp2p.AttestationData{Slot: attSlot, Shard: committee.Shard},
+		AggregationBitfield: bitfield,
+	}); err != nil {
+		t.Fatalf("Could not save attestation: %v", err)
+	}
+	attester := committee.Committee[0]
+
+	// The proposer of the second slot proposed a block.
+	blockSlot := params.BeaconConfig().GenesisSlot + 2
+	if err := db.SaveBlock(&pbp2p.BeaconBlock{Slot: blockSlot}); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	proposer, err := helpers.BeaconProposerIndex(beaconState, blockSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	idle := uint64(0)
+	for idle == attester || idle == proposer {
+		idle++
+	}
+	validatorServer := &ValidatorServer{beaconDB: db}
+	resp, err := validatorServer.ValidatorLiveness(ctx, &pb.ValidatorLivenessRequest{
+		Epoch:   params.BeaconConfig().GenesisEpoch,
+		Indices: []uint64{attester, proposer, idle},
+	})
+	if err != nil {
+		t.Fatalf("Could not get validator liveness: %v", err)
+	}
+	want := []bool{true, true, false}
+	for i, status := range resp.Statuses {
+		if status.IsLive != want[i] {
+			t.Errorf("Expected liveness of validator %d to be %v, received %v", status.Index, want[i], status.IsLive)
+		}
+	}
+
+	if _, err := validatorServer.ValidatorLiveness(ctx, &pb.ValidatorLivenessRequest{
+		Epoch: params.BeaconConfig().GenesisEpoch + 1,
+	}); err == nil {
+		t.Error("Expected an error for an epoch after the current epoch")
+	}
+}
@@ -0,0 +1,228 @@
+package slasher
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// attesterHistory keeps the votes of a single validator over the history window
+// together with its min and max span arrays. The span arrays are ring buffers
+// indexed by source epoch modulo the history length:
+//
+//   minSpans[e] = min(target - e) over the votes with a source epoch greater than e.
+//   maxSpans[e] = max(target - e) over the votes with a source epoch smaller than e.
+//
+// A span of 0 means no vote has been recorded for that epoch. With these, a new
+// vote (source, target) surrounds a previous vote if target - source > minSpans[source]
+// and is surrounded by one if target - source < maxSpans[source], which only takes
+// a single lookup instead of a scan over every previous vote.
+type attesterHistory struct {
+	minSpans []uint16
+	maxSpans []uint16
+	// latestEpoch is the epoch up to which the span arrays have been cleared
+	// of entries which belong to epochs before the history window.
+	latestEpoch uint64
+	// votes maps target epochs to the attestation the validator first voted with.
+	votes map[uint64]*pb.SlashableAttestation
+}
+
+type proposal struct {
+	data      *pb.ProposalSignedData
+	signature []byte
+}
+
+// detector indexes attestations and block proposals by validator index and finds
+// the ones which conflict with each other. The index is only kept in memory and
+// starts empty on every restart. It is not safe for concurrent use.
+type detector struct {
+	historyLength uint64
+	// latestEpoch is the highest target epoch seen in an attestation.
+	latestEpoch uint64
+	attesters   map[uint64]*attesterHistory
+	// proposals maps slots to proposer indices to the first proposal seen.
+	proposals map[uint64]map[uint64]*proposal
+}
+
+func newDetector(historyLength uint64) *detector {
+	return &detector{
+		historyLength: historyLength,
+		attesters:     make(map[uint64]*attesterHistory),
+		proposals:     make(map[uint64]map[uint64]*proposal),
+	}
+}
+
+// recordAttestation indexes the vote of the validator and returns the attester
+// slashings for any double or surround vote it makes with the validator's
+// previous votes. Votes with a target older than the history window are ignored.
+func (d *detector) recordAttestation(validatorIndex uint64, att *pb.SlashableAttestation) []*pb.AttesterSlashing {
+	source := att.Data.JustifiedEpoch
+	target := helpers.SlotToEpoch(att.Data.Slot)
+	if target < source {
+		return nil
+	}
+	if target > d.latestEpoch {
+		d.latestEpoch = target
+	}
+	if !d.withinHistory(target) {
+		return nil
+	}
+	history := d.attesterHistory(validatorIndex)
+
+	if previous, ok := history.votes[target]; ok {
+		if proto.Equal(previous.Data, att.Data) {
+			return nil
+		}
+		return []*pb.AttesterSlashing{{
+			SlashableAttestation_1: previous,
+			SlashableAttestation_2: att,
+		}}
+	}
+
+	var slashings []*pb.AttesterSlashing
+	if d.withinHistory(source) {
+		distance := target - source
+		minSpan := uint64(history.minSpans[source%d.historyLength])
+		if minSpan != 0 && distance > minSpan {
+			if surrounded, ok := history.votes[source+minSpan]; ok {
+				slashings = append(slashings, &pb.AttesterSlashing{
+					SlashableAttestation_1: att,
+					SlashableAttestation_2: surrounded,
+				})
+			}
+		}
+		maxSpan := uint64(history.maxSpans[source%d.historyLength])
+		if distance < maxSpan {
+			if surrounding, ok := history.votes[source+maxSpan]; ok {
+				slashings = append(slashings, &pb.AttesterSlashing{
+					SlashableAttestation_1: surrounding,
+					SlashableAttestation_2: att,
+				})
+			}
+		}
+	}
+
+	d.updateSpans(history, source, target)
+	history.votes[target] = att
+	return slashings
+}
+
+// recordProposal indexes the proposal of the validator and returns a proposer
+// slashing if the validator already proposed a different block at the same slot.
+func (d *detector) recordProposal(proposerIndex uint64, data *pb.ProposalSignedData, signature []byte) *pb.ProposerSlashing {
+	epoch := helpers.SlotToEpoch(data.Slot)
+	if epoch > d.latestEpoch {
+		d.latestEpoch = epoch
+	}
+	d.pruneProposals()
+	if !d.withinHistory(epoch) {
+		return nil
+	}
+
+	proposers, ok := d.proposals[data.Slot]
+	if !ok {
+		proposers = make(map[uint64]*proposal)
+		d.proposals[data.Slot] = proposers
+	}
+	previous, ok := proposers[proposerIndex]
+	if !ok {
+		proposers[proposerIndex] = &proposal{data: data, signature: signature}
+		return nil
+	}
+	if proto.Equal(previous.data, data) {
+		return nil
+	}
+	return &pb.ProposerSlashing{
+		ProposerIndex:       proposerIndex,
+		ProposalData_1:      previous.data,
+		ProposalSignature_1: previous.signature,
+		ProposalData_2:      data,
+		ProposalSignature_2: signature,
+	}
+}
+
+// updateSpans lowers the min spans of the epochs before the source and raises
+// the max spans of the epochs between source and target. Both walks stop at the
+// first epoch which already holds a tighter span, as every epoch past it does too.
+// Only epochs within the history window are walked, as the source epoch is chosen
+// by the sender of the attestation and may be arbitrarily far in the past.
+func (d *detector) updateSpans(history *attesterHistory, source uint64, target uint64) {
+	for epoch := source; epoch > 0 && d.withinHistory(epoch-1); epoch-- {
+		i := (epoch - 1) % d.historyLength
+		span := target - (epoch - 1)
+		if history.minSpans[i] != 0 && uint64(history.minSpans[i]) <= span {
+			break
+		}
+		history.minSpans[i] = uint16(span)
+	}
+	start := source + 1
+	if oldest := d.oldestEpoch(); start < oldest {
+		start = oldest
+	}
+	for epoch := start; epoch < target; epoch++ {
+		i := epoch % d.historyLength
+		span := target - epoch
+		if span >= maxHistoryLength || uint64(history.maxSpans[i]) >= span {
+			break
+		}
+		history.maxSpans[i] = uint16(span)
+	}
+}
+
+// attesterHistory returns the history of the validator with every entry from
+// before the history window cleared.
+func (d *detector) attesterHistory(validatorIndex uint64) *attesterHistory {
+	history, ok := d.attesters[validatorIndex]
+	if !ok {
+		history = &attesterHistory{
+			minSpans:    make([]uint16, d.historyLength),
+			maxSpans:    make([]uint16, d.historyLength),
+			latestEpoch: d.latestEpoch,
+			votes:       make(map[uint64]*pb.SlashableAttestation),
+		}
+		d.attesters[validatorIndex] = history
+		return history
+	}
+	if history.latestEpoch == d.latestEpoch {
+		return history
+	}
+
+	if d.latestEpoch-history.latestEpoch >= d.historyLength {
+		history.minSpans = make([]uint16, d.historyLength)
+		history.maxSpans = make([]uint16, d.historyLength)
+	} else {
+		for epoch := history.latestEpoch + 1; epoch <= d.latestEpoch; epoch++ {
+			history.minSpans[epoch%d.historyLength] = 0
+			history.maxSpans[epoch%d.historyLength] = 0
+		}
+	}
+	for target := range history.votes {
+		if !d.withinHistory(target) {
+			delete(history.votes, target)
+		}
+	}
+	history.latestEpoch = d.latestEpoch
+	return history
+}
+
+func (d *detector) pruneProposals() {
+	for slot := range d.proposals {
+		if !d.withinHistory(helpers.SlotToEpoch(slot)) {
+			delete(d.proposals, slot)
+		}
+	}
+}
+
+// oldestEpoch returns the first epoch of the history window.
+func (d *detector) oldestEpoch() uint64 {
+	if d.latestEpoch < d.historyLength {
+		return 0
+	}
+	return d.latestEpoch - d.historyLength + 1
+}
+
+// withinHistory returns whether the epoch is one of the last historyLength
+// epochs up to and including the latest epoch seen.
+func (d *detector) withinHistory(epoch uint64) bool {
+	return epoch+d.historyLength > d.latestEpoch
+}
@@ -0,0 +1,141 @@
+package slasher
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func vote(source uint64, target uint64) *pb.SlashableAttestation {
+	return &pb.SlashableAttestation{
+		ValidatorIndices: []uint64{1},
+		CustodyBitfield:  []byte{0},
+		Data: &pb.AttestationData{
+			Slot:           params.BeaconConfig().GenesisSlot + target*params.BeaconConfig().SlotsPerEpoch,
+			JustifiedEpoch: params.BeaconConfig().GenesisEpoch + source,
+		},
+	}
+}
+
+func TestRecordAttestation_DoubleVote(t *testing.T) {
+	d := newDetector(16)
+	first := vote(1, 3)
+	if slashings := d.recordAttestation(1, first); len(slashings) != 0 {
+		t.Fatalf("Expected no slashings, received %v", slashings)
+	}
+	if slashings := d.recordAttestation(1, vote(1, 3)); len(slashings) != 0 {
+		t.Errorf("Expected repeated vote not to be slashable, received %v", slashings)
+	}
+
+	second := vote(2, 3)
+	slashings := d.recordAttestation(1, second)
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 slashing, received %d", len(slashings))
+	}
+	if slashings[0].SlashableAttestation_1 != first || slashings[0].SlashableAttestation_2 != second {
+		t.Errorf("Slashing does not hold the conflicting votes: %v", slashings[0])
+	}
+	if slashings := d.recordAttestation(2, second); len(slashings) != 0 {
+		t.Errorf("Expected votes of other validators to be independent, received %v", slashings)
+	}
+}
+
+func TestRecordAttestation_SurroundVotes(t *testing.T) {
+	d := newDetector(16)
+	for _, v := range []*pb.SlashableAttestation{vote(0, 1), vote(1, 2), vote(4, 5)} {
+		if slashings := d.recordAttestation(1, v); len(slashings) != 0 {
+			t.Fatalf("Expected no slashings for consecutive votes, received %v", slashings)
+		}
+	}
+	surrounded := vote(4, 5)
+
+	surrounding := vote(3, 7)
+	slashings := d.recordAttestation(1, surrounding)
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 slashing, received %d", len(slashings))
+	}
+	if slashings[0].SlashableAttestation_1 != surrounding || !proto.Equal(slashings[0].SlashableAttestation_2, surrounded) {
+		t.Errorf("Expected new vote to surround the vote for epoch 5, received %v", slashings[0])
+	}
+
+	inner := vote(5, 6)
+	slashings = d.recordAttestation(1, inner)
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 slashing, received %d", len(slashings))
+	}
+	if slashings[0].SlashableAttestation_1 != surrounding || slashings[0].SlashableAttestation_2 != inner {
+		t.Errorf("Expected new vote to be surrounded by the vote for epoch 7, received %v", slashings[0])
+	}
+}
+
+func TestRecordAttestation_IgnoresVotesBeforeHistory(t *testing.T) {
+	d := newDetector(4)
+	if slashings := d.recordAttestation(1, vote(2, 3)); len(slashings) != 0 {
+		t.Fatalf("Expected no slashings, received %v", slashings)
+	}
+	if slashings := d.recordAttestation(1, vote(9, 10)); len(slashings) != 0 {
+		t.Fatalf("Expected no slashings, received %v", slashings)
+	}
+	if slashings := d.recordAttestation(1, vote(1, 3)); len(slashings) != 0 {
+		t.Errorf("Expected vote older than the history to be ignored, received %v", slashings)
+	}
+	if _, ok := d.attesters[1].votes[params.BeaconConfig().GenesisEpoch+3]; ok {
+		t.Error("Expected vote older than the history to be pruned")
+	}
+}
+
+func TestRecordAttestation_FarPastSource(t *testing.T) {
+	d := newDetector(8)
+	if slashings := d.recordAttestation(1, vote(97, 98)); len(slashings) != 0 {
+		t.Fatalf("Expected no slashings, received %v", slashings)
+	}
+	far := vote(0, 100)
+	far.Data.JustifiedEpoch = 0
+	if slashings := d.recordAttestation(1, far); len(slashings) != 0 {
+		t.Fatalf("Expected no slashings, received %v", slashings)
+	}
+
+	// Only the epochs of the history window hold the max spans of the vote.
+	history := d.attesters[1]
+	for epoch := uint64(93); epoch < 100; epoch++ {
+		i := (params.BeaconConfig().GenesisEpoch + epoch) % d.historyLength
+		if span := uint64(history.maxSpans[i]); span != 100-epoch {
+			t.Errorf("Expected max span %d at epoch %d, received %d", 100-epoch, epoch, span)
+		}
+	}
+
+	inner := vote(98, 99)
+	slashings := d.recordAttestation(1, inner)
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 slashing, received %d", len(slashings))
+	}
+	if slashings[0].SlashableAttestation_1 != far || slashings[0].SlashableAttestation_2 != inner {
+		t.Errorf("Expected new vote to be surrounded by the far past vote, received %v", slashings[0])
+	}
+}
+
+func TestRecordProposal_DoubleProposal(t *testing.T) {
+	d := newDetector(16)
+	slot := params.BeaconConfig().GenesisSlot + 5
+	first := &pb.ProposalSignedData{Slot: slot, BlockRootHash32: []byte{1}}
+	if slashing := d.recordProposal(3, first, []byte{'a'}); slashing != nil {
+		t.Fatalf("Expected no slashing, received %v", slashing)
+	}
+	if slashing := d.recordProposal(3, &pb.ProposalSignedData{Slot: slot, BlockRootHash32: []byte{1}}, []byte{'a'}); slashing != nil {
+		t.Errorf("Expected repeated proposal not to be slashable, received %v", slashing)
+	}
+	if slashing := d.recordProposal(4, &pb.ProposalSignedData{Slot: slot, BlockRootHash32: []byte{2}}, nil); slashing != nil {
+		t.Errorf("Expected proposals of other validators to be independent, received %v", slashing)
+	}
+
+	second := &pb.ProposalSignedData{Slot: slot, BlockRootHash32: []byte{2}}
+	slashing := d.recordProposal(3, second, []byte{'b'})
+	if slashing == nil {
+		t.Fatal("Expected a proposer slashing")
+	}
+	if slashing.ProposerIndex != 3 || slashing.ProposalData_1 != first || slashing.ProposalData_2 != second {
+		t.Errorf("Slashing does not hold the conflicting proposals: %v", slashing)
+	}
+}
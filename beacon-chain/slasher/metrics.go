@@ -0,0 +1,17 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	detectedProposerSlashings = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_detected_proposer_slashings",
+		Help: "The number of double proposals detected by the slasher",
+	})
+	detectedAttesterSlashings = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_detected_attester_slashings",
+		Help: "The number of double and surround votes detected by the slasher",
+	})
+)
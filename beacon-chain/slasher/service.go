@@ -0,0 +1,265 @@
+// Package slasher defines a service which watches the attestations and blocks
+// seen by the beacon node for slashable behaviour.
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var log = logrus.WithField("prefix", "slasher")
+
+// maxHistoryLength is the longest history the span arrays can cover, as spans
+// are stored as uint16 values.
+const maxHistoryLength = 1<<16 - 1
+
+type attestationFeeder interface {
+	IncomingAttestationFeed() *event.Feed
+}
+
+type blockFeeder interface {
+	BlockAnnouncementFeed() *event.Feed
+}
+
+// Service indexes the attestations and blocks received by the beacon node by
+// validator index and submits slashings for the double proposals, double votes
+// and surround votes it detects to the operations pool.
+type Service struct {
+	ctx               context.Context
+	cancel            context.CancelFunc
+	beaconDB          db.Database
+	attsService       attestationFeeder
+	syncService       blockFeeder
+	opsService        operations.OperationFeeds
+	incomingAttChan   chan *pb.Attestation
+	incomingBlockChan chan *pb.BeaconBlock
+	detector          *detector
+	detectorLock      sync.Mutex
+}
+
+// Config options for the slasher service.
+type Config struct {
+	BeaconDB      db.Database
+	AttsService   attestationFeeder
+	SyncService   blockFeeder
+	OpsService    operations.OperationFeeds
+	HistoryEpochs uint64
+}
+
+// NewSlasherService instantiates a new slasher service which looks back at
+// most cfg.HistoryEpochs epochs for conflicting votes.
+func NewSlasherService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	historyEpochs := cfg.HistoryEpochs
+	if historyEpochs == 0 || historyEpochs > maxHistoryLength {
+		historyEpochs = maxHistoryLength
+	}
+	return &Service{
+		ctx:               ctx,
+		cancel:            cancel,
+		beaconDB:          cfg.BeaconDB,
+		attsService:       cfg.AttsService,
+		syncService:       cfg.SyncService,
+		opsService:        cfg.OpsService,
+		incomingAttChan:   make(chan *pb.Attestation, params.BeaconConfig().DefaultBufferSize),
+		incomingBlockChan: make(chan *pb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
+		detector:          newDetector(historyEpochs),
+	}
+}
+
+// Start the slasher's main event loop.
+func (s *Service) Start() {
+	log.WithField("historyEpochs", s.detector.historyLength).Info("Starting service")
+	go s.run()
+}
+
+// Stop the slasher's main event loop.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status always returns nil.
+// TODO(1201): Add service health checks.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	attSub := s.attsService.IncomingAttestationFeed().Subscribe(s.incomingAttChan)
+	defer attSub.Unsubscribe()
+	blockSub := s.syncService.BlockAnnouncementFeed().Subscribe(s.incomingBlockChan)
+	defer blockSub.Unsubscribe()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Slasher context closed, exiting goroutine")
+			return
+		case att := <-s.incomingAttChan:
+			handler.SafelyHandleMessage(s.ctx, s.handleAttestation, att)
+		case block := <-s.incomingBlockChan:
+			handler.SafelyHandleMessage(s.ctx, s.handleBlock, block)
+		}
+	}
+}
+
+// handleAttestation checks every participant of the attestation for double and
+// surround votes.
+func (s *Service) handleAttestation(ctx context.Context, msg proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.slasher.handleAttestation")
+	defer span.End()
+
+	att := msg.(*pb.Attestation)
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	return s.checkAttestation(headState, att)
+}
+
+// handleBlock checks the block's proposer for a double proposal and the
+// attestations included in the block for double and surround votes.
+func (s *Service) handleBlock(ctx context.Context, msg proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.slasher.handleBlock")
+	defer span.End()
+
+	block := msg.(*pb.BeaconBlock)
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	for _, att := range block.Body.Attestations {
+		if err := s.checkAttestation(headState, att); err != nil {
+			log.WithError(err).Debug("Could not check block attestation")
+		}
+	}
+
+	proposerIndex, err := proposerIndex(headState, block.Slot)
+	if err != nil {
+		// Blocks too far from the head to look up their proposer are skipped.
+		log.WithError(err).WithField("slot", block.Slot-params.BeaconConfig().GenesisSlot).
+			Debug("Could not determine block proposer")
+		return nil
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	data := &pb.ProposalSignedData{
+		Slot:            block.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: root[:],
+	}
+
+	s.detectorLock.Lock()
+	slashing := s.detector.recordProposal(proposerIndex, data, block.Signature)
+	s.detectorLock.Unlock()
+	if slashing == nil {
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"proposerIndex": proposerIndex,
+		"slot":          block.Slot - params.BeaconConfig().GenesisSlot,
+	}).Warn("Detected double proposal")
+	detectedProposerSlashings.Inc()
+	s.opsService.IncomingProposerSlashingFeed().Send(slashing)
+	return nil
+}
+
+// checkAttestation records the votes of the attestation's participants once the
+// attestation is verified against the head state. Unverified attestations are
+// never indexed, as a forged vote would be reported as a slashing and a vote
+// for a far future epoch would move the history window past every real vote.
+func (s *Service) checkAttestation(headState *pb.BeaconState, att *pb.Attestation) error {
+	targetEpoch := helpers.SlotToEpoch(att.Data.Slot)
+	if currentEpoch := helpers.CurrentEpoch(headState); targetEpoch > currentEpoch {
+		return fmt.Errorf("attestation target epoch %d is beyond the current epoch %d",
+			targetEpoch-params.BeaconConfig().GenesisEpoch, currentEpoch-params.BeaconConfig().GenesisEpoch)
+	}
+	if err := blocks.VerifyAttestation(headState, att, !featureconfig.FeatureConfig().DisableSignatureVerification); err != nil {
+		return fmt.Errorf("could not verify attestation: %v", err)
+	}
+	slashableAtt, err := slashableAttestation(headState, att)
+	if err != nil {
+		return err
+	}
+
+	s.detectorLock.Lock()
+	var slashings []*pb.AttesterSlashing
+	// Participants which made the same conflicting votes are covered by a single slashing.
+	seen := make(map[[2]*pb.SlashableAttestation]bool)
+	for _, idx := range slashableAtt.ValidatorIndices {
+		for _, slashing := range s.detector.recordAttestation(idx, slashableAtt) {
+			key := [2]*pb.SlashableAttestation{slashing.SlashableAttestation_1, slashing.SlashableAttestation_2}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			slashings = append(slashings, slashing)
+		}
+	}
+	s.detectorLock.Unlock()
+
+	for _, slashing := range slashings {
+		log.WithFields(logrus.Fields{
+			"sourceEpoch": slashing.SlashableAttestation_2.Data.JustifiedEpoch - params.BeaconConfig().GenesisEpoch,
+			"targetEpoch": helpers.SlotToEpoch(slashing.SlashableAttestation_2.Data.Slot) - params.BeaconConfig().GenesisEpoch,
+		}).Warn("Detected slashable attestation")
+		detectedAttesterSlashings.Inc()
+		s.opsService.IncomingAttesterSlashingFeed().Send(slashing)
+	}
+	return nil
+}
+
+// slashableAttestation converts the attestation into a slashable attestation
+// listing its participants in ascending order.
+func slashableAttestation(state *pb.BeaconState, att *pb.Attestation) (*pb.SlashableAttestation, error) {
+	indices, err := helpers.AttestationParticipants(state, att.Data, att.AggregationBitfield)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("attestation at slot %d has no participants",
+			att.Data.Slot-params.BeaconConfig().GenesisSlot)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return &pb.SlashableAttestation{
+		ValidatorIndices:   indices,
+		CustodyBitfield:    make([]byte, (len(indices)+7)/8),
+		Data:               att.Data,
+		AggregateSignature: att.AggregateSignature,
+	}, nil
+}
+
+// proposerIndex returns the proposer of the slot. A state at the last slot of an
+// epoch has already been through epoch processing, so its committees are looked
+// up as of the next slot where that epoch is the previous one.
+func proposerIndex(state *pb.BeaconState, slot uint64) (uint64, error) {
+	if epoch.CanProcessEpoch(state) {
+		nextSlotState := *state
+		nextSlotState.Slot++
+		state = &nextSlotState
+	}
+	return helpers.BeaconProposerIndex(state, slot)
+}
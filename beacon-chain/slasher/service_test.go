@@ -0,0 +1,207 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	logrus.SetLevel(logrus.DebugLevel)
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+		DisableSignatureVerification: true,
+	})
+}
+
+func setupHeadState(t *testing.T, beaconDB *db.BeaconDB) *pb.BeaconState {
+	var validators []*pb.Validator
+	for i := 0; i < 64; i++ {
+		validators = append(validators, &pb.Validator{
+			Pubkey:          []byte{byte(i)},
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			SlashedEpoch:    params.BeaconConfig().FarFutureEpoch,
+		})
+	}
+	crosslinks := make([]*pb.Crosslink, params.BeaconConfig().ShardCount)
+	for i := range crosslinks {
+		crosslinks[i] = &pb.Crosslink{
+			Epoch:                   params.BeaconConfig().GenesisEpoch,
+			CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
+		}
+	}
+	slot := params.BeaconConfig().GenesisSlot + params.BeaconConfig().MinAttestationInclusionDelay
+	beaconState := &pb.BeaconState{
+		Slot:              slot,
+		ValidatorRegistry: validators,
+		JustifiedEpoch:    params.BeaconConfig().GenesisEpoch,
+		LatestCrosslinks:  crosslinks,
+	}
+	block := &pb.BeaconBlock{
+		Slot: slot,
+	}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), block, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	return beaconState
+}
+
+func TestHandleAttestation_SubmitsDoubleVote(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB)
+
+	opsService := operations.NewOpsPoolService(ctx, &operations.Config{BeaconDB: beaconDB})
+	slashings := make(chan *pb.AttesterSlashing, 1)
+	sub := opsService.IncomingAttesterSlashingFeed().Subscribe(slashings)
+	defer sub.Unsubscribe()
+	service := NewSlasherService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		OpsService:    opsService,
+		HistoryEpochs: 16,
+	})
+
+	attestationSlot := params.BeaconConfig().GenesisSlot
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attestationSlot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every member of the committee participates.
+	committeeSize := len(committees[0].Committee)
+	aggregationBitfield := make([]byte, (committeeSize+7)/8)
+	for i := 0; i < committeeSize; i++ {
+		aggregationBitfield[i/8] |= 1 << (7 - uint(i%8))
+	}
+	attestation := func(blockRoot byte) *pb.Attestation {
+		return &pb.Attestation{
+			AggregationBitfield: aggregationBitfield,
+			CustodyBitfield:     make([]byte, (committeeSize+7)/8),
+			Data: &pb.AttestationData{
+				Slot:                    attestationSlot,
+				Shard:                   committees[0].Shard,
+				JustifiedEpoch:          params.BeaconConfig().GenesisEpoch,
+				BeaconBlockRootHash32:   []byte{blockRoot},
+				CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:],
+			},
+		}
+	}
+
+	if err := service.handleAttestation(ctx, attestation(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.handleAttestation(ctx, attestation(1)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case slashing := <-slashings:
+		t.Fatalf("Expected no slashing for a repeated attestation, received %v", slashing)
+	default:
+	}
+
+	if err := service.handleAttestation(ctx, attestation(2)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case slashing := <-slashings:
+		indices := slashing.SlashableAttestation_2.ValidatorIndices
+		if len(indices) != committeeSize {
+			t.Errorf("Expected every committee member in the slashing, received %v", indices)
+		}
+		for i := 1; i < len(indices); i++ {
+			if indices[i-1] >= indices[i] {
+				t.Errorf("Expected validator indices in ascending order, received %v", indices)
+			}
+		}
+	default:
+		t.Fatal("Expected an attester slashing for the double vote")
+	}
+	select {
+	case slashing := <-slashings:
+		t.Errorf("Expected a single slashing for the committee, received another %v", slashing)
+	default:
+	}
+}
+
+func TestHandleAttestation_RejectsFutureTarget(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB)
+
+	opsService := operations.NewOpsPoolService(ctx, &operations.Config{BeaconDB: beaconDB})
+	service := NewSlasherService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		OpsService:    opsService,
+		HistoryEpochs: 16,
+	})
+
+	attestation := &pb.Attestation{
+		AggregationBitfield: []byte{0xFF},
+		Data: &pb.AttestationData{
+			Slot:           beaconState.Slot + 100*params.BeaconConfig().SlotsPerEpoch,
+			JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+		},
+	}
+	if err := service.handleAttestation(ctx, attestation); err == nil {
+		t.Fatal("Expected an attestation for a future epoch to be rejected")
+	}
+	if service.detector.latestEpoch != 0 || len(service.detector.attesters) != 0 {
+		t.Error("Expected the rejected attestation not to be recorded")
+	}
+}
+
+func TestHandleBlock_SubmitsDoubleProposal(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	beaconState := setupHeadState(t, beaconDB)
+
+	opsService := operations.NewOpsPoolService(ctx, &operations.Config{BeaconDB: beaconDB})
+	slashings := make(chan *pb.ProposerSlashing, 1)
+	sub := opsService.IncomingProposerSlashingFeed().Subscribe(slashings)
+	defer sub.Unsubscribe()
+	service := NewSlasherService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		OpsService:    opsService,
+		HistoryEpochs: 16,
+	})
+
+	block := func(parentRoot byte) *pb.BeaconBlock {
+		return &pb.BeaconBlock{
+			Slot:             beaconState.Slot,
+			ParentRootHash32: []byte{parentRoot},
+			Body:             &pb.BeaconBlockBody{},
+		}
+	}
+	if err := service.handleBlock(ctx, block(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.handleBlock(ctx, block(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	proposerIndex, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case slashing := <-slashings:
+		if slashing.ProposerIndex != proposerIndex {
+			t.Errorf("Expected proposer %d to be slashed, received %d", proposerIndex, slashing.ProposerIndex)
+		}
+	default:
+		t.Fatal("Expected a proposer slashing for the double proposal")
+	}
+}
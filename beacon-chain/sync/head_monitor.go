@@ -0,0 +1,107 @@
+package sync
+
+import (
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultFallBehindEpochs is how many epochs the node may lag behind its best peer
+// before it stops regular sync and catches up through initial sync again.
+const defaultFallBehindEpochs = 2
+
+// fallBehindPeers is how many peers need to be ahead of the node before it re-enters
+// initial sync, so a single peer advertising a false head cannot pause regular sync.
+const fallBehindPeers = 3
+
+// monitorHead keeps polling peers for their chain heads after the node has synced.
+// Regular sync only fetches missing ancestors one block at a time, so once the best
+// peers are more than fallBehindSlots ahead of the local head, regular sync is paused
+// and batched initial sync takes over until the node caught up again.
+func (ss *Service) monitorHead() {
+	responseBuf := make(chan p2p.Message, params.BeaconConfig().DefaultBufferSize)
+	responseSub := ss.p2p.Subscribe(&pb.ChainHeadResponse{}, responseBuf)
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SyncPollingInterval) * time.Second)
+	defer func() {
+		responseSub.Unsubscribe()
+		ticker.Stop()
+	}()
+
+	chainHeadResponses := make(map[peer.ID]*pb.ChainHeadResponse)
+	for {
+		select {
+		case <-ss.ctx.Done():
+			slog.Debug("Exiting head monitor")
+			return
+		case msg := <-responseBuf:
+			chainHeadResponses[msg.Peer] = msg.Data.(*pb.ChainHeadResponse)
+		case <-ticker.C:
+			if !ss.isInitialSyncing() && ss.fellBehind(chainHeadResponses) {
+				ss.reenterInitialSync(chainHeadResponses)
+			}
+			chainHeadResponses = make(map[peer.ID]*pb.ChainHeadResponse)
+			ss.p2p.Broadcast(ss.ctx, &pb.ChainHeadRequest{})
+		}
+	}
+}
+
+// fellBehind reports whether at least fallBehindPeers peers have a canonical head more
+// than fallBehindSlots ahead of the local chain head.
+func (ss *Service) fellBehind(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) bool {
+	head, err := ss.db.ChainHead()
+	if err != nil {
+		slog.WithError(err).Error("Could not get chain head")
+		return false
+	}
+	if head == nil {
+		return false
+	}
+	ahead := 0
+	for _, response := range chainHeadResponses {
+		if response.CanonicalSlot > head.Slot+ss.fallBehindSlots {
+			ahead++
+		}
+	}
+	return ahead >= fallBehindPeers
+}
+
+// reenterInitialSync pauses regular sync and starts a new initial sync from the local
+// chain head. Initial sync resumes regular sync once it reaches the best peer's head or
+// gives up, after which the head monitor may start another one.
+func (ss *Service) reenterInitialSync(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) {
+	var bestSlot uint64
+	for _, response := range chainHeadResponses {
+		if response.CanonicalSlot > bestSlot {
+			bestSlot = response.CanonicalSlot
+		}
+	}
+	slog.WithFields(logrus.Fields{
+		"peers":    len(chainHeadResponses),
+		"bestSlot": bestSlot - params.BeaconConfig().GenesisSlot,
+	}).Warn("Node fell behind the network, re-entering initial sync")
+
+	ss.RegularSync.Pause()
+	is := initialsync.NewInitialSyncService(ss.ctx, ss.initialSyncCfg)
+	ss.initialSyncLock.Lock()
+	ss.InitialSync = is
+	ss.initialSyncing = true
+	ss.initialSyncLock.Unlock()
+	is.StartFromHead(chainHeadResponses)
+	go ss.awaitInitialSync(is)
+}
+
+// awaitInitialSync clears the initial syncing flag once the given initial sync exits,
+// whether it reached the chain head or not.
+func (ss *Service) awaitInitialSync(is *initialsync.InitialSync) {
+	<-is.Done()
+	ss.initialSyncLock.Lock()
+	defer ss.initialSyncLock.Unlock()
+	if ss.InitialSync == is {
+		ss.initialSyncing = false
+	}
+}
@@ -0,0 +1,62 @@
+package sync
+
+import (
+	"fmt"
+	"testing"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestFellBehind(t *testing.T) {
+	ss, db := setupTestSyncService(t, true)
+	defer internal.TeardownDB(t, db)
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainHeadResponses := make(map[peer.ID]*pb.ChainHeadResponse)
+	if ss.fellBehind(chainHeadResponses) {
+		t.Error("Expected the node not to be behind without any peers")
+	}
+
+	chainHeadResponses[peer.ID("close")] = &pb.ChainHeadResponse{
+		CanonicalSlot: head.Slot + ss.fallBehindSlots,
+	}
+	if ss.fellBehind(chainHeadResponses) {
+		t.Error("Expected the node not to be behind a peer within the threshold")
+	}
+
+	chainHeadResponses[peer.ID("ahead")] = &pb.ChainHeadResponse{
+		CanonicalSlot: head.Slot + ss.fallBehindSlots + 1,
+	}
+	if ss.fellBehind(chainHeadResponses) {
+		t.Error("Expected a single peer past the threshold not to put the node behind")
+	}
+
+	for i := 1; i < fallBehindPeers; i++ {
+		chainHeadResponses[peer.ID(fmt.Sprintf("ahead%d", i))] = &pb.ChainHeadResponse{
+			CanonicalSlot: head.Slot + ss.fallBehindSlots + 1,
+		}
+	}
+	if !ss.fellBehind(chainHeadResponses) {
+		t.Error("Expected the node to be behind enough peers past the threshold")
+	}
+}
+
+func TestAwaitInitialSync_ClearsInitialSyncing(t *testing.T) {
+	ss, db := setupTestSyncService(t, true)
+	defer internal.TeardownDB(t, db)
+	defer ss.RegularSync.Stop()
+
+	ss.initialSyncing = true
+	// Without any peers initial sync exits right away without reaching a chain head.
+	ss.InitialSync.Start(make(map[peer.ID]*pb.ChainHeadResponse))
+	ss.awaitInitialSync(ss.InitialSync)
+
+	if ss.isInitialSyncing() {
+		t.Error("Expected the node not to be initial syncing after initial sync exited")
+	}
+}
@@ -27,4 +27,12 @@ var (
 		Name: "initsync_received_state",
 		Help: "The number of received state",
 	})
+	syncedSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "initsync_synced_slot",
+		Help: "The last slot processed by range sync",
+	})
+	retriedBatches = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initsync_retried_batches",
+		Help: "The number of block batches retried on another peer",
+	})
 )
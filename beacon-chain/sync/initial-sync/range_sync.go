@@ -0,0 +1,296 @@
+package initialsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// batchRequestTimeout is how long a peer has to respond to a batch request
+// before the batch is handed to another peer.
+const batchRequestTimeout = 10 * time.Second
+
+// errEmptyBatch is returned for a batch without blocks, which a peer could send to
+// withhold the blocks of the requested slots.
+var errEmptyBatch = errors.New("received no blocks for the requested slots")
+
+// blockBatch is a contiguous range of slots which is requested from a single peer
+// at a time.
+type blockBatch struct {
+	startSlot uint64
+	endSlot   uint64
+	peer      peer.ID
+	deadline  time.Time
+	received  bool
+	blocks    []*pb.BeaconBlock
+	failed    map[peer.ID]bool
+	// emptyFrom is the peer which returned no blocks for the batch, pending
+	// confirmation by another peer.
+	emptyFrom peer.ID
+	// prevRetried is set once the previous batch was retried because this batch
+	// did not link to it.
+	prevRetried bool
+}
+
+// splitIntoBatches splits the slots between startSlot and endSlot inclusive into
+// batches of at most batchSize slots.
+func splitIntoBatches(startSlot uint64, endSlot uint64, batchSize uint64) []*blockBatch {
+	var batches []*blockBatch
+	for start := startSlot; start <= endSlot; start += batchSize {
+		end := start + batchSize - 1
+		if end > endSlot {
+			end = endSlot
+		}
+		batches = append(batches, &blockBatch{
+			startSlot: start,
+			endSlot:   end,
+			failed:    make(map[peer.ID]bool),
+		})
+	}
+	return batches
+}
+
+// rangeSync requests the blocks from the local head up to the given chain head in
+// fixed-size batches, spreading them concurrently over all peers which are far enough
+// ahead. A batch which times out, is invalid or fails processing is retried on another
+// peer. An empty batch is only accepted once a second peer confirms it, and a batch which
+// does not link to the previous one causes the previous batch to be retried on another
+// peer. Received batches are processed strictly in slot order.
+func (s *InitialSync) rangeSync(
+	ctx context.Context,
+	chainHead *pb.ChainHeadResponse,
+	peers []peer.ID,
+	chainHeadResponses map[peer.ID]*pb.ChainHeadResponse,
+) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.rangeSync")
+	defer span.End()
+
+	head, err := s.db.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not get chain head: %v", err)
+	}
+	if head.Slot >= chainHead.CanonicalSlot {
+		log.WithField("headSlot", head.Slot-params.BeaconConfig().GenesisSlot).Info("Already at the head of the best peer")
+		s.syncService.ResumeSync()
+		s.cancel()
+		s.nodeIsSynced = true
+		return nil
+	}
+
+	batches := splitIntoBatches(head.Slot+1, chainHead.CanonicalSlot, s.blockBatchSize)
+	pending := make([]*blockBatch, len(batches))
+	copy(pending, batches)
+	inFlight := make(map[peer.ID]*blockBatch)
+	next := 0
+	startTime := time.Now()
+
+	log.WithFields(logrus.Fields{
+		"startSlot":  head.Slot + 1 - params.BeaconConfig().GenesisSlot,
+		"targetSlot": chainHead.CanonicalSlot - params.BeaconConfig().GenesisSlot,
+		"batches":    len(batches),
+		"peers":      len(peers),
+	}).Info("Starting range sync")
+
+	requeue := func(b *blockBatch) {
+		b.failed[b.peer] = true
+		b.received = false
+		b.blocks = nil
+		retriedBatches.Inc()
+		pending = append(pending, b)
+		sort.Slice(pending, func(i, j int) bool {
+			return pending[i].startSlot < pending[j].startSlot
+		})
+	}
+	retry := func(b *blockBatch, penalty p2p.Behaviour) {
+		s.p2p.Reputation(b.peer, penalty)
+		requeue(b)
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for next < len(batches) {
+		// Hand out pending batches to every idle peer which can serve them.
+		var unassigned []*blockBatch
+		for _, b := range pending {
+			p, ok := pickPeer(b, peers, chainHeadResponses, inFlight)
+			if !ok {
+				unassigned = append(unassigned, b)
+				continue
+			}
+			if err := s.requestBlockRange(ctx, b.startSlot, b.endSlot, p); err != nil {
+				log.WithError(err).WithField("peer", p.Pretty()).Debug("Could not send batch request")
+				b.failed[p] = true
+				unassigned = append(unassigned, b)
+				continue
+			}
+			b.peer = p
+			b.deadline = time.Now().Add(batchRequestTimeout)
+			inFlight[p] = b
+		}
+		pending = unassigned
+		if len(inFlight) == 0 && len(pending) > 0 {
+			return fmt.Errorf(
+				"no peer could serve blocks for slots %d to %d",
+				pending[0].startSlot-params.BeaconConfig().GenesisSlot,
+				pending[0].endSlot-params.BeaconConfig().GenesisSlot,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg := <-s.batchedBlockBuf:
+			b, ok := inFlight[msg.Peer]
+			if !ok {
+				continue
+			}
+			delete(inFlight, msg.Peer)
+			blocks := msg.Data.(*pb.BatchedBeaconBlockResponse).BatchedBlocks
+			err := checkBatchRange(b, blocks)
+			if err == errEmptyBatch {
+				if b.emptyFrom == "" {
+					b.failed[msg.Peer] = true
+					if _, ok := pickPeer(b, peers, chainHeadResponses, nil); ok {
+						log.WithField("peer", msg.Peer.Pretty()).Debug("Received empty batch, cross-checking with another peer")
+						b.emptyFrom = msg.Peer
+						requeue(b)
+						continue
+					}
+				}
+				// A second peer confirmed the slots are empty, or no other peer can serve them.
+				err = nil
+			}
+			if err != nil {
+				log.WithError(err).WithField("peer", msg.Peer.Pretty()).Warn("Received invalid batch, retrying on another peer")
+				retry(b, p2p.RepPenalityInitialSyncFailure)
+				continue
+			}
+			if len(blocks) > 0 && b.emptyFrom != "" {
+				log.WithField("peer", b.emptyFrom.Pretty()).Warn("Peer withheld the blocks of a batch")
+				s.p2p.Reputation(b.emptyFrom, p2p.RepPenalityInitialSyncFailure)
+			}
+			b.emptyFrom = ""
+			b.blocks = blocks
+			b.received = true
+
+			for next < len(batches) && batches[next].received {
+				ready := batches[next]
+				if next > 0 && !ready.prevRetried && !s.linksToChain(ready.blocks) {
+					// The peer of the previous batch may have withheld its last blocks, so the
+					// previous batch is retried once on another peer before this batch is blamed.
+					ready.prevRetried = true
+					prev := batches[next-1]
+					log.WithField("peer", prev.peer.Pretty()).Warn("Batch does not link to the previous batch, retrying the previous batch on another peer")
+					next--
+					requeue(prev)
+					break
+				}
+				if err := s.processBatchedBlocks(ctx, ready.blocks, chainHead); err != nil {
+					log.WithError(err).WithField("peer", ready.peer.Pretty()).Warn("Failed to process batch, retrying on another peer")
+					retry(ready, p2p.RepPenalityInitialSyncFailure)
+					break
+				}
+				s.p2p.Reputation(ready.peer, p2p.RepRewardUsefulResponse)
+				next++
+				logProgress(ready.endSlot, chainHead.CanonicalSlot, head.Slot, startTime)
+			}
+		case <-ticker.C:
+			now := time.Now()
+			for p, b := range inFlight {
+				if now.After(b.deadline) {
+					log.WithField("peer", p.Pretty()).Debug("Batch request timed out, retrying on another peer")
+					delete(inFlight, p)
+					retry(b, p2p.RepPenalityRequestTimeout)
+				}
+			}
+		}
+	}
+
+	if !s.nodeIsSynced {
+		return errors.New("node still not in sync after processing all batches")
+	}
+	return nil
+}
+
+// pickPeer returns an idle peer that has not failed the batch before and whose
+// canonical slot reaches the start of the batch.
+func pickPeer(
+	b *blockBatch,
+	peers []peer.ID,
+	chainHeadResponses map[peer.ID]*pb.ChainHeadResponse,
+	inFlight map[peer.ID]*blockBatch,
+) (peer.ID, bool) {
+	for _, p := range peers {
+		if _, busy := inFlight[p]; busy || b.failed[p] {
+			continue
+		}
+		if chainHeadResponses[p].CanonicalSlot < b.startSlot {
+			continue
+		}
+		return p, true
+	}
+	return "", false
+}
+
+// linksToChain reports whether the lowest block of a batch builds on a block the node
+// already has.
+func (s *InitialSync) linksToChain(blocks []*pb.BeaconBlock) bool {
+	if len(blocks) == 0 {
+		return true
+	}
+	lowest := blocks[0]
+	for _, block := range blocks[1:] {
+		if block.Slot < lowest.Slot {
+			lowest = block
+		}
+	}
+	return s.db.HasBlock(bytesutil.ToBytes32(lowest.ParentRootHash32))
+}
+
+// checkBatchRange verifies a batch is not empty and every received block falls within
+// the requested slots.
+func checkBatchRange(b *blockBatch, blocks []*pb.BeaconBlock) error {
+	if len(blocks) == 0 {
+		return errEmptyBatch
+	}
+	for _, block := range blocks {
+		if block == nil {
+			return errors.New("received nil block")
+		}
+		if block.Slot < b.startSlot || block.Slot > b.endSlot {
+			return fmt.Errorf(
+				"block slot %d is outside of requested range %d to %d",
+				block.Slot-params.BeaconConfig().GenesisSlot,
+				b.startSlot-params.BeaconConfig().GenesisSlot,
+				b.endSlot-params.BeaconConfig().GenesisSlot,
+			)
+		}
+	}
+	return nil
+}
+
+func logProgress(slot uint64, targetSlot uint64, startSlot uint64, startTime time.Time) {
+	syncedSlot.Set(float64(slot - params.BeaconConfig().GenesisSlot))
+	done := slot - startSlot
+	total := targetSlot - startSlot
+	fields := logrus.Fields{
+		"slot":       slot - params.BeaconConfig().GenesisSlot,
+		"targetSlot": targetSlot - params.BeaconConfig().GenesisSlot,
+		"progress":   fmt.Sprintf("%.2f%%", float64(done)*100/float64(total)),
+	}
+	if elapsed := time.Since(startTime).Seconds(); elapsed > 0 {
+		fields["slotsPerSecond"] = fmt.Sprintf("%.2f", float64(done)/elapsed)
+	}
+	log.WithFields(fields).Info("Syncing")
+}
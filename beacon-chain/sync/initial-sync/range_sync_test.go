@@ -0,0 +1,105 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSplitIntoBatches(t *testing.T) {
+	batches := splitIntoBatches(10, 34, 10)
+	want := [][2]uint64{{10, 19}, {20, 29}, {30, 34}}
+	if len(batches) != len(want) {
+		t.Fatalf("Wanted %d batches, received %d", len(want), len(batches))
+	}
+	for i, b := range batches {
+		if b.startSlot != want[i][0] || b.endSlot != want[i][1] {
+			t.Errorf("Wanted batch %d to span %v, received [%d %d]", i, want[i], b.startSlot, b.endSlot)
+		}
+	}
+
+	if batches := splitIntoBatches(5, 5, 10); len(batches) != 1 || batches[0].endSlot != 5 {
+		t.Errorf("Wanted a single batch for a single slot, received %d batches", len(batches))
+	}
+}
+
+func TestPickPeer_SkipsBusyFailedAndBehindPeers(t *testing.T) {
+	busy, failed, behind, good := peer.ID("busy"), peer.ID("failed"), peer.ID("behind"), peer.ID("good")
+	peers := []peer.ID{busy, failed, behind, good}
+	chainHeads := map[peer.ID]*pb.ChainHeadResponse{
+		busy:   {CanonicalSlot: 100},
+		failed: {CanonicalSlot: 100},
+		behind: {CanonicalSlot: 15},
+		good:   {CanonicalSlot: 100},
+	}
+	b := splitIntoBatches(20, 29, 10)[0]
+	b.failed[failed] = true
+	inFlight := map[peer.ID]*blockBatch{busy: {}}
+
+	p, ok := pickPeer(b, peers, chainHeads, inFlight)
+	if !ok || p != good {
+		t.Errorf("Wanted peer %s, received %s", good, p)
+	}
+
+	inFlight[good] = b
+	if _, ok := pickPeer(b, peers, chainHeads, inFlight); ok {
+		t.Error("Expected no peer to be available")
+	}
+}
+
+func TestCheckBatchRange(t *testing.T) {
+	b := splitIntoBatches(20, 29, 10)[0]
+	if err := checkBatchRange(b, []*pb.BeaconBlock{{Slot: 20}, {Slot: 29}}); err != nil {
+		t.Errorf("Expected blocks within the batch to be accepted: %v", err)
+	}
+	if err := checkBatchRange(b, nil); err != errEmptyBatch {
+		t.Errorf("Expected an empty batch to be suspect, received %v", err)
+	}
+	if err := checkBatchRange(b, []*pb.BeaconBlock{{Slot: 20}, {Slot: 30}}); err == nil {
+		t.Error("Expected a block outside of the batch to be rejected")
+	}
+	if err := checkBatchRange(b, []*pb.BeaconBlock{nil}); err == nil {
+		t.Error("Expected a nil block to be rejected")
+	}
+}
+
+func TestLinksToChain(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	setUpGenesisStateAndBlock(db, t)
+	ss := NewInitialSyncService(context.Background(), &Config{
+		P2P:      &mockP2P{},
+		BeaconDB: db,
+	})
+
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("Could not get chain head: %v", err)
+	}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatalf("Could not hash genesis block: %v", err)
+	}
+
+	linked := []*pb.BeaconBlock{
+		{Slot: params.BeaconConfig().GenesisSlot + 2, ParentRootHash32: []byte("unknown")},
+		{Slot: params.BeaconConfig().GenesisSlot + 1, ParentRootHash32: genesisRoot[:]},
+	}
+	if !ss.linksToChain(linked) {
+		t.Error("Expected a batch building on the genesis block to link to the chain")
+	}
+	unlinked := []*pb.BeaconBlock{
+		{Slot: params.BeaconConfig().GenesisSlot + 1, ParentRootHash32: []byte("unknown")},
+	}
+	if ss.linksToChain(unlinked) {
+		t.Error("Expected a batch building on an unknown block not to link to the chain")
+	}
+	if !ss.linksToChain(nil) {
+		t.Error("Expected an empty batch to link to the chain")
+	}
+}
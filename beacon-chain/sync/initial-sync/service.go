@@ -11,6 +11,7 @@
 package initialsync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -34,38 +35,50 @@ import (
 
 var log = logrus.WithField("prefix", "initial-sync")
 
+// stateRequestTimeout is how long to wait for a peer to respond with the finalized state.
+const stateRequestTimeout = 20 * time.Second
+
 var (
 	// ErrCanonicalStateMismatch can occur when the node has processed all blocks
 	// from a peer, but arrived at a different state root.
 	ErrCanonicalStateMismatch = errors.New("canonical state did not match after syncing with peer")
+	// ErrCheckpointMismatch occurs when a peer serves a finalized state which is not
+	// the trusted checkpoint the node was started with.
+	ErrCheckpointMismatch = errors.New("finalized state does not match the trusted checkpoint")
 )
 
 // Config defines the configurable properties of InitialSync.
 //
 type Config struct {
 	SyncPollingInterval    time.Duration
+	BlockBatchSize         uint64
 	BatchedBlockBufferSize int
 	StateBufferSize        int
-	BeaconDB               *db.BeaconDB
+	BeaconDB               db.Database
 	P2P                    p2pAPI
 	SyncService            syncService
 	ChainService           chainService
 	PowChain               powChainService
+	CheckpointRoot         []byte
+	CheckpointState        *pb.BeaconState
 }
 
 // DefaultConfig provides the default configuration for a sync service.
 // SyncPollingInterval determines how frequently the service checks that initial sync is complete.
+// BlockBatchSize determines how many slots of blocks are requested from a peer at once.
 // BlockBufferSize determines that buffer size of the `blockBuf` channel.
 // StateBufferSize determines the buffer size of the `stateBuf` channel.
 func DefaultConfig() *Config {
 	return &Config{
 		SyncPollingInterval:    time.Duration(params.BeaconConfig().SyncPollingInterval) * time.Second,
+		BlockBatchSize:         params.BeaconConfig().BatchBlockLimit,
 		BatchedBlockBufferSize: params.BeaconConfig().DefaultBufferSize,
 		StateBufferSize:        params.BeaconConfig().DefaultBufferSize,
 	}
 }
 
 type p2pAPI interface {
+	p2p.Broadcaster
 	p2p.Sender
 	p2p.ReputationManager
 	p2p.Subscriber
@@ -95,15 +108,19 @@ type InitialSync struct {
 	p2p                 p2pAPI
 	syncService         syncService
 	chainService        chainService
-	db                  *db.BeaconDB
+	db                  db.Database
 	powchain            powChainService
 	batchedBlockBuf     chan p2p.Message
 	stateBuf            chan p2p.Message
 	syncPollingInterval time.Duration
+	blockBatchSize      uint64
 	syncedFeed          *event.Feed
 	stateReceived       bool
 	mutex               *sync.Mutex
 	nodeIsSynced        bool
+	checkpointRoot      []byte
+	checkpointState     *pb.BeaconState
+	done                chan struct{}
 }
 
 // NewInitialSyncService constructs a new InitialSyncService.
@@ -115,6 +132,10 @@ func NewInitialSyncService(ctx context.Context,
 
 	stateBuf := make(chan p2p.Message, cfg.StateBufferSize)
 	batchedBlockBuf := make(chan p2p.Message, cfg.BatchedBlockBufferSize)
+	blockBatchSize := cfg.BlockBatchSize
+	if blockBatchSize == 0 {
+		blockBatchSize = params.BeaconConfig().BatchBlockLimit
+	}
 
 	return &InitialSync{
 		ctx:                 ctx,
@@ -127,9 +148,13 @@ func NewInitialSyncService(ctx context.Context,
 		stateBuf:            stateBuf,
 		batchedBlockBuf:     batchedBlockBuf,
 		syncPollingInterval: cfg.SyncPollingInterval,
+		blockBatchSize:      blockBatchSize,
 		syncedFeed:          new(event.Feed),
 		stateReceived:       false,
 		mutex:               new(sync.Mutex),
+		checkpointRoot:      cfg.CheckpointRoot,
+		checkpointState:     cfg.CheckpointState,
+		done:                make(chan struct{}),
 	}
 }
 
@@ -138,6 +163,13 @@ func (s *InitialSync) Start(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse
 	go s.run(chainHeadResponses)
 }
 
+// StartFromHead begins the goroutine from the node's current chain head rather than
+// a finalized state from peers, for a node which fell behind after it was synced.
+func (s *InitialSync) StartFromHead(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) {
+	s.stateReceived = true
+	go s.run(chainHeadResponses)
+}
+
 // Stop kills the initial sync goroutine.
 func (s *InitialSync) Stop() error {
 	log.Info("Stopping service")
@@ -145,6 +177,11 @@ func (s *InitialSync) Stop() error {
 	return nil
 }
 
+// Done returns a channel which is closed once the initial sync goroutine exits.
+func (s *InitialSync) Done() <-chan struct{} {
+	return s.done
+}
+
 // NodeIsSynced checks that the node has been caught up with the network.
 func (s *InitialSync) NodeIsSynced() bool {
 	return s.nodeIsSynced
@@ -220,7 +257,6 @@ func (s *InitialSync) exitInitialSync(ctx context.Context, block *pb.BeaconBlock
 }
 
 // run is the main goroutine for the initial sync service.
-// delayChan is explicitly passed into this function to facilitate tests that don't require a timeout.
 // It is assumed that the goroutine `run` is only called once per instance.
 func (s *InitialSync) run(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) {
 	batchedBlocksub := s.p2p.Subscribe(&pb.BatchedBeaconBlockResponse{}, s.batchedBlockBuf)
@@ -230,74 +266,199 @@ func (s *InitialSync) run(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse)
 		batchedBlocksub.Unsubscribe()
 		close(s.batchedBlockBuf)
 		close(s.stateBuf)
+		// Regular sync is paused while initial sync runs, so it is resumed when initial
+		// sync gives up rather than leaving the node without any sync.
+		if !s.nodeIsSynced && s.ctx.Err() == nil {
+			log.Warn("Initial sync did not reach the chain head, resuming regular sync")
+			s.syncService.ResumeSync()
+		}
+		close(s.done)
 	}()
 
 	ctx := s.ctx
+	for {
+		peers := s.syncPeers(chainHeadResponses)
+		if len(peers) == 0 {
+			log.Error("No peers to sync with")
+			return
+		}
+		// The peer with the highest canonical slot determines the head the node syncs to.
+		chainHead := chainHeadResponses[peers[0]]
+		err := s.syncToChainHead(ctx, chainHead, peers, chainHeadResponses)
+		if err == nil {
+			log.Info("Synced!")
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).WithField("retryIn", s.syncPollingInterval).Error("Failed to sync, retrying")
+		// Peers may have disconnected or moved on since the last attempt, so their chain
+		// heads are requested again before retrying.
+		chainHeadResponses = s.requestChainHeads(ctx)
+	}
+}
 
+// syncPeers returns the peers which agree with the trusted checkpoint, sorted in
+// descending order based on their canonical slot.
+func (s *InitialSync) syncPeers(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) []peer.ID {
 	var peers []peer.ID
 	for k := range chainHeadResponses {
 		peers = append(peers, k)
 	}
-
-	// Sort peers in descending order based on their canonical slot.
+	peers = s.rejectCheckpointMismatches(peers, chainHeadResponses)
 	sort.Slice(peers, func(i, j int) bool {
 		return chainHeadResponses[peers[i]].CanonicalSlot > chainHeadResponses[peers[j]].CanonicalSlot
 	})
+	return peers
+}
 
-	for _, peer := range peers {
-		chainHead := chainHeadResponses[peer]
-		if err := s.syncToPeer(ctx, chainHead, peer); err != nil {
-			log.WithError(err).WithField("peer", peer.Pretty()).Warn("Failed to sync with peer, trying next best peer")
-			continue
+// requestChainHeads asks all peers for their chain heads and collects the responses
+// received within the sync polling interval.
+func (s *InitialSync) requestChainHeads(ctx context.Context) map[peer.ID]*pb.ChainHeadResponse {
+	responseBuf := make(chan p2p.Message, params.BeaconConfig().DefaultBufferSize)
+	responseSub := s.p2p.Subscribe(&pb.ChainHeadResponse{}, responseBuf)
+	defer responseSub.Unsubscribe()
+	s.p2p.Broadcast(ctx, &pb.ChainHeadRequest{})
+
+	chainHeadResponses := make(map[peer.ID]*pb.ChainHeadResponse)
+	timeout := time.After(s.syncPollingInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			return chainHeadResponses
+		case <-timeout:
+			return chainHeadResponses
+		case msg := <-responseBuf:
+			chainHeadResponses[msg.Peer] = msg.Data.(*pb.ChainHeadResponse)
+		}
+	}
+}
+
+// syncToChainHead fetches the last finalized state, unless it was already received, and
+// then range syncs the blocks up to the given chain head from all peers.
+func (s *InitialSync) syncToChainHead(
+	ctx context.Context,
+	chainHead *pb.ChainHeadResponse,
+	peers []peer.ID,
+	chainHeadResponses map[peer.ID]*pb.ChainHeadResponse,
+) error {
+	if !s.stateReceived && s.checkpointState != nil {
+		head, err := s.db.ChainHead()
+		if err != nil {
+			return fmt.Errorf("could not get chain head: %v", err)
+		}
+		// A node which already synced past the checkpoint keeps its chain.
+		if head != nil && head.Slot >= s.checkpointState.Slot {
+			s.stateReceived = true
+		}
+	}
+	if !s.stateReceived && s.checkpointState != nil {
+		log.WithField("checkpointRoot", fmt.Sprintf("%#x", bytesutil.Trunc(s.checkpointRoot))).Info("Starting from trusted checkpoint state")
+		if err := s.saveFinalizedState(ctx, s.checkpointState); err != nil {
+			return fmt.Errorf("could not save the checkpoint state: %v", err)
 		}
-		log.Info("Synced!")
-		break
 	}
+	if !s.stateReceived {
+		if err := s.syncFinalizedState(ctx, chainHead, peers, chainHeadResponses); err != nil {
+			return err
+		}
+	}
+	return s.rangeSync(ctx, chainHead, peers, chainHeadResponses)
+}
 
-	if !s.nodeIsSynced {
-		log.Fatal("Failed to sync with anyone...")
+// syncFinalizedState fetches the finalized state of the chain head, which the node syncs from
+// when it was not started from a trusted checkpoint state.
+func (s *InitialSync) syncFinalizedState(
+	ctx context.Context,
+	chainHead *pb.ChainHeadResponse,
+	peers []peer.ID,
+	chainHeadResponses map[peer.ID]*pb.ChainHeadResponse,
+) error {
+	stateRoot := chainHead.FinalizedStateRootHash32S
+	for _, peer := range peers {
+		peerHead := chainHeadResponses[peer]
+		if !bytes.Equal(peerHead.FinalizedStateRootHash32S, stateRoot) {
+			continue
+		}
+		if err := s.syncStateFromPeer(ctx, stateRoot, peerHead, peer); err != nil {
+			log.WithError(err).WithField("peer", peer.Pretty()).Warn("Failed to get state from peer, trying next best peer")
+			continue
+		}
+		return nil
 	}
+	return errors.New("could not get the finalized state from any peer")
 }
 
-func (s *InitialSync) syncToPeer(ctx context.Context, chainHeadResponse *pb.ChainHeadResponse, peer peer.ID) error {
+// syncStateFromPeer requests the finalized state with the given root from a peer and
+// saves it once it is verified. Only peers which advertise the state as finalized are
+// penalized for not responding.
+func (s *InitialSync) syncStateFromPeer(ctx context.Context, stateRoot []byte, peerHead *pb.ChainHeadResponse, peer peer.ID) error {
 	fields := logrus.Fields{
-		"peer":          peer.Pretty(),
-		"canonicalSlot": chainHeadResponse.CanonicalSlot - params.BeaconConfig().GenesisSlot,
+		"peer":      peer.Pretty(),
+		"stateRoot": fmt.Sprintf("%#x", bytesutil.Trunc(stateRoot)),
 	}
 
 	log.WithFields(fields).Info("Requesting state from peer")
-	if err := s.requestStateFromPeer(ctx, bytesutil.ToBytes32(chainHeadResponse.FinalizedStateRootHash32S), peer); err != nil {
-		log.Errorf("Could not request state from peer %v", err)
+	if err := s.requestStateFromPeer(ctx, bytesutil.ToBytes32(stateRoot), peer); err != nil {
+		return fmt.Errorf("could not request state from peer: %v", err)
 	}
 
-	ctx, cancel := context.WithTimeout(s.ctx, 20*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, stateRequestTimeout)
 	defer cancel()
 
 	for {
 		select {
 		case <-ctx.Done():
-
+			if ctx.Err() == context.DeadlineExceeded && bytes.Equal(peerHead.FinalizedStateRootHash32S, stateRoot) {
+				s.p2p.Reputation(peer, p2p.RepPenalityRequestTimeout)
+			}
 			return ctx.Err()
 		case msg := <-s.stateBuf:
-			log.WithFields(fields).Info("Received state resp from peer")
-			if err := s.processState(msg, chainHeadResponse); err != nil {
-				return err
-			}
-		case msg := <-s.batchedBlockBuf:
 			if msg.Peer != peer {
 				continue
 			}
-			log.WithFields(fields).Info("Received batched blocks from peer")
-			if err := s.processBatchedBlocks(msg, chainHeadResponse); err != nil {
-				log.WithError(err).WithField("peer", peer).Error("Failed to sync with peer.")
+			log.WithFields(fields).Info("Received state resp from peer")
+			data := msg.Data.(*pb.BeaconStateResponse)
+			if err := s.verifyFinalizedState(data.FinalizedState, stateRoot); err != nil {
 				s.p2p.Reputation(msg.Peer, p2p.RepPenalityInitialSyncFailure)
-				continue
+				return err
 			}
-			if !s.nodeIsSynced {
-				return errors.New("node still not in sync after receiving batch blocks")
+			if err := s.processState(msg); err != nil {
+				return fmt.Errorf("could not process state from peer: %v", err)
 			}
-			s.p2p.Reputation(msg.Peer, p2p.RepRewardValidBlock)
 			return nil
 		}
 	}
 }
+
+// rejectCheckpointMismatches drops the peers which contradict the trusted checkpoint,
+// either by advertising the checkpoint block as finalized with a different state or by
+// having a canonical head behind the checkpoint.
+func (s *InitialSync) rejectCheckpointMismatches(peers []peer.ID, chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) []peer.ID {
+	if s.checkpointState == nil {
+		return peers
+	}
+	checkpointStateRoot, err := hashutil.HashProto(s.checkpointState)
+	if err != nil {
+		log.WithError(err).Error("Could not hash checkpoint state")
+		return nil
+	}
+
+	var agreeing []peer.ID
+	for _, p := range peers {
+		peerHead := chainHeadResponses[p]
+		if bytes.Equal(peerHead.FinalizedBlockRoot, s.checkpointRoot) &&
+			!bytes.Equal(peerHead.FinalizedStateRootHash32S, checkpointStateRoot[:]) {
+			log.WithField("peer", p.Pretty()).Warn("Rejecting peer with a finalized state conflicting with the checkpoint")
+			s.p2p.Reputation(p, p2p.RepPenalityInitialSyncFailure)
+			continue
+		}
+		if peerHead.CanonicalSlot < s.checkpointState.Slot {
+			log.WithField("peer", p.Pretty()).Debug("Ignoring peer behind the checkpoint")
+			continue
+		}
+		agreeing = append(agreeing, p)
+	}
+	return agreeing
+}
@@ -2,9 +2,11 @@ package initialsync
 
 import (
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
 	peer "github.com/libp2p/go-libp2p-peer"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
@@ -32,13 +34,14 @@ func (mp *mockP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID)
 	return nil
 }
 
-func (mp *mockP2P) Reputation(_ peer.ID, _ int) {
+func (mp *mockP2P) Reputation(_ peer.ID, _ p2p.Behaviour) {
 
 }
 
 type mockSyncService struct {
 	hasStarted bool
 	isSynced   bool
+	resumed    bool
 }
 
 func (ms *mockSyncService) Start() {
@@ -50,7 +53,7 @@ func (ms *mockSyncService) IsSyncedWithNetwork() bool {
 }
 
 func (ms *mockSyncService) ResumeSync() {
-
+	ms.resumed = true
 }
 
 type mockChainService struct{}
@@ -135,16 +138,9 @@ func TestProcessingBatchedBlocks_OK(t *testing.T) {
 	// block first. This is swapping the first and last blocks in the list.
 	batchedBlocks[0], batchedBlocks[batchSize-1] = batchedBlocks[batchSize-1], batchedBlocks[0]
 
-	msg := p2p.Message{
-		Ctx: context.Background(),
-		Data: &pb.BatchedBeaconBlockResponse{
-			BatchedBlocks: batchedBlocks,
-		},
-	}
-
 	chainHead := &pb.ChainHeadResponse{}
 
-	ss.processBatchedBlocks(msg, chainHead)
+	ss.processBatchedBlocks(context.Background(), batchedBlocks, chainHead)
 
 }
 
@@ -227,3 +223,131 @@ func TestSafelyHandleMessage_NoData(t *testing.T) {
 		t.Errorf("Message logged was not what was expected: %s", entry.Data["msg"])
 	}
 }
+
+func TestVerifyFinalizedState_RejectsCheckpointMismatch(t *testing.T) {
+	checkpointBlock := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 64}
+	checkpointRoot, err := hashutil.HashBeaconBlock(checkpointBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss := NewInitialSyncService(context.Background(), &Config{
+		P2P:            &mockP2P{},
+		CheckpointRoot: checkpointRoot[:],
+	})
+
+	trusted := &pb.BeaconState{Slot: checkpointBlock.Slot, LatestBlock: checkpointBlock}
+	trustedRoot, err := hashutil.HashProto(trusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ss.verifyFinalizedState(trusted, trustedRoot[:]); err != nil {
+		t.Errorf("Expected the checkpoint state to be accepted: %v", err)
+	}
+
+	fake := &pb.BeaconState{Slot: checkpointBlock.Slot, LatestBlock: &pb.BeaconBlock{Slot: checkpointBlock.Slot + 1}}
+	fakeRoot, err := hashutil.HashProto(fake)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ss.verifyFinalizedState(fake, fakeRoot[:]); err != ErrCheckpointMismatch {
+		t.Errorf("Expected %v, received %v", ErrCheckpointMismatch, err)
+	}
+	if err := ss.verifyFinalizedState(trusted, fakeRoot[:]); err == nil {
+		t.Error("Expected a state not hashing to the requested root to be rejected")
+	}
+}
+
+func TestRejectCheckpointMismatches(t *testing.T) {
+	checkpointBlock := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 64}
+	checkpointRoot, err := hashutil.HashBeaconBlock(checkpointBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpointState := &pb.BeaconState{Slot: checkpointBlock.Slot, LatestBlock: checkpointBlock}
+	checkpointStateRoot, err := hashutil.HashProto(checkpointState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss := NewInitialSyncService(context.Background(), &Config{
+		P2P:             &mockP2P{},
+		CheckpointRoot:  checkpointRoot[:],
+		CheckpointState: checkpointState,
+	})
+
+	agreeing, conflicting, behind, later := peer.ID("agreeing"), peer.ID("conflicting"), peer.ID("behind"), peer.ID("later")
+	chainHeads := map[peer.ID]*pb.ChainHeadResponse{
+		agreeing: &pb.ChainHeadResponse{
+			CanonicalSlot:             checkpointBlock.Slot + 10,
+			FinalizedBlockRoot:        checkpointRoot[:],
+			FinalizedStateRootHash32S: checkpointStateRoot[:],
+		},
+		conflicting: &pb.ChainHeadResponse{
+			CanonicalSlot:             checkpointBlock.Slot + 10,
+			FinalizedBlockRoot:        checkpointRoot[:],
+			FinalizedStateRootHash32S: []byte{'A'},
+		},
+		behind: &pb.ChainHeadResponse{
+			CanonicalSlot: checkpointBlock.Slot - 1,
+		},
+		later: &pb.ChainHeadResponse{
+			CanonicalSlot:      checkpointBlock.Slot + 200,
+			FinalizedBlockRoot: []byte{'B'},
+		},
+	}
+
+	peers := ss.rejectCheckpointMismatches([]peer.ID{agreeing, conflicting, behind, later}, chainHeads)
+	if len(peers) != 2 || peers[0] != agreeing || peers[1] != later {
+		t.Errorf("Wanted peers [%s %s], received %v", agreeing, later, peers)
+	}
+}
+
+func TestRun_ResumesRegularSyncWithoutPeers(t *testing.T) {
+	ms := &mockSyncService{}
+	ss := NewInitialSyncService(context.Background(), &Config{
+		P2P:         &mockP2P{},
+		SyncService: ms,
+	})
+
+	ss.Start(make(map[peer.ID]*pb.ChainHeadResponse))
+	select {
+	case <-ss.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Expected initial sync to exit without peers")
+	}
+	if !ms.resumed {
+		t.Error("Expected regular sync to be resumed after initial sync gave up")
+	}
+	if ss.NodeIsSynced() {
+		t.Error("Expected the node not to be synced")
+	}
+}
+
+type mockPowChain struct {
+	exists bool
+}
+
+func (mp *mockPowChain) BlockExists(ctx context.Context, hash common.Hash) (bool, *big.Int, error) {
+	return mp.exists, big.NewInt(0), nil
+}
+
+func TestSaveFinalizedState_UnknownEth1Block(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ss := NewInitialSyncService(context.Background(), &Config{
+		P2P:      &mockP2P{},
+		BeaconDB: db,
+		PowChain: &mockPowChain{exists: false},
+	})
+
+	finalizedState := &pb.BeaconState{
+		Slot:           params.BeaconConfig().GenesisSlot + 64,
+		LatestBlock:    &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 64},
+		LatestEth1Data: &pb.Eth1Data{BlockHash32: []byte("unknown")},
+	}
+	if err := ss.saveFinalizedState(context.Background(), finalizedState); err == nil {
+		t.Error("Expected a state with an unknown ETH1 block to be rejected")
+	}
+	if ss.stateReceived {
+		t.Error("Expected the state not to be marked as received")
+	}
+}
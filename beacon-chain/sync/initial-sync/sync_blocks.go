@@ -10,7 +10,6 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
-	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -39,22 +38,14 @@ func (s *InitialSync) processBlock(ctx context.Context, block *pb.BeaconBlock, c
 	return nil
 }
 
-// processBatchedBlocks processes all the received blocks from
-// the p2p message.
-func (s *InitialSync) processBatchedBlocks(msg p2p.Message, chainHead *pb.ChainHeadResponse) error {
-	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processBatchedBlocks")
+// processBatchedBlocks processes the blocks received for a batch
+// in ascending slot order.
+func (s *InitialSync) processBatchedBlocks(ctx context.Context, batchedBlocks []*pb.BeaconBlock, chainHead *pb.ChainHeadResponse) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.processBatchedBlocks")
 	defer span.End()
 	batchedBlockReq.Inc()
 
-	response := msg.Data.(*pb.BatchedBeaconBlockResponse)
-	batchedBlocks := response.BatchedBlocks
-	if len(batchedBlocks) == 0 {
-		// Do not process empty responses.
-		s.p2p.Reputation(msg.Peer, p2p.RepPenalityInitialSyncFailure)
-		return nil
-	}
-
-	log.WithField("blocks", len(batchedBlocks)).Info("Processing batched block response")
+	log.WithField("blocks", len(batchedBlocks)).Debug("Processing batched block response")
 	// Sort batchBlocks in ascending order.
 	sort.Slice(batchedBlocks, func(i, j int) bool {
 		return batchedBlocks[i].Slot < batchedBlocks[j].Slot
@@ -68,23 +59,22 @@ func (s *InitialSync) processBatchedBlocks(msg p2p.Message, chainHead *pb.ChainH
 	return nil
 }
 
-// requestBatchedBlocks sends out a request for multiple blocks that's between finalized roots
-// and head roots.
-func (s *InitialSync) requestBatchedBlocks(ctx context.Context, finalizedRoot []byte, canonicalRoot []byte, peer peer.ID) {
-	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.requestBatchedBlocks")
+// requestBlockRange sends out a request to a peer for the canonical blocks
+// with slots between startSlot and endSlot inclusive.
+func (s *InitialSync) requestBlockRange(ctx context.Context, startSlot uint64, endSlot uint64, peer peer.ID) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.requestBlockRange")
 	defer span.End()
 	sentBatchedBlockReq.Inc()
 
 	log.WithFields(logrus.Fields{
-		"finalizedBlkRoot": fmt.Sprintf("%#x", bytesutil.Trunc(finalizedRoot[:])),
-		"headBlkRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(canonicalRoot[:]))},
-	).Debug("Requesting batched blocks")
-	if err := s.p2p.Send(ctx, &pb.BatchedBeaconBlockRequest{
-		FinalizedRoot: finalizedRoot,
-		CanonicalRoot: canonicalRoot,
-	}, peer); err != nil {
-		log.Errorf("Could not send batch block request to peer %s: %v", peer.Pretty(), err)
-	}
+		"peer":      peer.Pretty(),
+		"startSlot": startSlot - params.BeaconConfig().GenesisSlot,
+		"endSlot":   endSlot - params.BeaconConfig().GenesisSlot,
+	}).Debug("Requesting batched blocks")
+	return s.p2p.Send(ctx, &pb.BatchedBeaconBlockRequest{
+		StartSlot: startSlot,
+		EndSlot:   endSlot,
+	}, peer)
 }
 
 // validateAndSaveNextBlock will validate whether blocks received from the blockfetcher
@@ -1,7 +1,10 @@
 package initialsync
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 
 	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
@@ -13,37 +16,37 @@ import (
 	"go.opencensus.io/trace"
 )
 
-func (s *InitialSync) processState(msg p2p.Message, chainHead *pb.ChainHeadResponse) error {
+func (s *InitialSync) processState(msg p2p.Message) error {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processState")
 	defer span.End()
 	data := msg.Data.(*pb.BeaconStateResponse)
-	finalizedState := data.FinalizedState
 	recState.Inc()
 
+	return s.saveFinalizedState(ctx, data.FinalizedState)
+}
+
+// saveFinalizedState stores a finalized state, either received from a peer or loaded
+// from a trusted checkpoint, as the starting point of the chain the node syncs.
+func (s *InitialSync) saveFinalizedState(ctx context.Context, finalizedState *pb.BeaconState) error {
 	if err := s.db.SaveFinalizedState(finalizedState); err != nil {
-		log.Errorf("Unable to set received last finalized state in db: %v", err)
-		return nil
+		return fmt.Errorf("could not save finalized state: %v", err)
 	}
 
 	if err := s.db.SaveFinalizedBlock(finalizedState.LatestBlock); err != nil {
-		log.Errorf("Could not save finalized block %v", err)
-		return nil
+		return fmt.Errorf("could not save finalized block: %v", err)
 	}
 
 	if err := s.db.SaveBlock(finalizedState.LatestBlock); err != nil {
-		log.Errorf("Could not save block %v", err)
-		return nil
+		return fmt.Errorf("could not save block: %v", err)
 	}
 
 	finalizedBlockRoot, err := hashutil.HashBeaconBlock(finalizedState.LatestBlock)
 	if err != nil {
-		log.Errorf("Could not hash finalized block %v", err)
-		return nil
+		return fmt.Errorf("could not hash finalized block: %v", err)
 	}
 
 	if err := s.db.SaveHistoricalState(ctx, finalizedState, finalizedBlockRoot); err != nil {
-		log.Errorf("Could not save new historical state: %v", err)
-		return nil
+		return fmt.Errorf("could not save historical state: %v", err)
 	}
 
 	if err := s.db.SaveAttestationTarget(ctx, &pb.AttestationTarget{
@@ -51,35 +54,29 @@ func (s *InitialSync) processState(msg p2p.Message, chainHead *pb.ChainHeadRespo
 		BlockRoot:  finalizedBlockRoot[:],
 		ParentRoot: finalizedState.LatestBlock.ParentRootHash32,
 	}); err != nil {
-		log.Errorf("Could not to save attestation target: %v", err)
-		return nil
+		return fmt.Errorf("could not save attestation target: %v", err)
 	}
 
 	if err := s.db.SaveJustifiedState(finalizedState); err != nil {
-		log.Errorf("Could not set beacon state for initial sync %v", err)
-		return nil
+		return fmt.Errorf("could not save justified state: %v", err)
 	}
 
 	if err := s.db.SaveJustifiedBlock(finalizedState.LatestBlock); err != nil {
-		log.Errorf("Could not save finalized block %v", err)
-		return nil
+		return fmt.Errorf("could not save justified block: %v", err)
 	}
 
 	exists, _, err := s.powchain.BlockExists(ctx, bytesutil.ToBytes32(finalizedState.LatestEth1Data.BlockHash32))
 	if err != nil {
-		log.Errorf("Unable to get powchain block %v", err)
+		return fmt.Errorf("could not get powchain block: %v", err)
 	}
-
 	if !exists {
-		log.Error("Latest ETH1 block doesn't exist in the pow chain")
-		return nil
+		return errors.New("latest ETH1 block of the finalized state doesn't exist in the pow chain")
 	}
 
 	s.db.PrunePendingDeposits(ctx, finalizedState.DepositIndex)
 
 	if err := s.db.UpdateChainHead(ctx, finalizedState.LatestBlock, finalizedState); err != nil {
-		log.Errorf("Could not update chain head: %v", err)
-		return nil
+		return fmt.Errorf("could not update chain head: %v", err)
 	}
 
 	validators.InitializeValidatorStore(finalizedState)
@@ -89,9 +86,32 @@ func (s *InitialSync) processState(msg p2p.Message, chainHead *pb.ChainHeadRespo
 		"Successfully saved beacon state with the last finalized slot: %d",
 		finalizedState.Slot-params.BeaconConfig().GenesisSlot,
 	)
-	log.WithField("peer", msg.Peer.Pretty()).Info("Requesting batch blocks from peer")
-	s.requestBatchedBlocks(ctx, finalizedBlockRoot[:], chainHead.CanonicalBlockRoot, msg.Peer)
+	return nil
+}
 
+// verifyFinalizedState checks that a finalized state received from a peer hashes to the
+// requested root and, when a checkpoint is pinned, that it is the checkpoint state.
+func (s *InitialSync) verifyFinalizedState(finalizedState *pb.BeaconState, requestedRoot []byte) error {
+	if finalizedState == nil || finalizedState.LatestBlock == nil {
+		return errors.New("received finalized state without a latest block")
+	}
+	stateRoot, err := hashutil.HashProto(finalizedState)
+	if err != nil {
+		return fmt.Errorf("could not hash finalized state: %v", err)
+	}
+	if stateRoot != bytesutil.ToBytes32(requestedRoot) {
+		return fmt.Errorf("received state root %#x, requested %#x", stateRoot, requestedRoot)
+	}
+	if len(s.checkpointRoot) == 0 {
+		return nil
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(finalizedState.LatestBlock)
+	if err != nil {
+		return fmt.Errorf("could not hash finalized block: %v", err)
+	}
+	if !bytes.Equal(blockRoot[:], s.checkpointRoot) {
+		return ErrCheckpointMismatch
+	}
 	return nil
 }
 
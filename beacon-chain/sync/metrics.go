@@ -12,6 +12,14 @@ var (
 		Name: "regsync_batched_block_req",
 		Help: "The number of received batch block requests",
 	})
+	rateLimitedReq = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "regsync_rate_limited_req",
+		Help: "The number of peer requests dropped for exceeding the rate limit, by request type",
+	}, []string{"type"})
+	truncatedBatchReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_truncated_batch_req",
+		Help: "The number of batched block responses truncated to the maximum batch size",
+	})
 	blockReqHash = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "regsync_block_req_by_hash",
 		Help: "The number of received block requests by hash",
@@ -72,6 +80,30 @@ var (
 		Name: "regsync_sent_exits",
 		Help: "The number of sent exits",
 	})
+	proposerSlashingReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_proposer_slashing_req",
+		Help: "The number of received proposer slashing requests",
+	})
+	recProposerSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_received_proposer_slashings",
+		Help: "The number of received proposer slashings",
+	})
+	sentProposerSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_sent_proposer_slashings",
+		Help: "The number of sent proposer slashings",
+	})
+	attesterSlashingReq = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_attester_slashing_req",
+		Help: "The number of received attester slashing requests",
+	})
+	recAttesterSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_received_attester_slashings",
+		Help: "The number of received attester slashings",
+	})
+	sentAttesterSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_sent_attester_slashings",
+		Help: "The number of sent attester slashings",
+	})
 	chainHeadReq = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "regsync_chain_head_req",
 		Help: "The number of sent attestation requests",
@@ -28,7 +28,7 @@ type powChainService interface {
 type QuerierConfig struct {
 	ResponseBufferSize int
 	P2P                p2pAPI
-	BeaconDB           *db.BeaconDB
+	BeaconDB           db.Database
 	PowChain           powChainService
 	CurrentHeadSlot    uint64
 	ChainService       chainService
@@ -48,7 +48,7 @@ type Querier struct {
 	ctx                       context.Context
 	cancel                    context.CancelFunc
 	p2p                       p2pAPI
-	db                        *db.BeaconDB
+	db                        db.Database
 	chainService              chainService
 	currentHeadSlot           uint64
 	currentStateRoot          []byte
@@ -0,0 +1,81 @@
+package sync
+
+import (
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// maxIdleBuckets is the number of tracked peers above which buckets that have
+// refilled completely are dropped, as they are equivalent to a fresh bucket.
+const maxIdleBuckets = 1000
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter keeps a token bucket per peer for a single request type. Every
+// request takes one token, and tokens refill at a constant rate up to the burst size.
+type rateLimiter struct {
+	rate    float64
+	burst   float64
+	buckets map[peer.ID]*tokenBucket
+	lock    sync.Mutex
+	now     func() time.Time
+}
+
+// newRateLimiter returns a limiter allowing rate requests per second with bursts of
+// up to burst requests. A non-positive rate disables the limiter.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[peer.ID]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// allow takes a token from the peer's bucket and reports whether one was available.
+func (l *rateLimiter) allow(p peer.ID) bool {
+	if l == nil {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[p]
+	if !ok {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.prune(now)
+		}
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[p] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *rateLimiter) prune(now time.Time) {
+	for p, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, p)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func TestRateLimiter_RefillsOverTime(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+	p := peer.ID("a")
+
+	for i := 0; i < 3; i++ {
+		if !l.allow(p) {
+			t.Fatalf("Expected request %d within the burst to be allowed", i)
+		}
+	}
+	if l.allow(p) {
+		t.Fatal("Expected request over the burst to be rejected")
+	}
+	if !l.allow(peer.ID("b")) {
+		t.Error("Expected another peer to have its own bucket")
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if !l.allow(p) {
+		t.Error("Expected a token to be refilled after half a second")
+	}
+	if l.allow(p) {
+		t.Error("Expected only one token to be refilled after half a second")
+	}
+
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		if !l.allow(p) {
+			t.Fatalf("Expected request %d to be allowed after the bucket refilled", i)
+		}
+	}
+	if l.allow(p) {
+		t.Error("Expected tokens not to refill beyond the burst size")
+	}
+}
+
+func TestRateLimiter_DisabledAllowsAll(t *testing.T) {
+	l := newRateLimiter(0, 10)
+	for i := 0; i < 100; i++ {
+		if !l.allow(peer.ID("a")) {
+			t.Fatal("Expected a disabled limiter to allow every request")
+		}
+	}
+}
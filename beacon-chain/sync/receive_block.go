@@ -206,6 +206,7 @@ func (rs *RegularSync) validateAndProcessBlock(
 	}
 	rs.p2p.Reputation(blockMsg.Peer, p2p.RepRewardValidBlock)
 	sentBlocks.Inc()
+	rs.blockAnnouncementFeed.Send(block)
 	// We update the last observed slot to the received canonical block's slot.
 	if block.Slot > rs.highestObservedSlot {
 		rs.highestObservedSlot = block.Slot
@@ -32,6 +32,7 @@ var (
 		Name: "regsync_blocks_awaiting_processing",
 		Help: "Number of blocks which do not have a parent and are awaiting processing by the chain service",
 	})
+	errRateLimited = errors.New("peer exceeded its request rate limit")
 )
 
 type chainService interface {
@@ -71,7 +72,7 @@ type RegularSync struct {
 	chainService                 chainService
 	attsService                  attsService
 	operationsService            operations.OperationFeeds
-	db                           *db.BeaconDB
+	db                           db.Database
 	blockAnnouncementFeed        *event.Feed
 	announceBlockBuf             chan p2p.Message
 	blockBuf                     chan p2p.Message
@@ -83,6 +84,12 @@ type RegularSync struct {
 	attestationReqByHashBuf      chan p2p.Message
 	announceAttestationBuf       chan p2p.Message
 	exitBuf                      chan p2p.Message
+	announceProposerSlashingBuf  chan p2p.Message
+	proposerSlashingReqBuf       chan p2p.Message
+	proposerSlashingBuf          chan p2p.Message
+	announceAttesterSlashingBuf  chan p2p.Message
+	attesterSlashingReqBuf       chan p2p.Message
+	attesterSlashingBuf          chan p2p.Message
 	canonicalBuf                 chan *pb.BeaconBlockAnnounce
 	highestObservedSlot          uint64
 	blocksAwaitingProcessing     map[[32]byte]p2p.Message
@@ -90,9 +97,16 @@ type RegularSync struct {
 	blockProcessingLock          sync.RWMutex
 	blockAnnouncements           map[uint64][]byte
 	blockAnnouncementsLock       sync.RWMutex
+	runCancel                    context.CancelFunc
+	runLock                      sync.Mutex
+	blockReqLimiter              *rateLimiter
+	batchedReqLimiter            *rateLimiter
+	stateReqLimiter              *rateLimiter
+	maxBatchedBlocks             uint64
 }
 
-// RegularSyncConfig allows the channel's buffer sizes to be changed.
+// RegularSyncConfig allows the channel's buffer sizes and the limits on requests
+// served to peers to be changed. A request rate limit of zero disables the limit.
 type RegularSyncConfig struct {
 	BlockAnnounceBufferSize     int
 	BlockBufferSize             int
@@ -103,12 +117,20 @@ type RegularSyncConfig struct {
 	AttestationReqHashBufSize   int
 	AttestationsAnnounceBufSize int
 	ExitBufferSize              int
+	SlashingBufferSize          int
 	ChainHeadReqBufferSize      int
 	CanonicalBufferSize         int
+	BlockReqRateLimit           float64 // Block requests by hash per second per peer.
+	BlockReqBurst               int
+	BatchedReqRateLimit         float64 // Batched block requests per second per peer.
+	BatchedReqBurst             int
+	StateReqRateLimit           float64 // Finalized state requests per second per peer.
+	StateReqBurst               int
+	MaxBatchedBlocks            uint64 // Maximum number of blocks in a batched block response.
 	ChainService                chainService
 	OperationService            operations.OperationFeeds
 	AttsService                 attsService
-	BeaconDB                    *db.BeaconDB
+	BeaconDB                    db.Database
 	P2P                         p2pAPI
 }
 
@@ -125,46 +147,93 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		AttestationReqHashBufSize:   params.BeaconConfig().DefaultBufferSize,
 		AttestationsAnnounceBufSize: params.BeaconConfig().DefaultBufferSize,
 		ExitBufferSize:              params.BeaconConfig().DefaultBufferSize,
+		SlashingBufferSize:          params.BeaconConfig().DefaultBufferSize,
 		CanonicalBufferSize:         params.BeaconConfig().DefaultBufferSize,
+		BlockReqRateLimit:           10,
+		BlockReqBurst:               50,
+		BatchedReqRateLimit:         2,
+		BatchedReqBurst:             10,
+		StateReqRateLimit:           1.0 / 60,
+		StateReqBurst:               2,
+		MaxBatchedBlocks:            params.BeaconConfig().BatchBlockLimit,
 	}
 }
 
 // NewRegularSyncService accepts a context and returns a new Service.
 func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *RegularSync {
 	ctx, cancel := context.WithCancel(ctx)
+	maxBatchedBlocks := cfg.MaxBatchedBlocks
+	if maxBatchedBlocks == 0 {
+		maxBatchedBlocks = params.BeaconConfig().BatchBlockLimit
+	}
 	return &RegularSync{
-		ctx:                      ctx,
-		cancel:                   cancel,
-		p2p:                      cfg.P2P,
-		chainService:             cfg.ChainService,
-		db:                       cfg.BeaconDB,
-		operationsService:        cfg.OperationService,
-		attsService:              cfg.AttsService,
-		blockAnnouncementFeed:    new(event.Feed),
-		announceBlockBuf:         make(chan p2p.Message, cfg.BlockAnnounceBufferSize),
-		blockBuf:                 make(chan p2p.Message, cfg.BlockBufferSize),
-		blockRequestByHash:       make(chan p2p.Message, cfg.BlockReqHashBufferSize),
-		batchedRequestBuf:        make(chan p2p.Message, cfg.BatchedBufferSize),
-		stateRequestBuf:          make(chan p2p.Message, cfg.StateReqBufferSize),
-		attestationBuf:           make(chan p2p.Message, cfg.AttestationBufferSize),
-		attestationReqByHashBuf:  make(chan p2p.Message, cfg.AttestationReqHashBufSize),
-		announceAttestationBuf:   make(chan p2p.Message, cfg.AttestationsAnnounceBufSize),
-		exitBuf:                  make(chan p2p.Message, cfg.ExitBufferSize),
-		chainHeadReqBuf:          make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
-		canonicalBuf:             make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
-		blocksAwaitingProcessing: make(map[[32]byte]p2p.Message),
-		blockAnnouncements:       make(map[uint64][]byte),
+		ctx:                         ctx,
+		cancel:                      cancel,
+		p2p:                         cfg.P2P,
+		chainService:                cfg.ChainService,
+		db:                          cfg.BeaconDB,
+		operationsService:           cfg.OperationService,
+		attsService:                 cfg.AttsService,
+		blockAnnouncementFeed:       new(event.Feed),
+		announceBlockBuf:            make(chan p2p.Message, cfg.BlockAnnounceBufferSize),
+		blockBuf:                    make(chan p2p.Message, cfg.BlockBufferSize),
+		blockRequestByHash:          make(chan p2p.Message, cfg.BlockReqHashBufferSize),
+		batchedRequestBuf:           make(chan p2p.Message, cfg.BatchedBufferSize),
+		stateRequestBuf:             make(chan p2p.Message, cfg.StateReqBufferSize),
+		attestationBuf:              make(chan p2p.Message, cfg.AttestationBufferSize),
+		attestationReqByHashBuf:     make(chan p2p.Message, cfg.AttestationReqHashBufSize),
+		announceAttestationBuf:      make(chan p2p.Message, cfg.AttestationsAnnounceBufSize),
+		exitBuf:                     make(chan p2p.Message, cfg.ExitBufferSize),
+		announceProposerSlashingBuf: make(chan p2p.Message, cfg.SlashingBufferSize),
+		proposerSlashingReqBuf:      make(chan p2p.Message, cfg.SlashingBufferSize),
+		proposerSlashingBuf:         make(chan p2p.Message, cfg.SlashingBufferSize),
+		announceAttesterSlashingBuf: make(chan p2p.Message, cfg.SlashingBufferSize),
+		attesterSlashingReqBuf:      make(chan p2p.Message, cfg.SlashingBufferSize),
+		attesterSlashingBuf:         make(chan p2p.Message, cfg.SlashingBufferSize),
+		chainHeadReqBuf:             make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
+		canonicalBuf:                make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
+		blocksAwaitingProcessing:    make(map[[32]byte]p2p.Message),
+		blockAnnouncements:          make(map[uint64][]byte),
+		blockReqLimiter:             newRateLimiter(cfg.BlockReqRateLimit, cfg.BlockReqBurst),
+		batchedReqLimiter:           newRateLimiter(cfg.BatchedReqRateLimit, cfg.BatchedReqBurst),
+		stateReqLimiter:             newRateLimiter(cfg.StateReqRateLimit, cfg.StateReqBurst),
+		maxBatchedBlocks:            maxBatchedBlocks,
 	}
 }
 
 // Start begins the block processing goroutine.
 func (rs *RegularSync) Start() {
-	go rs.run()
+	rs.startRun()
 }
 
 // ResumeSync resumes normal sync after initial sync is complete.
 func (rs *RegularSync) ResumeSync() {
-	go rs.run()
+	rs.startRun()
+}
+
+// Pause stops handling sync messages from peers while the node catches up
+// through initial sync. ResumeSync starts handling them again.
+func (rs *RegularSync) Pause() {
+	rs.runLock.Lock()
+	defer rs.runLock.Unlock()
+	if rs.runCancel == nil {
+		return
+	}
+	log.Info("Pausing regular sync")
+	rs.runCancel()
+	rs.runCancel = nil
+}
+
+// startRun starts the block processing goroutine unless it is already running.
+func (rs *RegularSync) startRun() {
+	rs.runLock.Lock()
+	defer rs.runLock.Unlock()
+	if rs.runCancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(rs.ctx)
+	rs.runCancel = cancel
+	go rs.run(ctx)
 }
 
 // Stop kills the block processing goroutine, but does not wait until the goroutine exits.
@@ -175,13 +244,13 @@ func (rs *RegularSync) Stop() error {
 }
 
 // BlockAnnouncementFeed returns an event feed processes can subscribe to for
-// newly received, incoming p2p blocks.
+// newly received, incoming p2p blocks once they have been processed.
 func (rs *RegularSync) BlockAnnouncementFeed() *event.Feed {
 	return rs.blockAnnouncementFeed
 }
 
-// run handles incoming block sync.
-func (rs *RegularSync) run() {
+// run handles incoming block sync until the given context is cancelled.
+func (rs *RegularSync) run(ctx context.Context) {
 	announceBlockSub := rs.p2p.Subscribe(&pb.BeaconBlockAnnounce{}, rs.announceBlockBuf)
 	blockSub := rs.p2p.Subscribe(&pb.BeaconBlockResponse{}, rs.blockBuf)
 	blockRequestHashSub := rs.p2p.Subscribe(&pb.BeaconBlockRequest{}, rs.blockRequestByHash)
@@ -191,6 +260,12 @@ func (rs *RegularSync) run() {
 	attestationReqSub := rs.p2p.Subscribe(&pb.AttestationRequest{}, rs.attestationReqByHashBuf)
 	announceAttestationSub := rs.p2p.Subscribe(&pb.AttestationAnnounce{}, rs.announceAttestationBuf)
 	exitSub := rs.p2p.Subscribe(&pb.VoluntaryExit{}, rs.exitBuf)
+	announceProposerSlashingSub := rs.p2p.Subscribe(&pb.ProposerSlashingAnnounce{}, rs.announceProposerSlashingBuf)
+	proposerSlashingReqSub := rs.p2p.Subscribe(&pb.ProposerSlashingRequest{}, rs.proposerSlashingReqBuf)
+	proposerSlashingSub := rs.p2p.Subscribe(&pb.ProposerSlashingResponse{}, rs.proposerSlashingBuf)
+	announceAttesterSlashingSub := rs.p2p.Subscribe(&pb.AttesterSlashingAnnounce{}, rs.announceAttesterSlashingBuf)
+	attesterSlashingReqSub := rs.p2p.Subscribe(&pb.AttesterSlashingRequest{}, rs.attesterSlashingReqBuf)
+	attesterSlashingSub := rs.p2p.Subscribe(&pb.AttesterSlashingResponse{}, rs.attesterSlashingBuf)
 	chainHeadReqSub := rs.p2p.Subscribe(&pb.ChainHeadRequest{}, rs.chainHeadReqBuf)
 	canonicalBlockSub := rs.chainService.CanonicalBlockFeed().Subscribe(rs.canonicalBuf)
 
@@ -204,13 +279,19 @@ func (rs *RegularSync) run() {
 	defer attestationReqSub.Unsubscribe()
 	defer announceAttestationSub.Unsubscribe()
 	defer exitSub.Unsubscribe()
+	defer announceProposerSlashingSub.Unsubscribe()
+	defer proposerSlashingReqSub.Unsubscribe()
+	defer proposerSlashingSub.Unsubscribe()
+	defer announceAttesterSlashingSub.Unsubscribe()
+	defer attesterSlashingReqSub.Unsubscribe()
+	defer attesterSlashingSub.Unsubscribe()
 	defer canonicalBlockSub.Unsubscribe()
 
 	log.Info("Listening for regular sync messages from peers")
 
 	for {
 		select {
-		case <-rs.ctx.Done():
+		case <-ctx.Done():
 			log.Debug("Exiting goroutine")
 			return
 		case msg := <-rs.announceBlockBuf:
@@ -223,6 +304,18 @@ func (rs *RegularSync) run() {
 			go safelyHandleMessage(rs.handleAttestationAnnouncement, msg)
 		case msg := <-rs.exitBuf:
 			go safelyHandleMessage(rs.receiveExitRequest, msg)
+		case msg := <-rs.announceProposerSlashingBuf:
+			go safelyHandleMessage(rs.handleProposerSlashingAnnouncement, msg)
+		case msg := <-rs.proposerSlashingReqBuf:
+			go safelyHandleMessage(rs.handleProposerSlashingRequestByHash, msg)
+		case msg := <-rs.proposerSlashingBuf:
+			go safelyHandleMessage(rs.receiveProposerSlashing, msg)
+		case msg := <-rs.announceAttesterSlashingBuf:
+			go safelyHandleMessage(rs.handleAttesterSlashingAnnouncement, msg)
+		case msg := <-rs.attesterSlashingReqBuf:
+			go safelyHandleMessage(rs.handleAttesterSlashingRequestByHash, msg)
+		case msg := <-rs.attesterSlashingBuf:
+			go safelyHandleMessage(rs.receiveAttesterSlashing, msg)
 		case msg := <-rs.blockBuf:
 			go safelyHandleMessage(rs.receiveBlock, msg)
 		case msg := <-rs.blockRequestByHash:
@@ -293,6 +386,9 @@ func (rs *RegularSync) handleStateRequest(msg p2p.Message) error {
 		log.Error("Message is of the incorrect type")
 		return errors.New("incoming message is not *pb.BeaconStateRequest")
 	}
+	if rs.rateLimited(rs.stateReqLimiter, msg, "state") {
+		return errRateLimited
+	}
 	fState, err := rs.db.FinalizedState()
 	if err != nil {
 		log.Errorf("Unable to retrieve beacon state, %v", err)
@@ -467,6 +563,9 @@ func (rs *RegularSync) handleBlockRequestByHash(msg p2p.Message) error {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleBlockRequestByHash")
 	defer span.End()
 	blockReqHash.Inc()
+	if rs.rateLimited(rs.blockReqLimiter, msg, "block_by_hash") {
+		return errRateLimited
+	}
 
 	data := msg.Data.(*pb.BeaconBlockRequest)
 	root := bytesutil.ToBytes32(data.Hash)
@@ -490,16 +589,25 @@ func (rs *RegularSync) handleBlockRequestByHash(msg p2p.Message) error {
 }
 
 // handleBatchedBlockRequest receives p2p messages which consist of requests for batched blocks
-// which are bounded by a start slot and end slot.
+// which are bounded either by a finalized root and head root, or by a start slot and end slot.
 func (rs *RegularSync) handleBatchedBlockRequest(msg p2p.Message) error {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleBatchedBlockRequest")
 	defer span.End()
 	batchedBlockReq.Inc()
+	if rs.rateLimited(rs.batchedReqLimiter, msg, "batched_blocks") {
+		return errRateLimited
+	}
 	req := msg.Data.(*pb.BatchedBeaconBlockRequest)
 
 	// To prevent circuit in the chain and the potentiality peer can bomb a node building block list.
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
-	response, err := rs.respondBatchedBlocks(ctx, req.FinalizedRoot, req.CanonicalRoot)
+	var response []*pb.BeaconBlock
+	var err error
+	if len(req.FinalizedRoot) == 0 {
+		response, err = rs.respondBlocksBySlotRange(ctx, req.StartSlot, req.EndSlot)
+	} else {
+		response, err = rs.respondBatchedBlocks(ctx, req.FinalizedRoot, req.CanonicalRoot)
+	}
 	cancel()
 	if err != nil {
 		return fmt.Errorf("could not build canonical block list %v", err)
@@ -589,8 +697,24 @@ func (rs *RegularSync) broadcastCanonicalBlock(ctx context.Context, announce *pb
 	sentBlockAnnounce.Inc()
 }
 
+// rateLimited reports whether the peer sending msg has exceeded the request rate of
+// the given limiter, penalizing the peer if it did.
+func (rs *RegularSync) rateLimited(limiter *rateLimiter, msg p2p.Message, requestType string) bool {
+	if limiter.allow(msg.Peer) {
+		return false
+	}
+	rateLimitedReq.WithLabelValues(requestType).Inc()
+	rs.p2p.Reputation(msg.Peer, p2p.RepPenalityRateLimitExceeded)
+	log.WithFields(logrus.Fields{
+		"peer":        msg.Peer,
+		"requestType": requestType,
+	}).Debug("Dropping request from peer over its rate limit")
+	return true
+}
+
 // respondBatchedBlocks returns the requested block list inclusive of head block but not inclusive of the finalized block.
-// the return should look like (finalizedBlock... headBlock].
+// the return should look like (finalizedBlock... headBlock]. Only the first maxBatchedBlocks blocks after the finalized
+// block are returned, the requester asks for the rest once it processed them.
 func (rs *RegularSync) respondBatchedBlocks(ctx context.Context, finalizedRoot []byte, headRoot []byte) ([]*pb.BeaconBlock, error) {
 	// if head block was the same as the finalized block.
 	if bytes.Equal(headRoot, finalizedRoot) {
@@ -606,6 +730,7 @@ func (rs *RegularSync) respondBatchedBlocks(ctx context.Context, finalizedRoot [
 	}
 
 	bList := []*pb.BeaconBlock{b}
+	truncated := false
 	parentRoot := b.ParentRootHash32
 	for !bytes.Equal(parentRoot, finalizedRoot) {
 		if ctx.Err() != nil {
@@ -621,8 +746,44 @@ func (rs *RegularSync) respondBatchedBlocks(ctx context.Context, finalizedRoot [
 
 		// Prepend parent to the beginning of the list.
 		bList = append([]*pb.BeaconBlock{b}, bList...)
+		if uint64(len(bList)) > rs.maxBatchedBlocks {
+			bList = bList[:rs.maxBatchedBlocks]
+			truncated = true
+		}
 
 		parentRoot = b.ParentRootHash32
 	}
+	if truncated {
+		truncatedBatchReq.Inc()
+	}
+	return bList, nil
+}
+
+// respondBlocksBySlotRange returns the canonical blocks with slots in [startSlot, endSlot],
+// in ascending order. Skipped slots are omitted from the list. A range longer than
+// maxBatchedBlocks slots is rejected rather than truncated, as the requester could not
+// tell a truncated response from a range with skipped slots at its end.
+func (rs *RegularSync) respondBlocksBySlotRange(ctx context.Context, startSlot uint64, endSlot uint64) ([]*pb.BeaconBlock, error) {
+	if endSlot < startSlot {
+		return nil, fmt.Errorf("end slot %d is lower than start slot %d", endSlot, startSlot)
+	}
+	if endSlot-startSlot >= rs.maxBatchedBlocks {
+		return nil, fmt.Errorf("slot range of %d slots exceeds the maximum batch size of %d blocks",
+			endSlot-startSlot+1, rs.maxBatchedBlocks)
+	}
+
+	var bList []*pb.BeaconBlock
+	for slot := startSlot; slot <= endSlot; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		b, err := rs.db.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		if b != nil {
+			bList = append(bList, b)
+		}
+	}
 	return bList, nil
 }
@@ -29,12 +29,15 @@ func init() {
 	logrus.SetLevel(logrus.DebugLevel)
 	logrus.SetOutput(ioutil.Discard)
 	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		CacheTreeHash: false,
+		CacheTreeHash:                false,
+		DisableSignatureVerification: true,
 	})
 }
 
 type mockP2P struct {
-	sentMsg proto.Message
+	sentMsg      proto.Message
+	broadcastMsg proto.Message
+	behaviours   []p2p.Behaviour
 }
 
 func (mp *mockP2P) Subscribe(msg proto.Message, channel chan p2p.Message) event.Subscription {
@@ -42,6 +45,7 @@ func (mp *mockP2P) Subscribe(msg proto.Message, channel chan p2p.Message) event.
 }
 
 func (mp *mockP2P) Broadcast(ctx context.Context, msg proto.Message) {
+	mp.broadcastMsg = msg
 }
 
 func (mp *mockP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID) error {
@@ -49,8 +53,8 @@ func (mp *mockP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID)
 	return nil
 }
 
-func (mp *mockP2P) Reputation(_ peer.ID, val int) {
-
+func (mp *mockP2P) Reputation(_ peer.ID, behaviour p2p.Behaviour) {
+	mp.behaviours = append(mp.behaviours, behaviour)
 }
 
 type mockChainService struct {
@@ -117,6 +121,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 type mockAttestationService struct{}
 
 func (ma *mockAttestationService) IncomingAttestationFeed() *event.Feed {
@@ -786,3 +798,169 @@ func TestCanonicalBlockList_NilParentBlock(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBlocksBySlotRange_SkipsEmptySlots(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ss := setupService(db)
+	ctx := context.Background()
+
+	var wantList []*pb.BeaconBlock
+	for _, slot := range []uint64{1, 2, 4, 5} {
+		block := &pb.BeaconBlock{Slot: slot}
+		if err := ss.db.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+		if err := ss.db.UpdateChainHead(ctx, block, &pb.BeaconState{Slot: slot}); err != nil {
+			t.Fatalf("Could not update chain head: %v", err)
+		}
+		if slot >= 2 && slot <= 4 {
+			wantList = append(wantList, block)
+		}
+	}
+
+	list, err := ss.respondBlocksBySlotRange(ctx, 2, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(list, wantList) {
+		t.Errorf("Wanted blocks %v, received %v", wantList, list)
+	}
+
+	if _, err := ss.respondBlocksBySlotRange(ctx, 4, 2); err == nil {
+		t.Error("Expected an inverted slot range to be rejected")
+	}
+	ss.maxBatchedBlocks = 3
+	if _, err := ss.respondBlocksBySlotRange(ctx, 2, 4); err != nil {
+		t.Errorf("Expected a slot range at the batch limit to be served: %v", err)
+	}
+	if _, err := ss.respondBlocksBySlotRange(ctx, 2, 5); err == nil {
+		t.Error("Expected a slot range over the batch limit to be rejected")
+	}
+}
+
+func TestCanonicalBlockList_TruncatesToMaxBatchSize(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ss := setupService(db)
+	ss.maxBatchedBlocks = 2
+
+	// Construct the chain B1 - B2 - B3 - B4 with B1 finalized.
+	parentRoot := []byte{'A'}
+	var blocks []*pb.BeaconBlock
+	var roots [][32]byte
+	for slot := uint64(1); slot <= 4; slot++ {
+		block := &pb.BeaconBlock{Slot: slot, ParentRootHash32: parentRoot}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatalf("Could not hash block: %v", err)
+		}
+		if err := ss.db.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+		blocks = append(blocks, block)
+		roots = append(roots, root)
+		parentRoot = root[:]
+	}
+
+	list, err := ss.respondBatchedBlocks(context.Background(), roots[0][:], roots[3][:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The blocks right after the finalized block are returned so the requester can continue from them.
+	wantList := []*pb.BeaconBlock{blocks[1], blocks[2]}
+	if !reflect.DeepEqual(list, wantList) {
+		t.Errorf("Wanted blocks %v, received %v", wantList, list)
+	}
+}
+
+func TestHandleBlockRequestByHash_RateLimited(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	sender := &mockP2P{}
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		ChainService:      &mockChainService{},
+		P2P:               sender,
+		BeaconDB:          db,
+		BlockReqRateLimit: 0.001,
+		BlockReqBurst:     1,
+	})
+
+	block := &pb.BeaconBlock{Slot: 1}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.BeaconBlockRequest{Hash: root[:]},
+		Peer: "a",
+	}
+
+	if err := ss.handleBlockRequestByHash(msg); err != nil {
+		t.Fatal(err)
+	}
+	sender.sentMsg = nil
+	if err := ss.handleBlockRequestByHash(msg); err != errRateLimited {
+		t.Errorf("Expected request over the rate limit to fail with %v, received %v", errRateLimited, err)
+	}
+	if sender.sentMsg != nil {
+		t.Error("Expected no response to a rate limited request")
+	}
+	want := []p2p.Behaviour{p2p.RepPenalityRateLimitExceeded}
+	if !reflect.DeepEqual(sender.behaviours, want) {
+		t.Errorf("Expected peer behaviours %v, received %v", want, sender.behaviours)
+	}
+}
+
+func TestHandleBatchedBlockRequest_OverMaxBatchSize(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	sender := &mockP2P{}
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		ChainService:     &mockChainService{},
+		P2P:              sender,
+		BeaconDB:         db,
+		MaxBatchedBlocks: 2,
+	})
+
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.BatchedBeaconBlockRequest{StartSlot: 1, EndSlot: 3},
+		Peer: "a",
+	}
+	if err := ss.handleBatchedBlockRequest(msg); err == nil {
+		t.Error("Expected a request over the maximum batch size to be rejected")
+	}
+	if sender.sentMsg != nil {
+		t.Errorf("Expected no response to an oversized batch request, received %v", sender.sentMsg)
+	}
+	if len(sender.behaviours) != 0 {
+		t.Errorf("Expected the peer not to be penalized, received %v", sender.behaviours)
+	}
+}
+
+func TestRegularSync_PauseAndResume(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ss := setupService(db)
+	defer ss.Stop()
+
+	ss.Start()
+	if ss.runCancel == nil {
+		t.Fatal("Expected regular sync to be running after start")
+	}
+	ss.Pause()
+	if ss.runCancel != nil {
+		t.Fatal("Expected regular sync to be paused")
+	}
+	// Pausing twice is a no-op.
+	ss.Pause()
+	ss.ResumeSync()
+	if ss.runCancel == nil {
+		t.Fatal("Expected regular sync to be running after resuming")
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
@@ -19,16 +22,35 @@ type Service struct {
 	InitialSync     *initialsync.InitialSync
 	Querier         *Querier
 	querierFinished bool
+	ctx             context.Context
+	p2p             p2pAPI
+	db              db.Database
+	initialSyncCfg  *initialsync.Config
+	fallBehindSlots uint64
+	initialSyncLock sync.RWMutex
+	initialSyncing  bool
 }
 
 // Config defines the configured services required for sync to work.
 type Config struct {
 	ChainService     chainService
-	BeaconDB         *db.BeaconDB
+	BeaconDB         db.Database
 	P2P              p2pAPI
 	AttsService      attsService
 	OperationService operations.OperationFeeds
 	PowChainService  powChainService
+	CheckpointRoot   []byte
+	CheckpointState  *pb.BeaconState
+	FallBehindSlots  uint64
+	// Limits of the requests served to each peer, zero values keep the defaults of
+	// DefaultRegularSyncConfig.
+	BlockReqRateLimit   float64
+	BlockReqBurst       int
+	BatchedReqRateLimit float64
+	BatchedReqBurst     int
+	StateReqRateLimit   float64
+	StateReqBurst       int
+	MaxBatchedBlocks    uint64
 }
 
 // NewSyncService creates a new instance of SyncService using the config
@@ -45,6 +67,8 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	isCfg.P2P = cfg.P2P
 	isCfg.PowChain = cfg.PowChainService
 	isCfg.ChainService = cfg.ChainService
+	isCfg.CheckpointRoot = cfg.CheckpointRoot
+	isCfg.CheckpointState = cfg.CheckpointState
 
 	rsCfg := DefaultRegularSyncConfig()
 	rsCfg.ChainService = cfg.ChainService
@@ -52,6 +76,27 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	rsCfg.P2P = cfg.P2P
 	rsCfg.AttsService = cfg.AttsService
 	rsCfg.OperationService = cfg.OperationService
+	if cfg.BlockReqRateLimit != 0 {
+		rsCfg.BlockReqRateLimit = cfg.BlockReqRateLimit
+	}
+	if cfg.BlockReqBurst != 0 {
+		rsCfg.BlockReqBurst = cfg.BlockReqBurst
+	}
+	if cfg.BatchedReqRateLimit != 0 {
+		rsCfg.BatchedReqRateLimit = cfg.BatchedReqRateLimit
+	}
+	if cfg.BatchedReqBurst != 0 {
+		rsCfg.BatchedReqBurst = cfg.BatchedReqBurst
+	}
+	if cfg.StateReqRateLimit != 0 {
+		rsCfg.StateReqRateLimit = cfg.StateReqRateLimit
+	}
+	if cfg.StateReqBurst != 0 {
+		rsCfg.StateReqBurst = cfg.StateReqBurst
+	}
+	if cfg.MaxBatchedBlocks != 0 {
+		rsCfg.MaxBatchedBlocks = cfg.MaxBatchedBlocks
+	}
 
 	sq := NewQuerierService(ctx, sqCfg)
 	rs := NewRegularSyncService(ctx, rsCfg)
@@ -59,11 +104,21 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	isCfg.SyncService = rs
 	is := initialsync.NewInitialSyncService(ctx, isCfg)
 
+	fallBehindSlots := cfg.FallBehindSlots
+	if fallBehindSlots == 0 {
+		fallBehindSlots = defaultFallBehindEpochs * params.BeaconConfig().SlotsPerEpoch
+	}
+
 	return &Service{
 		RegularSync:     rs,
 		InitialSync:     is,
 		Querier:         sq,
 		querierFinished: false,
+		ctx:             ctx,
+		p2p:             cfg.P2P,
+		db:              cfg.BeaconDB,
+		initialSyncCfg:  isCfg,
+		fallBehindSlots: fallBehindSlots,
 	}
 
 }
@@ -82,7 +137,7 @@ func (ss *Service) Stop() error {
 		return err
 	}
 
-	err = ss.InitialSync.Stop()
+	err = ss.initialSync().Stop()
 	if err != nil {
 		return err
 	}
@@ -111,7 +166,7 @@ func (ss *Service) Status() error {
 	if blk == nil {
 		return errors.New("no chain head exists in db")
 	}
-	if !ss.InitialSync.NodeIsSynced() {
+	if !ss.initialSync().NodeIsSynced() {
 		return errors.New("not initially synced")
 	}
 	return nil
@@ -125,10 +180,31 @@ func (ss *Service) run() {
 	}
 	ss.querierFinished = true
 
+	go ss.monitorHead()
+
 	if synced {
 		ss.RegularSync.Start()
 		return
 	}
 
+	ss.initialSyncLock.Lock()
+	ss.initialSyncing = true
+	ss.initialSyncLock.Unlock()
 	ss.InitialSync.Start(ss.Querier.chainHeadResponses)
+	go ss.awaitInitialSync(ss.InitialSync)
+}
+
+// initialSync returns the current initial sync instance, which is replaced
+// each time the node falls behind and re-enters initial sync.
+func (ss *Service) initialSync() *initialsync.InitialSync {
+	ss.initialSyncLock.RLock()
+	defer ss.initialSyncLock.RUnlock()
+	return ss.InitialSync
+}
+
+// isInitialSyncing reports whether an initial sync was started and has not exited yet.
+func (ss *Service) isInitialSyncing() bool {
+	ss.initialSyncLock.RLock()
+	defer ss.initialSyncLock.RUnlock()
+	return ss.initialSyncing && !ss.InitialSync.NodeIsSynced()
 }
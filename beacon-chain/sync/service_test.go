@@ -23,7 +23,9 @@ func NotSyncQuerierConfig() *QuerierConfig {
 }
 
 func init() {
-	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+		DisableSignatureVerification: true,
+	})
 }
 
 func initializeTestSyncService(ctx context.Context, cfg *Config, synced bool) *Service {
@@ -98,3 +100,25 @@ func TestStatus_NotSynced(t *testing.T) {
 		t.Error("Wanted false, but got true")
 	}
 }
+
+func TestNewSyncService_RequestLimits(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	ss := NewSyncService(context.Background(), &Config{
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+		MaxBatchedBlocks: 16,
+	})
+	if ss.RegularSync.maxBatchedBlocks != 16 {
+		t.Errorf("Wanted a maximum of 16 batched blocks, received %d", ss.RegularSync.maxBatchedBlocks)
+	}
+
+	ss = NewSyncService(context.Background(), &Config{
+		P2P:      &mockP2P{},
+		BeaconDB: db,
+	})
+	if ss.RegularSync.maxBatchedBlocks != DefaultRegularSyncConfig().MaxBatchedBlocks {
+		t.Errorf("Wanted the default maximum of batched blocks, received %d", ss.RegularSync.maxBatchedBlocks)
+	}
+}
@@ -0,0 +1,221 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// handleProposerSlashingAnnouncement requests the announced proposer slashing
+// from the sending peer if it is not in the operations pool yet.
+func (rs *RegularSync) handleProposerSlashingAnnouncement(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleProposerSlashingAnnouncement")
+	defer span.End()
+	data, ok := msg.Data.(*pb.ProposerSlashingAnnounce)
+	if !ok {
+		log.Error("Message is of the incorrect type")
+		return errors.New("incoming message is not of type *pb.ProposerSlashingAnnounce")
+	}
+
+	hasSlashing := rs.db.HasProposerSlashing(bytesutil.ToBytes32(data.Hash))
+	span.AddAttributes(trace.BoolAttribute("hasSlashing", hasSlashing))
+	if hasSlashing {
+		return nil
+	}
+
+	log.WithField("peer", msg.Peer).Debug("Sending request for proposer slashing")
+	if err := rs.p2p.Send(ctx, &pb.ProposerSlashingRequest{Hash: data.Hash}, msg.Peer); err != nil {
+		log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// handleProposerSlashingRequestByHash sends the requested proposer slashing
+// to the peer if it is kept in the operations pool.
+func (rs *RegularSync) handleProposerSlashingRequestByHash(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleProposerSlashingRequestByHash")
+	defer span.End()
+	proposerSlashingReq.Inc()
+
+	req := msg.Data.(*pb.ProposerSlashingRequest)
+	root := bytesutil.ToBytes32(req.Hash)
+	slashing, err := rs.db.ProposerSlashing(root)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if slashing == nil {
+		log.WithField("slashingRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).
+			Debug("Proposer slashing not in db")
+		return nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"slashingRoot": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+		"peer":         msg.Peer},
+	).Debug("Sending proposer slashing to peer")
+	if err := rs.p2p.Send(ctx, &pb.ProposerSlashingResponse{
+		Hash:             root[:],
+		ProposerSlashing: slashing,
+	}, msg.Peer); err != nil {
+		log.Error(err)
+		return err
+	}
+	sentProposerSlashing.Inc()
+	return nil
+}
+
+// receiveProposerSlashing validates a proposer slashing received from a peer
+// against the head state and forwards it to the operations pool, which announces it
+// to peers once it is saved.
+func (rs *RegularSync) receiveProposerSlashing(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveProposerSlashing")
+	defer span.End()
+	recProposerSlashing.Inc()
+
+	slashing := msg.Data.(*pb.ProposerSlashingResponse).ProposerSlashing
+	if slashing == nil {
+		rs.p2p.Reputation(msg.Peer, p2p.RepPenalityInvalidSlashing)
+		return errors.New("received empty proposer slashing")
+	}
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		log.Errorf("Could not hash received proposer slashing: %v", err)
+		return err
+	}
+	hasSlashing := rs.db.HasProposerSlashing(root)
+	span.AddAttributes(trace.BoolAttribute("hasSlashing", hasSlashing))
+	if hasSlashing {
+		log.WithField("slashingRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).
+			Debug("Received, skipping proposer slashing")
+		return nil
+	}
+
+	headState, err := rs.db.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if err := blocks.VerifyProposerSlashing(headState, slashing, !featureconfig.FeatureConfig().DisableSignatureVerification); err != nil {
+		log.WithError(err).WithField("peer", msg.Peer).Debug("Received invalid proposer slashing")
+		rs.p2p.Reputation(msg.Peer, p2p.RepPenalityInvalidSlashing)
+		return err
+	}
+
+	log.WithField("proposerIndex", slashing.ProposerIndex).
+		Debug("Forwarding proposer slashing to subscribed services")
+	rs.operationsService.IncomingProposerSlashingFeed().Send(slashing)
+	rs.p2p.Reputation(msg.Peer, p2p.RepRewardValidSlashing)
+	return nil
+}
+
+// handleAttesterSlashingAnnouncement requests the announced attester slashing
+// from the sending peer if it is not in the operations pool yet.
+func (rs *RegularSync) handleAttesterSlashingAnnouncement(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleAttesterSlashingAnnouncement")
+	defer span.End()
+	data, ok := msg.Data.(*pb.AttesterSlashingAnnounce)
+	if !ok {
+		log.Error("Message is of the incorrect type")
+		return errors.New("incoming message is not of type *pb.AttesterSlashingAnnounce")
+	}
+
+	hasSlashing := rs.db.HasAttesterSlashing(bytesutil.ToBytes32(data.Hash))
+	span.AddAttributes(trace.BoolAttribute("hasSlashing", hasSlashing))
+	if hasSlashing {
+		return nil
+	}
+
+	log.WithField("peer", msg.Peer).Debug("Sending request for attester slashing")
+	if err := rs.p2p.Send(ctx, &pb.AttesterSlashingRequest{Hash: data.Hash}, msg.Peer); err != nil {
+		log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// handleAttesterSlashingRequestByHash sends the requested attester slashing
+// to the peer if it is kept in the operations pool.
+func (rs *RegularSync) handleAttesterSlashingRequestByHash(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleAttesterSlashingRequestByHash")
+	defer span.End()
+	attesterSlashingReq.Inc()
+
+	req := msg.Data.(*pb.AttesterSlashingRequest)
+	root := bytesutil.ToBytes32(req.Hash)
+	slashing, err := rs.db.AttesterSlashing(root)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if slashing == nil {
+		log.WithField("slashingRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).
+			Debug("Attester slashing not in db")
+		return nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"slashingRoot": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+		"peer":         msg.Peer},
+	).Debug("Sending attester slashing to peer")
+	if err := rs.p2p.Send(ctx, &pb.AttesterSlashingResponse{
+		Hash:             root[:],
+		AttesterSlashing: slashing,
+	}, msg.Peer); err != nil {
+		log.Error(err)
+		return err
+	}
+	sentAttesterSlashing.Inc()
+	return nil
+}
+
+// receiveAttesterSlashing validates an attester slashing received from a peer
+// against the head state and forwards it to the operations pool, which announces it
+// to peers once it is saved.
+func (rs *RegularSync) receiveAttesterSlashing(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveAttesterSlashing")
+	defer span.End()
+	recAttesterSlashing.Inc()
+
+	slashing := msg.Data.(*pb.AttesterSlashingResponse).AttesterSlashing
+	if slashing == nil {
+		rs.p2p.Reputation(msg.Peer, p2p.RepPenalityInvalidSlashing)
+		return errors.New("received empty attester slashing")
+	}
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		log.Errorf("Could not hash received attester slashing: %v", err)
+		return err
+	}
+	hasSlashing := rs.db.HasAttesterSlashing(root)
+	span.AddAttributes(trace.BoolAttribute("hasSlashing", hasSlashing))
+	if hasSlashing {
+		log.WithField("slashingRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).
+			Debug("Received, skipping attester slashing")
+		return nil
+	}
+
+	headState, err := rs.db.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if err := blocks.VerifyAttesterSlashing(headState, slashing, !featureconfig.FeatureConfig().DisableSignatureVerification); err != nil {
+		log.WithError(err).WithField("peer", msg.Peer).Debug("Received invalid attester slashing")
+		rs.p2p.Reputation(msg.Peer, p2p.RepPenalityInvalidSlashing)
+		return err
+	}
+
+	log.WithField("slashingRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).
+		Debug("Forwarding attester slashing to subscribed services")
+	rs.operationsService.IncomingAttesterSlashingFeed().Send(slashing)
+	rs.p2p.Reputation(msg.Peer, p2p.RepRewardValidSlashing)
+	return nil
+}
@@ -0,0 +1,156 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestHandleProposerSlashingAnnouncement_RequestsUnknownSlashing(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	slashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := &mockP2P{}
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		OperationService: &mockOperationService{},
+		P2P:              sender,
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	})
+
+	if err := ss.handleProposerSlashingAnnouncement(p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.ProposerSlashingAnnounce{Hash: hash[:]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	req, ok := sender.sentMsg.(*pb.ProposerSlashingRequest)
+	if !ok {
+		t.Fatalf("Expected a proposer slashing request to be sent, received %v", sender.sentMsg)
+	}
+	if bytesutil.ToBytes32(req.Hash) != hash {
+		t.Error("Request did not include the announced hash")
+	}
+
+	// Once the slashing is known, announcements are ignored.
+	sender.sentMsg = nil
+	if err := db.SaveProposerSlashing(context.Background(), slashing); err != nil {
+		t.Fatal(err)
+	}
+	if err := ss.handleProposerSlashingAnnouncement(p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.ProposerSlashingAnnounce{Hash: hash[:]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if sender.sentMsg != nil {
+		t.Error("Expected no request for a known slashing")
+	}
+}
+
+func TestHandleAttesterSlashingRequest_SendsSlashing(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashing(context.Background(), slashing); err != nil {
+		t.Fatal(err)
+	}
+	sender := &mockP2P{}
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		OperationService: &mockOperationService{},
+		P2P:              sender,
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	})
+
+	if err := ss.handleAttesterSlashingRequestByHash(p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.AttesterSlashingRequest{Hash: hash[:]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	resp, ok := sender.sentMsg.(*pb.AttesterSlashingResponse)
+	if !ok {
+		t.Fatalf("Expected an attester slashing response to be sent, received %v", sender.sentMsg)
+	}
+	if !proto.Equal(resp.AttesterSlashing, slashing) {
+		t.Errorf("Wanted %v, received %v", slashing, resp.AttesterSlashing)
+	}
+}
+
+func TestReceiveProposerSlashing_RejectsInvalid(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	validators := make([]*pb.Validator, 2)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{SlashedEpoch: params.BeaconConfig().FarFutureEpoch}
+	}
+	if err := db.SaveState(context.Background(), &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: validators,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sender := &mockP2P{}
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		OperationService: &mockOperationService{},
+		P2P:              sender,
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	})
+
+	invalid := &pb.ProposerSlashing{
+		ProposerIndex:  1,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 1},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 2},
+	}
+	if err := ss.receiveProposerSlashing(p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.ProposerSlashingResponse{ProposerSlashing: invalid},
+	}); err == nil {
+		t.Error("Expected slashing with mismatching slots to be rejected")
+	}
+	if sender.broadcastMsg != nil {
+		t.Error("Expected invalid slashing not to be relayed")
+	}
+
+	valid := &pb.ProposerSlashing{
+		ProposerIndex:  1,
+		ProposalData_1: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{1}},
+		ProposalData_2: &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{2}},
+	}
+	if err := ss.receiveProposerSlashing(p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.ProposerSlashingResponse{ProposerSlashing: valid},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Forwarding proposer slashing to subscribed services")
+	// The operations pool announces the slashing once it is saved.
+	if sender.broadcastMsg != nil {
+		t.Errorf("Expected valid slashing to be left to the operations pool to announce, received %v", sender.broadcastMsg)
+	}
+}
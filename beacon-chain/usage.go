@@ -78,11 +78,27 @@ var appHelpFlagGroups = []flagGroup{
 			utils.DepositContractFlag,
 			utils.Web3ProviderFlag,
 			utils.RPCPort,
+			utils.AdminRPCPort,
 			utils.CertFlag,
 			utils.KeyFlag,
 			utils.EnableDBCleanup,
 			utils.GRPCGatewayPort,
 			utils.HTTPWeb3ProviderFlag,
+			utils.ArchiveFlag,
+			utils.ArchiveCheckpointIntervalFlag,
+			utils.StateSnapshotIntervalFlag,
+			utils.ForkChoiceDumpDirFlag,
+			utils.SlasherFlag,
+			utils.SlasherHistoryFlag,
+			utils.CheckpointRootFlag,
+			utils.CheckpointStateFileFlag,
+			utils.BlockRequestRateLimitFlag,
+			utils.BlockRequestBurstFlag,
+			utils.BatchedBlockRequestRateLimitFlag,
+			utils.BatchedBlockRequestBurstFlag,
+			utils.StateRequestRateLimitFlag,
+			utils.StateRequestBurstFlag,
+			utils.MaxBatchedBlocksFlag,
 		},
 	},
 	{
@@ -92,6 +108,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMaxPeers,
 			cmd.P2PPrivKey,
 			cmd.P2PWhitelist,
+			cmd.P2PBanThreshold,
+			cmd.P2PBanDuration,
+			cmd.P2PScoreHalfLife,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 		},
@@ -33,6 +33,11 @@ var (
 		Usage: "RPC port exposed by a beacon node",
 		Value: 4000,
 	}
+	// AdminRPCPort defines the port of the admin RPC service, which is only served on localhost.
+	AdminRPCPort = cli.IntFlag{
+		Name:  "admin-rpc-port",
+		Usage: "Port of the admin RPC service exposing the p2p internals of the node on localhost. The service is disabled by default.",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = cli.StringFlag{
 		Name:  "tls-cert",
@@ -48,6 +53,91 @@ var (
 		Name:  "enable-db-cleanup",
 		Usage: "Enable automatic DB cleanup routine",
 	}
+	// ArchiveFlag keeps canonical blocks and periodic finalized state checkpoints in the database
+	// to regenerate the state at any slot.
+	ArchiveFlag = cli.BoolFlag{
+		Name:  "archive",
+		Usage: "Run the beacon node in archive mode, keeping state checkpoints to serve the state at any slot",
+	}
+	// ArchiveCheckpointIntervalFlag defines the number of epochs between archived state checkpoints.
+	ArchiveCheckpointIntervalFlag = cli.Uint64Flag{
+		Name:  "archive-checkpoint-interval",
+		Usage: "Number of epochs between archived state checkpoints, lower values use more disk and replay fewer blocks",
+		Value: 8,
+	}
+	// StateSnapshotIntervalFlag defines the number of epochs between full historical state snapshots.
+	StateSnapshotIntervalFlag = cli.Uint64Flag{
+		Name:  "state-snapshot-interval",
+		Usage: "Number of epochs between full historical state snapshots, states in between are stored as diffs. Higher values use less disk and take longer to read",
+		Value: 8,
+	}
+	// ForkChoiceDumpDirFlag defines a directory where the fork choice store is dumped on every reorg.
+	ForkChoiceDumpDirFlag = cli.StringFlag{
+		Name:  "fork-choice-dump-dir",
+		Usage: "Directory to write a JSON and Graphviz snapshot of the fork choice store to each time a reorg happens",
+	}
+	// SlasherFlag runs a slasher which watches attestations and blocks for slashable offences.
+	SlasherFlag = cli.BoolFlag{
+		Name:  "slasher",
+		Usage: "Watch received attestations and blocks for double votes, surround votes and double proposals and submit slashings for them. The slasher history is only kept in memory, so offences against votes seen before a restart are not detected",
+	}
+	// SlasherHistoryFlag defines the number of epochs of attestations kept by the slasher.
+	SlasherHistoryFlag = cli.Uint64Flag{
+		Name:  "slasher-history-epochs",
+		Usage: "Number of epochs the slasher looks back over when detecting surround votes. The history takes 4 bytes of memory per epoch for every validator which attested",
+		Value: 4096,
+	}
+	// CheckpointRootFlag pins the root of a finalized block the node trusts when syncing.
+	CheckpointRootFlag = cli.StringFlag{
+		Name:  "checkpoint-root",
+		Usage: "Hex encoded root of a trusted finalized block, checked against the state given with --checkpoint-state-file. Peers which conflict with it are rejected",
+	}
+	// CheckpointStateFileFlag defines a file holding the trusted finalized state to start syncing from.
+	CheckpointStateFileFlag = cli.StringFlag{
+		Name:  "checkpoint-state-file",
+		Usage: "Path to a protobuf encoded finalized beacon state to start syncing from instead of requesting one from peers",
+	}
+	// BlockRequestRateLimitFlag defines how many block requests by hash each peer may send per second.
+	BlockRequestRateLimitFlag = cli.Float64Flag{
+		Name:  "block-request-rate-limit",
+		Usage: "Number of block requests by hash served per second to each peer",
+		Value: 10,
+	}
+	// BlockRequestBurstFlag defines how many block requests by hash a peer may send at once.
+	BlockRequestBurstFlag = cli.IntFlag{
+		Name:  "block-request-burst",
+		Usage: "Number of block requests by hash a peer may send at once over the rate limit",
+		Value: 50,
+	}
+	// BatchedBlockRequestRateLimitFlag defines how many batched block requests each peer may send per second.
+	BatchedBlockRequestRateLimitFlag = cli.Float64Flag{
+		Name:  "batched-block-request-rate-limit",
+		Usage: "Number of batched block requests served per second to each peer",
+		Value: 2,
+	}
+	// BatchedBlockRequestBurstFlag defines how many batched block requests a peer may send at once.
+	BatchedBlockRequestBurstFlag = cli.IntFlag{
+		Name:  "batched-block-request-burst",
+		Usage: "Number of batched block requests a peer may send at once over the rate limit",
+		Value: 10,
+	}
+	// StateRequestRateLimitFlag defines how many finalized state requests each peer may send per second.
+	StateRequestRateLimitFlag = cli.Float64Flag{
+		Name:  "state-request-rate-limit",
+		Usage: "Number of finalized state requests served per second to each peer",
+		Value: 1.0 / 60,
+	}
+	// StateRequestBurstFlag defines how many finalized state requests a peer may send at once.
+	StateRequestBurstFlag = cli.IntFlag{
+		Name:  "state-request-burst",
+		Usage: "Number of finalized state requests a peer may send at once over the rate limit",
+		Value: 2,
+	}
+	// MaxBatchedBlocksFlag defines the maximum number of blocks in a batched block response.
+	MaxBatchedBlocksFlag = cli.Uint64Flag{
+		Name:  "max-batched-blocks",
+		Usage: "Maximum number of blocks sent in response to a batched block request, longer slot range requests are rejected and others truncated. Defaults to the batch block limit of the beacon chain config",
+	}
 	// GRPCGatewayPort enables a gRPC gateway to be exposed for Prysm.
 	GRPCGatewayPort = cli.IntFlag{
 		Name:  "grpc-gateway-port",
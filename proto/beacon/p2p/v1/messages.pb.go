@@ -41,6 +41,13 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_PROPOSER_SLASHING_ANNOUNCE          Topic = 15
+	Topic_PROPOSER_SLASHING_REQUEST           Topic = 16
+	Topic_PROPOSER_SLASHING_RESPONSE          Topic = 17
+	Topic_ATTESTER_SLASHING_ANNOUNCE          Topic = 18
+	Topic_ATTESTER_SLASHING_REQUEST           Topic = 19
+	Topic_ATTESTER_SLASHING_RESPONSE          Topic = 20
+	Topic_VOLUNTARY_EXIT                      Topic = 21
 )
 
 var Topic_name = map[int32]string{
@@ -59,6 +66,13 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "PROPOSER_SLASHING_ANNOUNCE",
+	16: "PROPOSER_SLASHING_REQUEST",
+	17: "PROPOSER_SLASHING_RESPONSE",
+	18: "ATTESTER_SLASHING_ANNOUNCE",
+	19: "ATTESTER_SLASHING_REQUEST",
+	20: "ATTESTER_SLASHING_RESPONSE",
+	21: "VOLUNTARY_EXIT",
 }
 
 var Topic_value = map[string]int32{
@@ -77,6 +91,13 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"PROPOSER_SLASHING_ANNOUNCE":          15,
+	"PROPOSER_SLASHING_REQUEST":           16,
+	"PROPOSER_SLASHING_RESPONSE":          17,
+	"ATTESTER_SLASHING_ANNOUNCE":          18,
+	"ATTESTER_SLASHING_REQUEST":           19,
+	"ATTESTER_SLASHING_RESPONSE":          20,
+	"VOLUNTARY_EXIT":                      21,
 }
 
 func (x Topic) String() string {
@@ -355,8 +376,8 @@ func (m *BeaconBlockResponse) GetAttestation() *Attestation {
 }
 
 type BatchedBeaconBlockRequest struct {
-	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"` // Deprecated: Do not use.
-	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`       // Deprecated: Do not use.
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
 	FinalizedRoot        []byte   `protobuf:"bytes,3,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
 	CanonicalRoot        []byte   `protobuf:"bytes,4,opt,name=canonical_root,json=canonicalRoot,proto3" json:"canonical_root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -397,7 +418,6 @@ func (m *BatchedBeaconBlockRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BatchedBeaconBlockRequest proto.InternalMessageInfo
 
-// Deprecated: Do not use.
 func (m *BatchedBeaconBlockRequest) GetStartSlot() uint64 {
 	if m != nil {
 		return m.StartSlot
@@ -405,7 +425,6 @@ func (m *BatchedBeaconBlockRequest) GetStartSlot() uint64 {
 	return 0
 }
 
-// Deprecated: Do not use.
 func (m *BatchedBeaconBlockRequest) GetEndSlot() uint64 {
 	if m != nil {
 		return m.EndSlot
@@ -1624,74 +1643,78 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_a1d590cda035b632) }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 1068 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0xe3, 0x44,
-	0x10, 0x46, 0xf9, 0xd9, 0xc4, 0x6d, 0xc7, 0xeb, 0x9d, 0x84, 0xc4, 0x09, 0x9b, 0x3f, 0x2d, 0x29,
-	0x02, 0x55, 0x6b, 0xef, 0x66, 0x2f, 0xbb, 0x07, 0x8a, 0x92, 0x1c, 0x51, 0xde, 0xdd, 0x20, 0x2f,
-	0xb2, 0x0d, 0xc5, 0x49, 0x8c, 0xa5, 0xd9, 0xd8, 0xac, 0xa3, 0x11, 0x9a, 0x71, 0x2a, 0xe1, 0xc6,
-	0x81, 0x67, 0xe0, 0xc6, 0x8d, 0x13, 0x2f, 0xc2, 0x91, 0x47, 0xa0, 0xf2, 0x24, 0x94, 0x66, 0x46,
-	0xb2, 0xfc, 0x13, 0x25, 0x07, 0x6e, 0x56, 0xf7, 0xd7, 0x5f, 0xf7, 0xf7, 0xcd, 0xf4, 0x94, 0x41,
-	0x0f, 0x23, 0xca, 0x69, 0xbd, 0x47, 0xb0, 0x47, 0x83, 0x7a, 0x78, 0x12, 0xd6, 0x2f, 0x9f, 0xd7,
-	0x2f, 0x08, 0x63, 0xf8, 0x9c, 0xb0, 0x9a, 0x48, 0xa2, 0x4d, 0xc2, 0xfb, 0x24, 0x22, 0xa3, 0x8b,
-	0x9a, 0x84, 0xd5, 0xc2, 0x93, 0xb0, 0x76, 0xf9, 0x7c, 0x67, 0x7f, 0x5e, 0x2d, 0xbf, 0x0e, 0x93,
-	0xc2, 0x9d, 0xfd, 0x73, 0x4a, 0xcf, 0x87, 0xa4, 0x2e, 0xbe, 0x7a, 0xa3, 0xf7, 0x75, 0x3e, 0xb8,
-	0x20, 0x8c, 0xe3, 0x8b, 0x50, 0x02, 0xf4, 0x5f, 0x35, 0x58, 0xb5, 0x82, 0x4b, 0x32, 0xa4, 0x21,
-	0x41, 0x87, 0x50, 0x62, 0x21, 0x0e, 0x5c, 0x8f, 0x06, 0x9c, 0x5c, 0xf1, 0xaa, 0x76, 0xa0, 0x1d,
-	0x97, 0x9c, 0x62, 0x1c, 0x6b, 0xc8, 0x10, 0xaa, 0xc2, 0x4a, 0x88, 0xaf, 0x87, 0x14, 0xfb, 0xd5,
-	0x05, 0x91, 0x4d, 0x3e, 0xd1, 0x4b, 0x28, 0xa4, 0xe4, 0xd5, 0xc5, 0x03, 0xed, 0xb8, 0x78, 0xb2,
-	0x53, 0x93, 0xed, 0x6b, 0x49, 0xfb, 0x5a, 0x27, 0x41, 0x38, 0x63, 0xb0, 0xfe, 0x06, 0xd6, 0x4d,
-	0xa1, 0xc0, 0x1c, 0x52, 0xef, 0x83, 0x11, 0x04, 0x74, 0x14, 0x78, 0x04, 0x21, 0x58, 0xea, 0x63,
-	0xd6, 0x57, 0x53, 0x88, 0xdf, 0x68, 0x1f, 0x8a, 0x6c, 0x48, 0xb9, 0x1b, 0x8c, 0x2e, 0x7a, 0x24,
-	0x12, 0x23, 0x2c, 0x39, 0x10, 0x87, 0x6c, 0x11, 0xd1, 0x8f, 0x01, 0x65, 0xb8, 0x1c, 0xf2, 0xf3,
-	0x88, 0x30, 0x3e, 0x8f, 0x4a, 0x37, 0x60, 0x6f, 0x16, 0x69, 0x5e, 0xb7, 0x53, 0xae, 0xe9, 0x66,
-	0xda, 0x4c, 0xb3, 0xdf, 0xb5, 0x89, 0xc9, 0x1d, 0xc2, 0x42, 0x1a, 0x30, 0x82, 0x5e, 0xc1, 0x72,
-	0x2f, 0x0e, 0x88, 0x92, 0xe2, 0xc9, 0x93, 0xda, 0xfc, 0xe3, 0xab, 0x65, 0x6b, 0x65, 0x05, 0xb2,
-	0xa0, 0x88, 0x39, 0x8f, 0x8d, 0xe1, 0x03, 0x1a, 0x08, 0x81, 0x39, 0x04, 0xc6, 0x18, 0xea, 0x64,
-	0xeb, 0xf4, 0xbf, 0x34, 0xd8, 0x36, 0x31, 0xf7, 0xfa, 0xc4, 0x9f, 0x63, 0xc7, 0x21, 0x00, 0xe3,
-	0x38, 0xe2, 0x6e, 0xac, 0x45, 0xea, 0x32, 0x17, 0xaa, 0x9a, 0x53, 0x10, 0xd1, 0xd8, 0x01, 0xb4,
-	0x0b, 0xab, 0x24, 0xf0, 0x25, 0x60, 0x21, 0x05, 0xac, 0x90, 0xc0, 0x17, 0xe9, 0x23, 0x28, 0xbf,
-	0x1f, 0x04, 0x78, 0x38, 0xf8, 0x85, 0xf8, 0x6e, 0x44, 0x29, 0x17, 0x27, 0x5e, 0x72, 0xd6, 0xd2,
-	0xa8, 0x43, 0x25, 0xcc, 0xc3, 0x01, 0x0d, 0x06, 0x1e, 0x1e, 0x4a, 0xd8, 0x92, 0x84, 0xa5, 0xd1,
-	0x18, 0xa6, 0xf7, 0x61, 0x67, 0xde, 0xb0, 0xca, 0xcd, 0x37, 0x50, 0xee, 0xc9, 0xac, 0x2b, 0x3c,
-	0x62, 0x55, 0xed, 0x60, 0xf1, 0xbe, 0xb6, 0xae, 0xa9, 0x52, 0xf1, 0xc5, 0x74, 0x04, 0x95, 0x46,
-	0x1f, 0x0f, 0x82, 0x26, 0xc1, 0xbe, 0x72, 0x43, 0xff, 0x63, 0x01, 0x1e, 0x65, 0x82, 0xaa, 0xeb,
-	0xc4, 0xe8, 0x63, 0x9f, 0x32, 0xa3, 0x0b, 0x23, 0xbe, 0x84, 0x4f, 0x32, 0x30, 0x8e, 0x39, 0x11,
-	0x3a, 0xdd, 0xf8, 0x8a, 0xbd, 0x38, 0x51, 0x3b, 0x52, 0x1d, 0xd7, 0xc4, 0x88, 0x58, 0x73, 0x53,
-	0xe4, 0xd1, 0x57, 0xf0, 0x78, 0xec, 0xe3, 0x4c, 0x39, 0x53, 0xae, 0x6e, 0xa7, 0x98, 0xa9, 0x7a,
-	0x86, 0x9e, 0xc1, 0xc6, 0xb8, 0xbf, 0xb0, 0x27, 0xeb, 0x33, 0x4a, 0x73, 0xd2, 0x8d, 0xf8, 0x4c,
-	0x9e, 0xc1, 0xc6, 0xb8, 0x65, 0xa6, 0x62, 0x59, 0x56, 0xa4, 0xb9, 0xb4, 0x42, 0x7f, 0x0a, 0x5b,
-	0xd2, 0x52, 0xd1, 0x3d, 0xee, 0x9c, 0xb7, 0xa3, 0x7a, 0x37, 0x59, 0x41, 0x39, 0xac, 0xba, 0x73,
-	0x77, 0x29, 0xd5, 0xee, 0x50, 0xaa, 0x7b, 0xc9, 0xae, 0x29, 0x5a, 0x75, 0x4e, 0x67, 0xf0, 0x70,
-	0x8a, 0xf7, 0x7e, 0x5b, 0x27, 0x59, 0xca, 0x93, 0xfd, 0xf4, 0xcf, 0x61, 0x3d, 0xb3, 0x53, 0xb9,
-	0x32, 0x8f, 0x01, 0x65, 0xd7, 0x2f, 0xe7, 0xa5, 0x09, 0x27, 0x48, 0xd3, 0xc9, 0xe7, 0xbd, 0x6f,
-	0xff, 0xd3, 0xfa, 0xff, 0x04, 0x9b, 0x5f, 0x4f, 0x08, 0x4b, 0x95, 0xec, 0x02, 0x64, 0xce, 0x5c,
-	0xb6, 0x2e, 0xf4, 0xd2, 0xcb, 0xb1, 0x2b, 0x5e, 0x06, 0x75, 0x36, 0xea, 0xf6, 0x16, 0x58, 0x72,
-	0x14, 0xf1, 0xc8, 0x62, 0x15, 0x16, 0xc5, 0x2a, 0x88, 0xdf, 0x7a, 0x0d, 0xaa, 0xef, 0x22, 0x1a,
-	0x52, 0x46, 0xa2, 0xf6, 0x10, 0xb3, 0xfe, 0x20, 0x38, 0xcf, 0xf5, 0xed, 0x29, 0x6c, 0x4d, 0xe3,
-	0xf3, 0xcc, 0xfb, 0x4d, 0x9b, 0xe5, 0xcf, 0xb5, 0xb0, 0x0b, 0x8f, 0x42, 0x85, 0x77, 0x99, 0x2a,
-	0x50, 0x46, 0x1e, 0xdf, 0x66, 0xe4, 0x4c, 0x83, 0x4a, 0x38, 0x15, 0x89, 0x65, 0x4a, 0xbb, 0xef,
-	0x2f, 0x73, 0x1a, 0x7f, 0x97, 0xcc, 0x59, 0x7c, 0xbe, 0xcc, 0x04, 0x7f, 0x6f, 0x99, 0x33, 0x0d,
-	0x2a, 0xd3, 0x11, 0xfd, 0x08, 0x1e, 0x9e, 0x92, 0x90, 0xb2, 0x01, 0xcf, 0x55, 0xf7, 0x29, 0x94,
-	0x15, 0x2c, 0x4f, 0xd4, 0x8f, 0x29, 0x59, 0xae, 0x94, 0x57, 0xb0, 0xe2, 0x4b, 0x98, 0x12, 0xb0,
-	0x7f, 0x9b, 0x80, 0x84, 0x2d, 0xc1, 0xeb, 0x3a, 0x94, 0xac, 0xab, 0x3b, 0x66, 0x3d, 0x84, 0x62,
-	0x8c, 0xc9, 0xdf, 0xd0, 0x92, 0x84, 0xe4, 0x4c, 0x79, 0x06, 0xe5, 0x4b, 0x3a, 0x1c, 0x05, 0x1c,
-	0x47, 0xd7, 0x2e, 0xb9, 0x4a, 0x87, 0x3d, 0xba, 0x6d, 0xd8, 0xef, 0x12, 0xb4, 0xa0, 0x5e, 0xbb,
-	0xcc, 0x7e, 0xea, 0x16, 0x14, 0x9a, 0x38, 0xf0, 0x59, 0x1f, 0x7f, 0x20, 0xe8, 0x25, 0x54, 0x95,
-	0x20, 0xf1, 0xd7, 0x2b, 0xc2, 0x1e, 0x77, 0xb1, 0xef, 0x47, 0x84, 0xc9, 0x77, 0xb1, 0xe0, 0x6c,
-	0xaa, 0x7c, 0x43, 0xa5, 0x0d, 0x99, 0xfd, 0xe2, 0xcf, 0x45, 0x58, 0xee, 0xd0, 0x70, 0xe0, 0xa1,
-	0x22, 0xac, 0x74, 0xed, 0xb7, 0x76, 0xeb, 0x7b, 0xbb, 0xf2, 0x11, 0xda, 0x86, 0x8f, 0x4d, 0xcb,
-	0x68, 0xb4, 0x6c, 0xd7, 0x3c, 0x6b, 0x35, 0xde, 0xba, 0x86, 0x6d, 0xb7, 0xba, 0x76, 0xc3, 0xaa,
-	0x68, 0xa8, 0x0a, 0x1b, 0x13, 0x29, 0xc7, 0xfa, 0xb6, 0x6b, 0xb5, 0x3b, 0x95, 0x05, 0xf4, 0x19,
-	0x3c, 0x99, 0x97, 0x71, 0xcd, 0x1f, 0xdc, 0xf6, 0x59, 0xab, 0xe3, 0xda, 0xdd, 0x6f, 0x4c, 0xcb,
-	0xa9, 0x2c, 0xce, 0xb0, 0x3b, 0x56, 0xfb, 0x5d, 0xcb, 0x6e, 0x5b, 0x95, 0x25, 0x74, 0x00, 0x8f,
-	0x4d, 0xa3, 0xd3, 0x68, 0x5a, 0xa7, 0xee, 0xdc, 0x2e, 0xcb, 0xe8, 0x10, 0x76, 0x6f, 0x41, 0x28,
-	0x92, 0x07, 0x68, 0x13, 0x50, 0xa3, 0x69, 0xbc, 0xb6, 0xdd, 0xa6, 0x65, 0x9c, 0xa6, 0xa5, 0x2b,
-	0x68, 0x0b, 0xd6, 0x27, 0xe2, 0xaa, 0x60, 0x15, 0xed, 0xc1, 0x8e, 0xe2, 0x6a, 0x77, 0x8c, 0x8e,
-	0xe5, 0x36, 0x8d, 0x76, 0x73, 0xac, 0xb9, 0x90, 0xd1, 0x2c, 0xf3, 0x09, 0x25, 0x64, 0xa4, 0x24,
-	0x19, 0x45, 0x5a, 0x8c, 0x8b, 0x8c, 0x4e, 0xc7, 0x8a, 0xe3, 0xaf, 0x5b, 0xf6, 0x98, 0xae, 0x14,
-	0xcf, 0x91, 0xcd, 0x24, 0x6c, 0x6b, 0xd3, 0x25, 0x29, 0x59, 0xd9, 0x2c, 0xfd, 0x7d, 0xb3, 0xa7,
-	0xfd, 0x73, 0xb3, 0xa7, 0xfd, 0x7b, 0xb3, 0xa7, 0xf5, 0x1e, 0x88, 0xff, 0xc3, 0x2f, 0xfe, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x3b, 0x46, 0x10, 0x57, 0xfb, 0x0b, 0x00, 0x00,
+	// 1125 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0xe2, 0x46,
+	0x17, 0xfd, 0xe4, 0x9f, 0xb1, 0xb9, 0x60, 0x8c, 0xdb, 0x1e, 0x1b, 0xfb, 0x1b, 0xff, 0x69, 0xe2,
+	0x8a, 0x93, 0xaa, 0x81, 0x19, 0xcf, 0x66, 0x66, 0x91, 0x4a, 0x09, 0xac, 0x04, 0xcf, 0x10, 0xe1,
+	0x08, 0x31, 0xc9, 0xac, 0x94, 0x06, 0x7a, 0x0c, 0x19, 0xac, 0x56, 0xe8, 0xc6, 0x65, 0x67, 0x97,
+	0x45, 0x9e, 0x21, 0xbb, 0x6c, 0xf2, 0x32, 0x59, 0xe6, 0x11, 0x52, 0x7e, 0x89, 0x6c, 0x53, 0xea,
+	0x6e, 0x09, 0x01, 0xb2, 0xec, 0x45, 0x76, 0xe8, 0xde, 0x73, 0xce, 0xbd, 0xe7, 0xb4, 0x5a, 0x05,
+	0xe8, 0xfe, 0x90, 0x72, 0x5a, 0x6e, 0x13, 0xdc, 0xa1, 0x5e, 0xd9, 0x3f, 0xf1, 0xcb, 0x57, 0x2f,
+	0xca, 0x97, 0x84, 0x31, 0x7c, 0x41, 0x58, 0x49, 0x34, 0xd1, 0x26, 0xe1, 0x3d, 0x32, 0x24, 0xa3,
+	0xcb, 0x92, 0x84, 0x95, 0xfc, 0x13, 0xbf, 0x74, 0xf5, 0x62, 0x67, 0x3f, 0x89, 0xcb, 0x6f, 0xfc,
+	0x90, 0xb8, 0xb3, 0x7f, 0x41, 0xe9, 0xc5, 0x80, 0x94, 0xc5, 0x53, 0x7b, 0xf4, 0xa1, 0xcc, 0xfb,
+	0x97, 0x84, 0x71, 0x7c, 0xe9, 0x4b, 0x80, 0xfe, 0x8b, 0x06, 0xcb, 0xa6, 0x77, 0x45, 0x06, 0xd4,
+	0x27, 0xe8, 0x10, 0x72, 0xcc, 0xc7, 0x9e, 0xdb, 0xa1, 0x1e, 0x27, 0xd7, 0xbc, 0xa8, 0x1d, 0x68,
+	0xc7, 0x39, 0x3b, 0x1b, 0xd4, 0xaa, 0xb2, 0x84, 0x8a, 0xb0, 0xe4, 0xe3, 0x9b, 0x01, 0xc5, 0xdd,
+	0xe2, 0x9c, 0xe8, 0x86, 0x8f, 0xe8, 0x15, 0x64, 0x22, 0xf1, 0xe2, 0xfc, 0x81, 0x76, 0x9c, 0x3d,
+	0xd9, 0x29, 0xc9, 0xf1, 0xa5, 0x70, 0x7c, 0xc9, 0x09, 0x11, 0xf6, 0x18, 0xac, 0xbf, 0x81, 0xf5,
+	0x8a, 0x70, 0x50, 0x19, 0xd0, 0xce, 0x47, 0xc3, 0xf3, 0xe8, 0xc8, 0xeb, 0x10, 0x84, 0x60, 0xa1,
+	0x87, 0x59, 0x4f, 0x6d, 0x21, 0x7e, 0xa3, 0x7d, 0xc8, 0xb2, 0x01, 0xe5, 0xae, 0x37, 0xba, 0x6c,
+	0x93, 0xa1, 0x58, 0x61, 0xc1, 0x86, 0xa0, 0x64, 0x89, 0x8a, 0x7e, 0x0c, 0x28, 0xa6, 0x65, 0x93,
+	0x9f, 0x46, 0x84, 0xf1, 0x24, 0x29, 0xdd, 0x80, 0xbd, 0x59, 0x64, 0xe5, 0xa6, 0x19, 0x69, 0x4d,
+	0x0f, 0xd3, 0x66, 0x86, 0xfd, 0xa6, 0x4d, 0x6c, 0x6e, 0x13, 0xe6, 0x53, 0x8f, 0x11, 0xf4, 0x1a,
+	0x16, 0xdb, 0x41, 0x41, 0x50, 0xb2, 0x27, 0x4f, 0x4b, 0xc9, 0xc7, 0x57, 0x8a, 0x73, 0x25, 0x03,
+	0x99, 0x90, 0xc5, 0x9c, 0x07, 0xc1, 0xf0, 0x3e, 0xf5, 0x8a, 0x73, 0xe9, 0x02, 0xc6, 0x18, 0x6a,
+	0xc7, 0x79, 0xfa, 0x1f, 0x1a, 0x6c, 0x57, 0x30, 0xef, 0xf4, 0x48, 0x37, 0x21, 0x8e, 0x5d, 0x00,
+	0xc6, 0xf1, 0x90, 0xbb, 0x81, 0x17, 0xe5, 0x2b, 0x23, 0x2a, 0x81, 0x7b, 0xb4, 0x0d, 0xcb, 0xc4,
+	0xeb, 0xca, 0xa6, 0x4c, 0x78, 0x89, 0x78, 0x5d, 0xd1, 0x3a, 0x82, 0xfc, 0x87, 0xbe, 0x87, 0x07,
+	0xfd, 0x9f, 0x49, 0xd7, 0x1d, 0x52, 0xca, 0xc5, 0x49, 0xe7, 0xec, 0x95, 0xa8, 0x6a, 0x53, 0x09,
+	0xeb, 0x60, 0x8f, 0x7a, 0xfd, 0x0e, 0x1e, 0x48, 0xd8, 0x82, 0x84, 0x45, 0xd5, 0x00, 0xa6, 0xf7,
+	0x60, 0x27, 0x69, 0x49, 0x95, 0xe2, 0x1b, 0xc8, 0xb7, 0x65, 0xd7, 0x15, 0xd9, 0xb0, 0xa2, 0x76,
+	0x30, 0xff, 0xd0, 0x38, 0x57, 0x14, 0x55, 0x3c, 0x31, 0x1d, 0x41, 0xa1, 0xda, 0xc3, 0x7d, 0xaf,
+	0x46, 0x70, 0x57, 0xa5, 0xa0, 0xff, 0x3e, 0x07, 0x6b, 0xb1, 0xa2, 0x9a, 0x3a, 0xb1, 0x7a, 0x2c,
+	0x9f, 0xf1, 0xea, 0x22, 0x88, 0x2f, 0xe0, 0xff, 0x31, 0x18, 0xc7, 0x9c, 0x08, 0x9f, 0x6e, 0xf0,
+	0x6a, 0xbd, 0x3c, 0x51, 0x77, 0xa3, 0x38, 0xe6, 0x04, 0x88, 0xc0, 0x73, 0x4d, 0xf4, 0xd1, 0x97,
+	0xf0, 0x64, 0x9c, 0xe3, 0x0c, 0x9d, 0xa9, 0x54, 0xb7, 0x23, 0xcc, 0x14, 0x9f, 0xa1, 0xe7, 0xb0,
+	0x31, 0x9e, 0x2f, 0xe2, 0x89, 0xe7, 0x8c, 0xa2, 0x9e, 0x4c, 0x23, 0x38, 0x93, 0xe7, 0xb0, 0x31,
+	0x1e, 0x19, 0x63, 0x2c, 0x4a, 0x46, 0xd4, 0x8b, 0x18, 0xfa, 0x33, 0xd8, 0x92, 0x91, 0x8a, 0xe9,
+	0xc1, 0xe4, 0xb4, 0xbb, 0xa9, 0xb7, 0x00, 0xc5, 0xe0, 0xe1, 0xbb, 0x76, 0x9f, 0x53, 0xed, 0x1e,
+	0xa7, 0x7a, 0x27, 0xbc, 0x63, 0x4a, 0x56, 0x9d, 0x53, 0x1d, 0x56, 0xa7, 0x74, 0x1f, 0x76, 0xdb,
+	0xa4, 0x4a, 0x7e, 0x72, 0x9e, 0xfe, 0x19, 0xac, 0xc7, 0xee, 0x52, 0xaa, 0xcd, 0x63, 0x40, 0xf1,
+	0x6b, 0x97, 0xf2, 0x85, 0xf1, 0x27, 0x44, 0xa3, 0xcd, 0x13, 0xa0, 0xff, 0xd5, 0xb5, 0xff, 0x11,
+	0x36, 0xbf, 0x9a, 0x30, 0x16, 0x39, 0xd9, 0x05, 0x88, 0x9d, 0xb9, 0x1c, 0x9d, 0x69, 0x47, 0x2f,
+	0x87, 0xfc, 0x22, 0xa8, 0xb3, 0x51, 0x6f, 0x6f, 0x86, 0x85, 0x47, 0x11, 0xac, 0x2c, 0xae, 0xc2,
+	0xbc, 0xb8, 0x0a, 0xe2, 0xb7, 0x5e, 0x82, 0xe2, 0xf9, 0x90, 0xfa, 0x94, 0x91, 0x61, 0x73, 0x80,
+	0x59, 0xaf, 0xef, 0x5d, 0xa4, 0xe6, 0xf6, 0x0c, 0xb6, 0xa6, 0xf1, 0x69, 0xe1, 0xfd, 0xaa, 0xcd,
+	0xea, 0xa7, 0x46, 0xd8, 0x82, 0x35, 0x5f, 0xe1, 0x5d, 0xa6, 0x08, 0x2a, 0xc8, 0xe3, 0xbb, 0x82,
+	0x9c, 0x19, 0x50, 0xf0, 0xa7, 0x2a, 0x81, 0x4d, 0x19, 0xf7, 0xc3, 0x6d, 0x4e, 0xe3, 0xef, 0xb3,
+	0x39, 0x8b, 0x4f, 0xb7, 0x19, 0xe2, 0x1f, 0x6c, 0x73, 0x66, 0x40, 0x61, 0xba, 0xa2, 0x1f, 0xc1,
+	0xea, 0x29, 0xf1, 0x29, 0xeb, 0xf3, 0x54, 0x77, 0x9f, 0x40, 0x5e, 0xc1, 0xd2, 0x4c, 0xfd, 0x10,
+	0x89, 0xa5, 0x5a, 0x79, 0x0d, 0x4b, 0x5d, 0x09, 0x53, 0x06, 0xf6, 0xef, 0x32, 0x10, 0xaa, 0x85,
+	0x78, 0x5d, 0x87, 0x9c, 0x79, 0x7d, 0xcf, 0xae, 0x87, 0x90, 0x35, 0xaf, 0xd3, 0x17, 0xf5, 0xa5,
+	0x4c, 0xea, 0x96, 0x75, 0xc8, 0x5f, 0xd1, 0xc1, 0xc8, 0xe3, 0x78, 0x78, 0xe3, 0x92, 0xeb, 0x68,
+	0xd9, 0xa3, 0xbb, 0x96, 0x7d, 0x17, 0xa2, 0x85, 0xf4, 0xca, 0x55, 0xfc, 0x51, 0x37, 0x21, 0x53,
+	0xc3, 0x5e, 0x97, 0xf5, 0xf0, 0x47, 0x82, 0x5e, 0x41, 0x51, 0x19, 0x12, 0x7f, 0xb9, 0x86, 0xb8,
+	0xc3, 0x5d, 0xdc, 0xed, 0x0e, 0x09, 0x93, 0xdf, 0xc5, 0x8c, 0xbd, 0xa9, 0xfa, 0x55, 0xd5, 0x36,
+	0x64, 0xf7, 0xf3, 0x7f, 0x16, 0x60, 0xd1, 0xa1, 0x7e, 0xbf, 0x83, 0xb2, 0xb0, 0xd4, 0xb2, 0xde,
+	0x5a, 0x8d, 0xef, 0xac, 0xc2, 0xff, 0xd0, 0x36, 0x3c, 0xae, 0x98, 0x46, 0xb5, 0x61, 0xb9, 0x95,
+	0x7a, 0xa3, 0xfa, 0xd6, 0x35, 0x2c, 0xab, 0xd1, 0xb2, 0xaa, 0x66, 0x41, 0x43, 0x45, 0xd8, 0x98,
+	0x68, 0xd9, 0xe6, 0xb7, 0x2d, 0xb3, 0xe9, 0x14, 0xe6, 0xd0, 0xa7, 0xf0, 0x34, 0xa9, 0xe3, 0x56,
+	0xde, 0xbb, 0xcd, 0x7a, 0xc3, 0x71, 0xad, 0xd6, 0x37, 0x15, 0xd3, 0x2e, 0xcc, 0xcf, 0xa8, 0xdb,
+	0x66, 0xf3, 0xbc, 0x61, 0x35, 0xcd, 0xc2, 0x02, 0x3a, 0x80, 0x27, 0x15, 0xc3, 0xa9, 0xd6, 0xcc,
+	0x53, 0x37, 0x71, 0xca, 0x22, 0x3a, 0x84, 0xdd, 0x3b, 0x10, 0x4a, 0xe4, 0x11, 0xda, 0x04, 0x54,
+	0xad, 0x19, 0x67, 0x96, 0x5b, 0x33, 0x8d, 0xd3, 0x88, 0xba, 0x84, 0xb6, 0x60, 0x7d, 0xa2, 0xae,
+	0x08, 0xcb, 0x68, 0x0f, 0x76, 0x94, 0x56, 0xd3, 0x31, 0x1c, 0xd3, 0xad, 0x19, 0xcd, 0xda, 0xd8,
+	0x73, 0x26, 0xe6, 0x59, 0xf6, 0x43, 0x49, 0x88, 0x59, 0x09, 0x3b, 0x4a, 0x34, 0x1b, 0x90, 0x0c,
+	0xc7, 0x31, 0x83, 0xfa, 0x59, 0xc3, 0x1a, 0xcb, 0xe5, 0x82, 0x3d, 0xe2, 0x9d, 0x50, 0x6d, 0x65,
+	0x9a, 0x12, 0x89, 0xe5, 0x83, 0x0d, 0xcf, 0xed, 0xc6, 0x79, 0xa3, 0x69, 0xda, 0x6e, 0xb3, 0x6e,
+	0x34, 0x6b, 0x67, 0xd6, 0xd7, 0x63, 0xc9, 0x55, 0xb4, 0x0b, 0xdb, 0xb3, 0xfd, 0x50, 0xb8, 0x90,
+	0x4c, 0x8f, 0xe4, 0xd7, 0x82, 0xbe, 0x1c, 0x9c, 0x28, 0x8f, 0x02, 0xf9, 0xd9, 0x7e, 0x28, 0xbf,
+	0x9e, 0x4c, 0x8f, 0xe4, 0x37, 0x10, 0x82, 0xfc, 0xbb, 0x46, 0xbd, 0x65, 0x39, 0x86, 0xfd, 0xde,
+	0x35, 0xbf, 0x3f, 0x73, 0x0a, 0x8f, 0x2b, 0xb9, 0x3f, 0x6f, 0xf7, 0xb4, 0xbf, 0x6e, 0xf7, 0xb4,
+	0xbf, 0x6f, 0xf7, 0xb4, 0xf6, 0x23, 0xf1, 0xcf, 0xfe, 0xe5, 0xbf, 0x03, 0x00, 0x85, 0x63, 0xec,
+	0x79, 0xc5, 0x0c, 0x00, 0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
@@ -1773,6 +1773,298 @@ func (m *Eth1DataVote) GetVoteCount() uint64 {
 	return 0
 }
 
+type BeaconStateDiff struct {
+	SnapshotHash32         []byte             `protobuf:"bytes,1,opt,name=snapshot_hash32,json=snapshotHash32,proto3" json:"snapshot_hash32,omitempty"`
+	PartialState           *BeaconState       `protobuf:"bytes,2,opt,name=partial_state,json=partialState,proto3" json:"partial_state,omitempty"`
+	ValidatorRegistry      *ValidatorListDiff `protobuf:"bytes,3,opt,name=validator_registry,json=validatorRegistry,proto3" json:"validator_registry,omitempty"`
+	ValidatorBalances      *Uint64ListDiff    `protobuf:"bytes,4,opt,name=validator_balances,json=validatorBalances,proto3" json:"validator_balances,omitempty"`
+	LatestRandaoMixes      *BytesListDiff     `protobuf:"bytes,5,opt,name=latest_randao_mixes,json=latestRandaoMixes,proto3" json:"latest_randao_mixes,omitempty"`
+	LatestBlockRootHash32S *BytesListDiff     `protobuf:"bytes,6,opt,name=latest_block_root_hash32s,json=latestBlockRootHash32s,proto3" json:"latest_block_root_hash32s,omitempty"`
+	LatestIndexRootHash32S *BytesListDiff     `protobuf:"bytes,7,opt,name=latest_index_root_hash32s,json=latestIndexRootHash32s,proto3" json:"latest_index_root_hash32s,omitempty"`
+	LatestSlashedBalances  *Uint64ListDiff    `protobuf:"bytes,8,opt,name=latest_slashed_balances,json=latestSlashedBalances,proto3" json:"latest_slashed_balances,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
+	XXX_unrecognized       []byte             `json:"-"`
+	XXX_sizecache          int32              `json:"-"`
+}
+
+func (m *BeaconStateDiff) Reset()         { *m = BeaconStateDiff{} }
+func (m *BeaconStateDiff) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateDiff) ProtoMessage()    {}
+func (*BeaconStateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{22}
+}
+func (m *BeaconStateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateDiff.Merge(m, src)
+}
+func (m *BeaconStateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateDiff proto.InternalMessageInfo
+
+func (m *BeaconStateDiff) GetSnapshotHash32() []byte {
+	if m != nil {
+		return m.SnapshotHash32
+	}
+	return nil
+}
+
+func (m *BeaconStateDiff) GetPartialState() *BeaconState {
+	if m != nil {
+		return m.PartialState
+	}
+	return nil
+}
+
+func (m *BeaconStateDiff) GetValidatorRegistry() *ValidatorListDiff {
+	if m != nil {
+		return m.ValidatorRegistry
+	}
+	return nil
+}
+
+func (m *BeaconStateDiff) GetValidatorBalances() *Uint64ListDiff {
+	if m != nil {
+		return m.ValidatorBalances
+	}
+	return nil
+}
+
+func (m *BeaconStateDiff) GetLatestRandaoMixes() *BytesListDiff {
+	if m != nil {
+		return m.LatestRandaoMixes
+	}
+	return nil
+}
+
+func (m *BeaconStateDiff) GetLatestBlockRootHash32S() *BytesListDiff {
+	if m != nil {
+		return m.LatestBlockRootHash32S
+	}
+	return nil
+}
+
+func (m *BeaconStateDiff) GetLatestIndexRootHash32S() *BytesListDiff {
+	if m != nil {
+		return m.LatestIndexRootHash32S
+	}
+	return nil
+}
+
+func (m *BeaconStateDiff) GetLatestSlashedBalances() *Uint64ListDiff {
+	if m != nil {
+		return m.LatestSlashedBalances
+	}
+	return nil
+}
+
+type ValidatorListDiff struct {
+	Length               uint64       `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Indices              []uint64     `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	Values               []*Validator `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ValidatorListDiff) Reset()         { *m = ValidatorListDiff{} }
+func (m *ValidatorListDiff) String() string { return proto.CompactTextString(m) }
+func (*ValidatorListDiff) ProtoMessage()    {}
+func (*ValidatorListDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{23}
+}
+func (m *ValidatorListDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorListDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorListDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorListDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorListDiff.Merge(m, src)
+}
+func (m *ValidatorListDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorListDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorListDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorListDiff proto.InternalMessageInfo
+
+func (m *ValidatorListDiff) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *ValidatorListDiff) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ValidatorListDiff) GetValues() []*Validator {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type Uint64ListDiff struct {
+	Length               uint64   `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	Values               []uint64 `protobuf:"varint,3,rep,packed,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Uint64ListDiff) Reset()         { *m = Uint64ListDiff{} }
+func (m *Uint64ListDiff) String() string { return proto.CompactTextString(m) }
+func (*Uint64ListDiff) ProtoMessage()    {}
+func (*Uint64ListDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{24}
+}
+func (m *Uint64ListDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Uint64ListDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Uint64ListDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Uint64ListDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Uint64ListDiff.Merge(m, src)
+}
+func (m *Uint64ListDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *Uint64ListDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_Uint64ListDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Uint64ListDiff proto.InternalMessageInfo
+
+func (m *Uint64ListDiff) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *Uint64ListDiff) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *Uint64ListDiff) GetValues() []uint64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type BytesListDiff struct {
+	Length               uint64   `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BytesListDiff) Reset()         { *m = BytesListDiff{} }
+func (m *BytesListDiff) String() string { return proto.CompactTextString(m) }
+func (*BytesListDiff) ProtoMessage()    {}
+func (*BytesListDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{25}
+}
+func (m *BytesListDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BytesListDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BytesListDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BytesListDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BytesListDiff.Merge(m, src)
+}
+func (m *BytesListDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *BytesListDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_BytesListDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BytesListDiff proto.InternalMessageInfo
+
+func (m *BytesListDiff) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *BytesListDiff) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *BytesListDiff) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.p2p.v1.Validator_StatusFlags", Validator_StatusFlags_name, Validator_StatusFlags_value)
 	proto.RegisterType((*BeaconState)(nil), "ethereum.beacon.p2p.v1.BeaconState")
@@ -1797,140 +2089,157 @@ func init() {
 	proto.RegisterType((*VoluntaryExit)(nil), "ethereum.beacon.p2p.v1.VoluntaryExit")
 	proto.RegisterType((*Eth1Data)(nil), "ethereum.beacon.p2p.v1.Eth1Data")
 	proto.RegisterType((*Eth1DataVote)(nil), "ethereum.beacon.p2p.v1.Eth1DataVote")
+	proto.RegisterType((*BeaconStateDiff)(nil), "ethereum.beacon.p2p.v1.BeaconStateDiff")
+	proto.RegisterType((*ValidatorListDiff)(nil), "ethereum.beacon.p2p.v1.ValidatorListDiff")
+	proto.RegisterType((*Uint64ListDiff)(nil), "ethereum.beacon.p2p.v1.Uint64ListDiff")
+	proto.RegisterType((*BytesListDiff)(nil), "ethereum.beacon.p2p.v1.BytesListDiff")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/types.proto", fileDescriptor_e719e7d82cfa7b0d) }
 
 var fileDescriptor_e719e7d82cfa7b0d = []byte{
-	// 2048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5b, 0x6f, 0x23, 0x49,
-	0x15, 0xa6, 0xe3, 0x5c, 0x8f, 0x9d, 0xd8, 0xa9, 0x4c, 0xe2, 0x66, 0x6e, 0xc9, 0xf4, 0xcc, 0x32,
-	0x99, 0x61, 0x37, 0xc1, 0x5e, 0x89, 0x11, 0x0c, 0x2b, 0x91, 0x4c, 0xb2, 0x6c, 0x60, 0x76, 0x37,
-	0x6a, 0x87, 0x19, 0x1e, 0x80, 0x56, 0xd9, 0x5d, 0xb6, 0x7b, 0xd2, 0xee, 0x6a, 0x75, 0x95, 0xbd,
-	0x13, 0xc4, 0x1f, 0x60, 0x91, 0x78, 0xe3, 0x01, 0xde, 0xb8, 0xfc, 0x09, 0xee, 0xbc, 0x20, 0xf1,
-	0xc8, 0x75, 0x11, 0x12, 0x42, 0x68, 0x9e, 0xb9, 0xf3, 0x07, 0x50, 0x5d, 0xba, 0xbb, 0x7c, 0x4b,
-	0x66, 0x58, 0x5e, 0xf6, 0xc9, 0xea, 0x73, 0xbe, 0x73, 0xea, 0xd4, 0xa9, 0x53, 0xdf, 0x39, 0x65,
-	0xd8, 0x8c, 0x13, 0xca, 0xe9, 0x6e, 0x93, 0xe0, 0x16, 0x8d, 0x76, 0xe3, 0x7a, 0xbc, 0x3b, 0xa8,
-	0xed, 0xf2, 0xb3, 0x98, 0xb0, 0x1d, 0xa9, 0x41, 0x1b, 0x84, 0x77, 0x49, 0x42, 0xfa, 0xbd, 0x1d,
-	0x85, 0xd9, 0x89, 0xeb, 0xf1, 0xce, 0xa0, 0x76, 0xf9, 0x8a, 0x32, 0x6c, 0xd1, 0x5e, 0x8f, 0x46,
-	0xbb, 0x3d, 0xc2, 0x18, 0xee, 0xa4, 0x46, 0xce, 0x7f, 0x96, 0xa1, 0xb8, 0x2f, 0xe1, 0x0d, 0x8e,
-	0x39, 0x41, 0xc7, 0x80, 0x06, 0x38, 0x0c, 0x7c, 0xcc, 0x69, 0xe2, 0x25, 0xa4, 0x13, 0x30, 0x9e,
-	0x9c, 0xd9, 0xd6, 0x56, 0x61, 0xbb, 0x58, 0xbf, 0xb1, 0x33, 0x79, 0x85, 0x9d, 0x47, 0xa9, 0x85,
-	0xbb, 0x9a, 0x19, 0xbb, 0xda, 0x16, 0x1d, 0xc2, 0xe6, 0xb8, 0x47, 0xaf, 0x1f, 0xfb, 0x98, 0x13,
-	0x8f, 0xc4, 0xb4, 0xd5, 0xb5, 0x67, 0xb6, 0xac, 0xed, 0x59, 0xf7, 0xea, 0x98, 0xed, 0xe7, 0x25,
-	0xe8, 0x50, 0x60, 0xd0, 0x2b, 0x66, 0x60, 0x4d, 0x1c, 0xe2, 0xa8, 0x45, 0x98, 0x5d, 0xd8, 0x2a,
-	0x6c, 0xcf, 0x1a, 0xab, 0xee, 0x6b, 0x05, 0xda, 0x85, 0xb5, 0x10, 0x73, 0xc2, 0xb8, 0x97, 0xe0,
-	0xc8, 0xc7, 0xd4, 0xeb, 0x05, 0x4f, 0x09, 0xb3, 0xff, 0xba, 0xb0, 0x55, 0xd8, 0x2e, 0xb9, 0xab,
-	0x4a, 0xe7, 0x4a, 0xd5, 0x9b, 0x42, 0x83, 0x0e, 0xe0, 0x7a, 0x9c, 0x90, 0x41, 0x40, 0xfb, 0xcc,
-	0x63, 0xdd, 0x7e, 0xbb, 0x1d, 0x06, 0x51, 0xc7, 0x63, 0x1c, 0x27, 0xdc, 0x63, 0x5d, 0x9c, 0xf8,
-	0xf6, 0xdf, 0x16, 0x64, 0x98, 0x57, 0x52, 0x58, 0x23, 0x45, 0x35, 0x04, 0xa8, 0x21, 0x30, 0x68,
-	0x1f, 0xae, 0xb5, 0xfa, 0x49, 0x42, 0x22, 0x3e, 0xc5, 0xc9, 0xdf, 0x95, 0x93, 0xcb, 0x1a, 0x35,
-	0xc9, 0xc7, 0x27, 0xc0, 0x9e, 0x10, 0x89, 0xca, 0xd4, 0x3f, 0x94, 0xf9, 0xc6, 0x58, 0x0c, 0x2a,
-	0x49, 0xf7, 0xa0, 0x3a, 0xbe, 0xbc, 0xb2, 0xfc, 0xa7, 0xb2, 0x5c, 0x1f, 0x5d, 0x58, 0x19, 0x4e,
-	0xd9, 0x3d, 0x21, 0xbe, 0xd7, 0xc5, 0xac, 0xfb, 0x6a, 0xdd, 0xfe, 0x97, 0xb0, 0x2f, 0x4d, 0xda,
-	0x3d, 0x21, 0xfe, 0x1b, 0x12, 0x33, 0x65, 0xf7, 0x86, 0x93, 0x7f, 0x2b, 0x27, 0xe3, 0xbb, 0xcf,
-	0x7d, 0x98, 0xbb, 0x7f, 0xd2, 0x67, 0x3c, 0x68, 0x07, 0xc4, 0xd7, 0x7b, 0xf8, 0x75, 0x79, 0x78,
-	0xf7, 0x9f, 0x4d, 0xf5, 0xd9, 0xee, 0x27, 0x98, 0x26, 0x94, 0x72, 0xfb, 0x37, 0x65, 0xb9, 0xf0,
-	0xfa, 0x98, 0xa5, 0x4b, 0x29, 0x47, 0xdb, 0x50, 0x1e, 0x5d, 0xea, 0xb7, 0x6a, 0xa9, 0x95, 0x27,
-	0xc3, 0x4b, 0x7c, 0x04, 0x56, 0x46, 0x3c, 0xff, 0x4e, 0x79, 0x5e, 0x7e, 0x32, 0xe4, 0xf1, 0xe3,
-	0xb0, 0xa1, 0x05, 0x2d, 0xcc, 0x03, 0x1a, 0x79, 0xcd, 0x80, 0xb7, 0x03, 0x12, 0xfa, 0xf6, 0xef,
-	0x95, 0xe3, 0xf5, 0x21, 0xf5, 0xbe, 0xd6, 0x8a, 0x48, 0xda, 0x41, 0x84, 0xc3, 0xe0, 0x2b, 0x59,
-	0x24, 0xef, 0xe9, 0x48, 0x32, 0x79, 0x16, 0x49, 0x8e, 0x94, 0x91, 0xfc, 0x41, 0x47, 0x92, 0x89,
-	0x65, 0x24, 0x6f, 0x83, 0x2e, 0x76, 0xaf, 0x95, 0x50, 0xc6, 0xc2, 0x20, 0x3a, 0x65, 0xf6, 0x0f,
-	0xaa, 0xe7, 0x5f, 0xe8, 0x07, 0x29, 0xd4, 0xad, 0x28, 0xe3, 0x4c, 0xc0, 0xd0, 0x27, 0xe1, 0xc3,
-	0xda, 0x61, 0x33, 0xa4, 0xad, 0x53, 0xb9, 0xb6, 0x3e, 0x5f, 0x66, 0xff, 0xa8, 0x2a, 0xef, 0xd7,
-	0x86, 0x42, 0xec, 0x0b, 0x80, 0x88, 0x42, 0x9d, 0x2d, 0x43, 0x9f, 0x82, 0xcb, 0x4d, 0xcc, 0x5b,
-	0x5d, 0xe2, 0x4f, 0x32, 0xfe, 0xb1, 0x32, 0xae, 0x6a, 0xc8, 0x98, 0xf5, 0x3d, 0xa8, 0xea, 0x95,
-	0x59, 0x88, 0x99, 0x74, 0x92, 0xf2, 0xc0, 0x4f, 0xaa, 0x92, 0x08, 0xd6, 0x95, 0xbe, 0xa1, 0xd4,
-	0x19, 0x19, 0x7c, 0x31, 0x23, 0x03, 0xcc, 0xc5, 0x8f, 0xcc, 0x39, 0xb3, 0x7f, 0xaa, 0xb2, 0x70,
-	0x77, 0x5a, 0x16, 0x8e, 0x49, 0xe4, 0x07, 0x51, 0x67, 0x2f, 0xb7, 0x71, 0x91, 0xf2, 0x63, 0x88,
-	0xcc, 0x84, 0x04, 0x91, 0x4f, 0x9e, 0x0e, 0xef, 0xe9, 0x67, 0x43, 0x09, 0x39, 0x12, 0x00, 0x73,
-	0x4b, 0x9f, 0x81, 0x92, 0x99, 0x4c, 0xfb, 0xe7, 0xd5, 0x2d, 0x6b, 0xbb, 0x58, 0xbf, 0x39, 0x2d,
-	0x24, 0x45, 0xd5, 0x2a, 0x33, 0x45, 0x23, 0xc9, 0xe8, 0x73, 0xa0, 0x4f, 0xca, 0x23, 0xbc, 0x5b,
-	0xf3, 0x7c, 0xcc, 0xb1, 0xfd, 0x9d, 0x4d, 0xe9, 0x6c, 0x6b, 0x9a, 0xb3, 0x43, 0xde, 0xad, 0x1d,
-	0x60, 0x8e, 0xdd, 0x15, 0x65, 0x9a, 0x7e, 0xa3, 0x37, 0xa1, 0x9c, 0x79, 0xf1, 0x06, 0x94, 0x13,
-	0x66, 0x7f, 0x77, 0x53, 0xe6, 0xea, 0xd6, 0x45, 0xbe, 0x1e, 0x51, 0x4e, 0xdc, 0x65, 0x62, 0x7c,
-	0x31, 0x74, 0x0b, 0x96, 0x7d, 0x12, 0x53, 0x16, 0xe8, 0x0c, 0xd9, 0xdf, 0xdb, 0x94, 0x25, 0x5d,
-	0xd2, 0x52, 0x99, 0x15, 0xe4, 0x40, 0xa9, 0x43, 0x22, 0xc2, 0x02, 0xe6, 0xf1, 0xa0, 0x47, 0xec,
-	0xaf, 0xdd, 0x96, 0xa0, 0xa2, 0x16, 0x9e, 0x04, 0x3d, 0x82, 0x6a, 0x30, 0xdb, 0xa6, 0xc9, 0xa9,
-	0xfd, 0xee, 0x6d, 0xb9, 0xb3, 0xab, 0xd3, 0xa2, 0x79, 0x9d, 0x26, 0xa7, 0xae, 0x84, 0xa2, 0x35,
-	0x98, 0x65, 0x21, 0xe5, 0xf6, 0xd7, 0x95, 0x3b, 0xf9, 0xe1, 0xc4, 0x30, 0x2b, 0x20, 0xe8, 0x0e,
-	0x54, 0x32, 0xc6, 0x18, 0x90, 0x84, 0x05, 0x34, 0xb2, 0x2d, 0x89, 0x2b, 0xa7, 0xf2, 0x47, 0x4a,
-	0x8c, 0x6e, 0x43, 0x39, 0xe5, 0xb6, 0x14, 0xa9, 0xda, 0xd6, 0x8a, 0x16, 0xa7, 0xc0, 0x4b, 0x30,
-	0xa7, 0x2e, 0x6e, 0x41, 0xaa, 0xd5, 0x87, 0xf3, 0x9e, 0x05, 0x68, 0xbc, 0x9e, 0xd0, 0x7d, 0x98,
-	0x95, 0x47, 0x65, 0xc9, 0xfd, 0xdc, 0x9e, 0xb6, 0x1f, 0xc3, 0x44, 0x1e, 0x98, 0x34, 0x42, 0x35,
-	0xb8, 0x84, 0x3b, 0x9d, 0x84, 0x74, 0x46, 0x28, 0x66, 0x46, 0xf2, 0xc0, 0x9a, 0xa1, 0xcb, 0xf8,
-	0xe5, 0x0e, 0x54, 0x5a, 0x7d, 0xc6, 0xa9, 0x7f, 0x96, 0xc3, 0x0b, 0x12, 0x5e, 0xd6, 0xf2, 0x0c,
-	0xfa, 0x12, 0xac, 0x04, 0x51, 0x2b, 0xec, 0x8b, 0x4d, 0x79, 0x32, 0x85, 0xb3, 0x72, 0x43, 0xcb,
-	0x99, 0xb4, 0x21, 0x52, 0xf9, 0x47, 0x0b, 0x8a, 0x1f, 0x90, 0x1d, 0xed, 0x42, 0xe6, 0x81, 0x78,
-	0x2c, 0xe8, 0x44, 0x98, 0xf7, 0x13, 0x22, 0xb7, 0x55, 0x72, 0x51, 0xa6, 0x6a, 0xa4, 0x1a, 0xe7,
-	0xfb, 0x05, 0x28, 0x8f, 0x04, 0x8a, 0x90, 0xae, 0x27, 0x2b, 0x2f, 0x27, 0x71, 0xe4, 0xaa, 0xbb,
-	0xab, 0x8a, 0x50, 0x1f, 0xe8, 0x1e, 0xd8, 0x6a, 0xcf, 0xe3, 0x5c, 0xa7, 0x23, 0x5c, 0x6f, 0x1a,
-	0xd7, 0x39, 0x63, 0x05, 0x74, 0x1f, 0x2e, 0xcb, 0xa2, 0xf1, 0x9a, 0xb4, 0x1f, 0xf9, 0x38, 0x39,
-	0x1b, 0x32, 0x55, 0xe1, 0x56, 0x25, 0x62, 0x5f, 0x03, 0x86, 0x8d, 0x33, 0xa2, 0x57, 0x17, 0xd8,
-	0x34, 0x9e, 0x53, 0xc6, 0x19, 0x42, 0xe6, 0x3e, 0x37, 0x7e, 0x98, 0xb1, 0x48, 0x86, 0xb0, 0xe7,
-	0xe5, 0x41, 0x3e, 0x47, 0xab, 0x28, 0x8f, 0xb4, 0x0a, 0x71, 0x65, 0x46, 0xdb, 0xea, 0xc2, 0xc4,
-	0xae, 0xfa, 0x1a, 0x5c, 0xc9, 0x81, 0xe3, 0xc9, 0x5a, 0x94, 0x41, 0xdb, 0x19, 0x64, 0x24, 0x5f,
-	0x4e, 0x07, 0x56, 0x8d, 0x53, 0x3a, 0xc1, 0x49, 0x87, 0xf0, 0x89, 0xe7, 0x74, 0x0d, 0x20, 0xf7,
-	0xae, 0x8b, 0x6a, 0xa9, 0x99, 0x7a, 0x43, 0x9b, 0x50, 0x8c, 0xb1, 0xbc, 0xe1, 0x52, 0xaf, 0xce,
-	0x08, 0x94, 0x48, 0x00, 0x9c, 0xaf, 0xc2, 0xd5, 0x91, 0x72, 0xd8, 0x8b, 0xfc, 0x07, 0x59, 0x95,
-	0xbd, 0xbf, 0xda, 0xdf, 0x84, 0xa2, 0x51, 0xc8, 0x32, 0xba, 0x45, 0x17, 0xf2, 0x1a, 0x76, 0xbe,
-	0x59, 0x80, 0xa5, 0x6c, 0xd2, 0x46, 0x1b, 0x30, 0x1f, 0xf7, 0x9b, 0xa7, 0xe4, 0x4c, 0xae, 0x56,
-	0x72, 0xf5, 0x97, 0x98, 0xc1, 0xde, 0x09, 0x78, 0xd7, 0x4f, 0xf0, 0x3b, 0x38, 0xf4, 0x5a, 0x09,
-	0xf1, 0x49, 0xc4, 0x03, 0x1c, 0xb2, 0x34, 0x9b, 0x6a, 0xdb, 0x57, 0x72, 0xd0, 0x83, 0x1c, 0xa3,
-	0xcb, 0xe0, 0x0e, 0x54, 0x70, 0x8b, 0x07, 0x03, 0x75, 0x0b, 0xd5, 0xc9, 0xcd, 0x29, 0x5a, 0xcc,
-	0xe5, 0xea, 0xe8, 0xae, 0x01, 0x90, 0xa7, 0x01, 0xd7, 0xa0, 0x79, 0x09, 0x5a, 0x12, 0x12, 0xa5,
-	0xbe, 0x03, 0x15, 0x23, 0x1a, 0xb3, 0x06, 0xca, 0xb9, 0x5c, 0x41, 0x6f, 0xc2, 0x72, 0xda, 0xd6,
-	0x15, 0x6e, 0x51, 0x35, 0x09, 0x2d, 0x54, 0xa0, 0x63, 0x28, 0x89, 0xcc, 0xf5, 0x99, 0xd7, 0x0e,
-	0x71, 0x87, 0xd9, 0x4b, 0x5b, 0xd6, 0xf6, 0x4a, 0xfd, 0x95, 0x0b, 0x1f, 0x26, 0x3b, 0x0d, 0x69,
-	0xf5, 0xba, 0x30, 0x72, 0x8b, 0x2c, 0xff, 0x70, 0x3e, 0x0d, 0x45, 0x43, 0x87, 0x8a, 0xb0, 0x70,
-	0xf4, 0xd6, 0xd1, 0xc9, 0xd1, 0xde, 0xc3, 0xca, 0x87, 0x10, 0x82, 0x15, 0xf5, 0x71, 0x72, 0x78,
-	0xe0, 0x1d, 0x7e, 0xe1, 0xe8, 0xa4, 0x62, 0xa1, 0x0a, 0x94, 0x1e, 0x1f, 0x9d, 0xbc, 0x71, 0xe0,
-	0xee, 0x3d, 0xde, 0xdb, 0x7f, 0x78, 0x58, 0x99, 0x71, 0x42, 0xa8, 0xca, 0xc1, 0xdd, 0x25, 0x98,
-	0x09, 0x56, 0xe9, 0x89, 0x72, 0x21, 0x2d, 0x9a, 0xf8, 0xe2, 0x06, 0xe4, 0x8f, 0x16, 0xd5, 0xfb,
-	0x54, 0x3d, 0xae, 0x64, 0x62, 0xd5, 0xfc, 0x26, 0x33, 0x48, 0x5a, 0xc3, 0x05, 0xa3, 0x75, 0x7d,
-	0x19, 0x96, 0xf2, 0x1b, 0x96, 0xf5, 0x1a, 0xcb, 0xe8, 0x35, 0x17, 0x50, 0xc0, 0xcc, 0xb9, 0x14,
-	0xe0, 0xfc, 0x70, 0x26, 0x7d, 0x10, 0xaa, 0xc1, 0x62, 0xd2, 0x3d, 0x7a, 0x19, 0x90, 0x71, 0x51,
-	0x86, 0x1d, 0x57, 0xf2, 0xfb, 0xa2, 0xab, 0xe9, 0x2e, 0xac, 0x8a, 0x84, 0x93, 0x09, 0x04, 0x58,
-	0x96, 0x0a, 0x03, 0x7b, 0x13, 0x96, 0xf5, 0x7b, 0x2d, 0x21, 0x03, 0x82, 0x43, 0xcd, 0x76, 0x25,
-	0x25, 0x74, 0xa5, 0x0c, 0xbd, 0x06, 0x4b, 0xf9, 0x90, 0x33, 0xf7, 0x9c, 0x33, 0xce, 0x62, 0x3a,
-	0x93, 0xa0, 0xab, 0xb0, 0x94, 0x93, 0xff, 0xbc, 0x22, 0x81, 0x4c, 0x20, 0xee, 0x70, 0x93, 0xfa,
-	0x67, 0xb2, 0x4a, 0xcf, 0xb9, 0xc3, 0x46, 0x8a, 0xf6, 0xa9, 0x7f, 0xe6, 0x4a, 0x23, 0xe7, 0x5b,
-	0x05, 0x28, 0x8f, 0x68, 0xc4, 0x88, 0x37, 0x34, 0x75, 0xaa, 0xb7, 0xf4, 0xcd, 0xe7, 0x20, 0x07,
-	0x77, 0xc8, 0x10, 0x3d, 0x06, 0x14, 0x27, 0x34, 0xa6, 0x8c, 0x24, 0x6a, 0x00, 0x0e, 0xa2, 0x0e,
-	0xb3, 0x67, 0xa4, 0xbb, 0xed, 0xa9, 0x33, 0xac, 0xb6, 0x68, 0x68, 0x03, 0x77, 0x35, 0x1e, 0x91,
-	0x48, 0xc7, 0x6a, 0xa1, 0x21, 0xc7, 0x85, 0xf3, 0x1d, 0xef, 0x69, 0x8b, 0xdc, 0x31, 0x1e, 0x91,
-	0x30, 0x74, 0x1f, 0x16, 0xf5, 0x88, 0xc7, 0xec, 0x59, 0xe9, 0x6e, 0x73, 0x9a, 0xbb, 0x03, 0x85,
-	0x73, 0x33, 0x03, 0xf4, 0x16, 0x94, 0x07, 0x34, 0xec, 0x47, 0x5c, 0x34, 0x40, 0xc1, 0x28, 0xcc,
-	0x9e, 0x93, 0x3e, 0x5e, 0x9a, 0x7a, 0xdb, 0x53, 0xf8, 0xe1, 0xd3, 0x80, 0xbb, 0x2b, 0x03, 0xf3,
-	0x93, 0x39, 0xdf, 0xb6, 0xa0, 0x74, 0x90, 0x0e, 0x9c, 0x71, 0x9f, 0x4f, 0x65, 0xd0, 0x1d, 0x58,
-	0x8b, 0x13, 0x4a, 0xdb, 0x1e, 0x6d, 0x7b, 0x31, 0x65, 0x8c, 0xb0, 0x6c, 0xda, 0x2b, 0xc9, 0xf4,
-	0xd1, 0xf6, 0xdb, 0xed, 0xe3, 0x4c, 0x71, 0x31, 0xe3, 0x16, 0x2e, 0x64, 0x5c, 0xe7, 0x09, 0x20,
-	0x75, 0x52, 0x38, 0x14, 0xe3, 0x07, 0xf1, 0x5f, 0x70, 0xd6, 0xb8, 0x0b, 0xab, 0xd3, 0x86, 0x8c,
-	0x72, 0x73, 0xa4, 0x5d, 0xfe, 0xc9, 0x82, 0x4b, 0xf2, 0x8c, 0x70, 0x33, 0x24, 0xe6, 0xe8, 0xf6,
-	0x51, 0x58, 0x1d, 0x62, 0xab, 0x40, 0xbc, 0xac, 0x2c, 0xf9, 0xb0, 0xaa, 0x98, 0x7c, 0x25, 0xe4,
-	0x13, 0xe7, 0xae, 0x99, 0xc9, 0x73, 0x57, 0xda, 0x16, 0x0b, 0xff, 0x4b, 0x5b, 0x7c, 0xe1, 0xa1,
-	0xed, 0x1b, 0x16, 0x14, 0xf5, 0x39, 0xcb, 0x24, 0x1e, 0x99, 0xaf, 0x8f, 0xb8, 0xcf, 0x75, 0x77,
-	0xbe, 0x75, 0x41, 0x25, 0xca, 0x1a, 0x31, 0x9e, 0x28, 0xba, 0x62, 0x70, 0x8f, 0xf6, 0x23, 0xae,
-	0x93, 0xaf, 0xbf, 0x04, 0xa3, 0x88, 0x27, 0x0b, 0xe3, 0xb8, 0x17, 0x6b, 0xb2, 0xce, 0x05, 0xce,
-	0x2f, 0x66, 0xa0, 0x32, 0x7a, 0x0d, 0xc5, 0x74, 0x9d, 0x5d, 0x66, 0xb3, 0x31, 0x2c, 0xa7, 0x52,
-	0xd5, 0x17, 0x5c, 0x28, 0xc7, 0xba, 0x2e, 0x14, 0x93, 0xd7, 0xe4, 0xd2, 0xe7, 0x3d, 0x5a, 0xc7,
-	0xca, 0x28, 0xf5, 0x89, 0x43, 0xf1, 0x55, 0x43, 0x1f, 0x83, 0x4b, 0x99, 0xcf, 0x2c, 0xa1, 0x5e,
-	0x4d, 0x97, 0x0b, 0x8a, 0x0d, 0x07, 0x52, 0x55, 0x1b, 0x8f, 0x42, 0x4d, 0xa1, 0xef, 0x23, 0x8a,
-	0xfa, 0x94, 0x28, 0xd2, 0x09, 0x75, 0x3c, 0x8a, 0xba, 0xf3, 0x67, 0x0b, 0x2a, 0xa3, 0xac, 0x83,
-	0x7c, 0xa8, 0xb2, 0xb4, 0x96, 0xcd, 0xd7, 0xbd, 0x57, 0xd3, 0xe7, 0xfc, 0xf2, 0xb4, 0x10, 0x27,
-	0x5d, 0x01, 0x77, 0x9d, 0x4d, 0x90, 0xd6, 0xa6, 0xaf, 0x52, 0xd7, 0xc7, 0xf1, 0x7f, 0x58, 0xa5,
-	0xee, 0xbc, 0x6b, 0xc1, 0x82, 0xae, 0x3e, 0x91, 0x9e, 0x1e, 0x49, 0x4e, 0x43, 0xe2, 0x29, 0x2e,
-	0x4a, 0xff, 0x4f, 0xb0, 0xe4, 0xdf, 0x09, 0x48, 0xe9, 0x8e, 0x85, 0x2a, 0xfd, 0x2b, 0xe1, 0x2e,
-	0xac, 0x6a, 0x0b, 0x9e, 0x10, 0xa2, 0x8b, 0x4a, 0xd5, 0x69, 0x59, 0x29, 0x4e, 0x12, 0x42, 0x54,
-	0x59, 0xdd, 0x80, 0xb4, 0xb0, 0xbd, 0xec, 0x66, 0x96, 0xdc, 0xa2, 0x9f, 0x5f, 0x1b, 0x27, 0x84,
-	0xe5, 0x21, 0x3e, 0x9d, 0x32, 0x6b, 0x4c, 0x98, 0x70, 0x66, 0x26, 0x4e, 0x38, 0x43, 0x5d, 0xb7,
-	0x30, 0xd2, 0x75, 0x9d, 0x2f, 0xc1, 0x62, 0xf6, 0xef, 0xc3, 0x0e, 0xac, 0xa5, 0xc1, 0x99, 0x6c,
-	0xa6, 0x48, 0x7a, 0x55, 0xab, 0x8c, 0x99, 0xe1, 0x06, 0x94, 0x14, 0xf7, 0x0d, 0xcd, 0x21, 0x45,
-	0x29, 0xd3, 0x94, 0x17, 0x42, 0xc9, 0xfc, 0x83, 0x62, 0x78, 0x82, 0xb0, 0x5e, 0x78, 0x82, 0xb8,
-	0x06, 0x30, 0xa0, 0x9c, 0x78, 0x2d, 0x83, 0x0b, 0x96, 0x84, 0xe4, 0x81, 0x10, 0xec, 0x97, 0x7e,
-	0xf9, 0xec, 0xba, 0xf5, 0xab, 0x67, 0xd7, 0xad, 0xbf, 0x3c, 0xbb, 0x6e, 0x35, 0xe7, 0xe5, 0x1f,
-	0xed, 0xaf, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xae, 0x96, 0x55, 0x1f, 0xc0, 0x17, 0x00, 0x00,
+	// 2253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x49, 0x73, 0x1c, 0x49,
+	0xf5, 0xff, 0x57, 0xb7, 0xd6, 0xd7, 0xab, 0xd2, 0x96, 0x54, 0x7f, 0x6f, 0x92, 0xcb, 0x9e, 0xb1,
+	0x6c, 0x66, 0x24, 0x5a, 0x43, 0x8c, 0x63, 0x30, 0x13, 0x81, 0x64, 0x69, 0xb0, 0xc0, 0x33, 0xe3,
+	0x28, 0xc9, 0xf6, 0x40, 0xc0, 0xd4, 0x64, 0x77, 0x65, 0x77, 0x97, 0x55, 0x5d, 0x55, 0x51, 0x99,
+	0xdd, 0x63, 0x11, 0x1c, 0xb8, 0x32, 0x44, 0x70, 0xe3, 0x00, 0x37, 0x96, 0x0f, 0xc0, 0x95, 0x9d,
+	0x0b, 0x11, 0x1c, 0x59, 0x87, 0x20, 0x82, 0x20, 0x08, 0x9f, 0xd9, 0xf9, 0x02, 0x44, 0x2e, 0xb5,
+	0x75, 0x57, 0x49, 0x96, 0xcd, 0x85, 0x53, 0x47, 0xbe, 0x2d, 0x5f, 0xbe, 0x7c, 0xef, 0xf7, 0x5e,
+	0x65, 0xc3, 0x4a, 0x10, 0xfa, 0xcc, 0xdf, 0x68, 0x13, 0xdc, 0xf1, 0xbd, 0x8d, 0x60, 0x33, 0xd8,
+	0x18, 0xb5, 0x36, 0xd8, 0x51, 0x40, 0xe8, 0xba, 0xe0, 0xa0, 0x25, 0xc2, 0xfa, 0x24, 0x24, 0xc3,
+	0xc1, 0xba, 0x94, 0x59, 0x0f, 0x36, 0x83, 0xf5, 0x51, 0xeb, 0xdc, 0x79, 0xa9, 0xd8, 0xf1, 0x07,
+	0x03, 0xdf, 0xdb, 0x18, 0x10, 0x4a, 0x71, 0x2f, 0x52, 0x32, 0xfe, 0x5d, 0x83, 0xca, 0xb6, 0x10,
+	0xdf, 0x67, 0x98, 0x11, 0x74, 0x0f, 0xd0, 0x08, 0xbb, 0x8e, 0x8d, 0x99, 0x1f, 0x5a, 0x21, 0xe9,
+	0x39, 0x94, 0x85, 0x47, 0xba, 0xb6, 0x5a, 0x5e, 0xab, 0x6c, 0x5e, 0x5e, 0xcf, 0xdf, 0x61, 0xfd,
+	0x41, 0xa4, 0x61, 0x2e, 0xc4, 0xca, 0xa6, 0xd2, 0x45, 0xbb, 0xb0, 0x32, 0x69, 0xd1, 0x1a, 0x06,
+	0x36, 0x66, 0xc4, 0x22, 0x81, 0xdf, 0xe9, 0xeb, 0xa5, 0x55, 0x6d, 0x6d, 0xca, 0xbc, 0x30, 0xa1,
+	0x7b, 0x5f, 0x08, 0xed, 0x72, 0x19, 0xf4, 0x72, 0xda, 0xb1, 0x36, 0x76, 0xb1, 0xd7, 0x21, 0x54,
+	0x2f, 0xaf, 0x96, 0xd7, 0xa6, 0x52, 0xbb, 0x6e, 0x2b, 0x06, 0xda, 0x80, 0x33, 0x2e, 0x66, 0x84,
+	0x32, 0x2b, 0xc4, 0x9e, 0x8d, 0x7d, 0x6b, 0xe0, 0x3c, 0x26, 0x54, 0xff, 0xcb, 0xec, 0x6a, 0x79,
+	0xad, 0x6a, 0x2e, 0x48, 0x9e, 0x29, 0x58, 0x6f, 0x72, 0x0e, 0xda, 0x81, 0x4b, 0x41, 0x48, 0x46,
+	0x8e, 0x3f, 0xa4, 0x16, 0xed, 0x0f, 0xbb, 0x5d, 0xd7, 0xf1, 0x7a, 0x16, 0x65, 0x38, 0x64, 0x16,
+	0xed, 0xe3, 0xd0, 0xd6, 0xff, 0x3a, 0x2b, 0xdc, 0x3c, 0x1f, 0x89, 0xed, 0x47, 0x52, 0xfb, 0x5c,
+	0x68, 0x9f, 0xcb, 0xa0, 0x6d, 0xb8, 0xd8, 0x19, 0x86, 0x21, 0xf1, 0x58, 0x81, 0x91, 0xbf, 0x49,
+	0x23, 0xe7, 0x94, 0x54, 0x9e, 0x8d, 0xd7, 0x40, 0xcf, 0xf1, 0x44, 0x46, 0xea, 0xef, 0x52, 0x7d,
+	0x69, 0xc2, 0x07, 0x19, 0xa4, 0x9b, 0xb0, 0x3c, 0xb9, 0xbd, 0xd4, 0xfc, 0x87, 0xd4, 0x5c, 0x1c,
+	0xdf, 0x58, 0x2a, 0x16, 0x9c, 0x9e, 0x10, 0xdb, 0xea, 0x63, 0xda, 0x7f, 0x65, 0x53, 0xff, 0x27,
+	0xd7, 0xaf, 0xe6, 0x9d, 0x9e, 0x10, 0xfb, 0x8e, 0x90, 0x29, 0x38, 0x7d, 0xca, 0xc8, 0xbf, 0xa4,
+	0x91, 0xc9, 0xd3, 0x27, 0x36, 0xd2, 0xa7, 0x7f, 0x34, 0xa4, 0xcc, 0xe9, 0x3a, 0xc4, 0x56, 0x67,
+	0xf8, 0x55, 0x23, 0x7b, 0xfa, 0x4f, 0x47, 0xfc, 0xf8, 0xf4, 0x39, 0xaa, 0xa1, 0xef, 0x33, 0xfd,
+	0xd7, 0x0d, 0xb1, 0xf1, 0xe2, 0x84, 0xa6, 0xe9, 0xfb, 0x0c, 0xad, 0x41, 0x63, 0x7c, 0xab, 0xdf,
+	0xc8, 0xad, 0xea, 0x8f, 0xb2, 0x5b, 0xbc, 0x08, 0xf5, 0x31, 0xcb, 0xbf, 0x95, 0x96, 0x6b, 0x8f,
+	0x32, 0x16, 0x5f, 0x85, 0x25, 0x45, 0xe8, 0x60, 0xe6, 0xf8, 0x9e, 0xd5, 0x76, 0x58, 0xd7, 0x21,
+	0xae, 0xad, 0xff, 0x4e, 0x1a, 0x5e, 0xcc, 0xb0, 0xb7, 0x15, 0x97, 0x7b, 0xd2, 0x75, 0x3c, 0xec,
+	0x3a, 0x5f, 0x8c, 0x3d, 0xf9, 0x50, 0x79, 0x12, 0xd3, 0x63, 0x4f, 0x12, 0x49, 0xe1, 0xc9, 0xef,
+	0x95, 0x27, 0x31, 0x59, 0x78, 0xf2, 0x36, 0xa8, 0x64, 0xb7, 0x3a, 0xa1, 0x4f, 0xa9, 0xeb, 0x78,
+	0x87, 0x54, 0xff, 0xfe, 0xf2, 0xf1, 0x05, 0x7d, 0x3b, 0x12, 0x35, 0x9b, 0x52, 0x39, 0x26, 0x50,
+	0xf4, 0x71, 0xf8, 0x7f, 0x65, 0xb0, 0xed, 0xfa, 0x9d, 0x43, 0xb1, 0xb7, 0xba, 0x5f, 0xaa, 0xff,
+	0x70, 0x59, 0xd4, 0xd7, 0x92, 0x94, 0xd8, 0xe6, 0x02, 0xdc, 0x0b, 0x79, 0xb7, 0x14, 0x7d, 0x02,
+	0xce, 0xb5, 0x31, 0xeb, 0xf4, 0x89, 0x9d, 0xa7, 0xfc, 0x23, 0xa9, 0xbc, 0xac, 0x44, 0x26, 0xb4,
+	0x6f, 0xc2, 0xb2, 0xda, 0x99, 0xba, 0x98, 0x0a, 0x23, 0x11, 0x0e, 0xfc, 0x78, 0x59, 0x00, 0xc1,
+	0xa2, 0xe4, 0xef, 0x4b, 0x76, 0x0c, 0x06, 0x9f, 0x8f, 0xc1, 0x00, 0x33, 0xfe, 0x23, 0x62, 0x4e,
+	0xf5, 0x9f, 0xc8, 0x28, 0xdc, 0x28, 0x8a, 0xc2, 0x3d, 0xe2, 0xd9, 0x8e, 0xd7, 0xdb, 0x4a, 0x74,
+	0x4c, 0x24, 0xed, 0xa4, 0x48, 0xe9, 0x80, 0x38, 0x9e, 0x4d, 0x1e, 0x67, 0xcf, 0xf4, 0xd3, 0x4c,
+	0x40, 0xf6, 0xb8, 0x40, 0xfa, 0x48, 0x9f, 0x82, 0x6a, 0x3a, 0x98, 0xfa, 0xcf, 0x96, 0x57, 0xb5,
+	0xb5, 0xca, 0xe6, 0x95, 0x22, 0x97, 0x24, 0x54, 0xcb, 0xc8, 0x54, 0x52, 0x41, 0x46, 0x9f, 0x01,
+	0x75, 0x53, 0x16, 0x61, 0xfd, 0x96, 0x65, 0x63, 0x86, 0xf5, 0x6f, 0xad, 0x08, 0x63, 0xab, 0x45,
+	0xc6, 0x76, 0x59, 0xbf, 0xb5, 0x83, 0x19, 0x36, 0xeb, 0x52, 0x35, 0x5a, 0xa3, 0x37, 0xa1, 0x11,
+	0x5b, 0xb1, 0x46, 0x3e, 0x23, 0x54, 0xff, 0xf6, 0x8a, 0x88, 0xd5, 0xd5, 0x93, 0x6c, 0x3d, 0xf0,
+	0x19, 0x31, 0x6b, 0x24, 0xb5, 0xa2, 0xe8, 0x2a, 0xd4, 0x6c, 0x12, 0xf8, 0xd4, 0x51, 0x11, 0xd2,
+	0xbf, 0xb3, 0x22, 0x52, 0xba, 0xaa, 0xa8, 0x22, 0x2a, 0xc8, 0x80, 0x6a, 0x8f, 0x78, 0x84, 0x3a,
+	0xd4, 0x62, 0xce, 0x80, 0xe8, 0x5f, 0xb9, 0x26, 0x84, 0x2a, 0x8a, 0x78, 0xe0, 0x0c, 0x08, 0x6a,
+	0xc1, 0x54, 0xd7, 0x0f, 0x0f, 0xf5, 0x0f, 0xae, 0x89, 0x93, 0x5d, 0x28, 0xf2, 0xe6, 0x0d, 0x3f,
+	0x3c, 0x34, 0x85, 0x28, 0x3a, 0x03, 0x53, 0xd4, 0xf5, 0x99, 0xfe, 0x55, 0x69, 0x4e, 0x2c, 0x8c,
+	0x00, 0xa6, 0xb8, 0x08, 0xba, 0x0e, 0xcd, 0x18, 0x31, 0x46, 0x24, 0xa4, 0x8e, 0xef, 0xe9, 0x9a,
+	0x90, 0x6b, 0x44, 0xf4, 0x07, 0x92, 0x8c, 0xae, 0x41, 0x23, 0xc2, 0xb6, 0x48, 0x52, 0xb6, 0xad,
+	0xba, 0x22, 0x47, 0x82, 0x67, 0x61, 0x5a, 0x16, 0x6e, 0x59, 0xb0, 0xe5, 0xc2, 0xf8, 0x50, 0x03,
+	0x34, 0x99, 0x4f, 0xe8, 0x16, 0x4c, 0x89, 0xab, 0xd2, 0xc4, 0x79, 0xae, 0x15, 0x9d, 0x27, 0xa5,
+	0x22, 0x2e, 0x4c, 0x28, 0xa1, 0x16, 0x9c, 0xc5, 0xbd, 0x5e, 0x48, 0x7a, 0x63, 0x10, 0x53, 0x12,
+	0x38, 0x70, 0x26, 0xc5, 0x8b, 0xf1, 0xe5, 0x3a, 0x34, 0x3b, 0x43, 0xca, 0x7c, 0xfb, 0x28, 0x11,
+	0x2f, 0x0b, 0xf1, 0x86, 0xa2, 0xc7, 0xa2, 0x2f, 0x40, 0xdd, 0xf1, 0x3a, 0xee, 0x90, 0x1f, 0xca,
+	0x12, 0x21, 0x9c, 0x12, 0x07, 0xaa, 0xc5, 0xd4, 0x7d, 0x1e, 0xca, 0x3f, 0x68, 0x50, 0xf9, 0x1f,
+	0x39, 0xd1, 0x06, 0xc4, 0x16, 0x88, 0x45, 0x9d, 0x9e, 0x87, 0xd9, 0x30, 0x24, 0xe2, 0x58, 0x55,
+	0x13, 0xc5, 0xac, 0xfd, 0x88, 0x63, 0x7c, 0xb7, 0x0c, 0x8d, 0x31, 0x47, 0x11, 0x52, 0xf9, 0xa4,
+	0x25, 0xe9, 0xc4, 0xaf, 0x5c, 0x76, 0x77, 0x99, 0x11, 0x72, 0x81, 0x6e, 0x82, 0x2e, 0xcf, 0x3c,
+	0x89, 0x75, 0xca, 0xc3, 0xc5, 0x76, 0xaa, 0x9c, 0x63, 0x54, 0x40, 0xb7, 0xe0, 0x9c, 0x48, 0x1a,
+	0xab, 0xed, 0x0f, 0x3d, 0x1b, 0x87, 0x47, 0x19, 0x55, 0xe9, 0xee, 0xb2, 0x90, 0xd8, 0x56, 0x02,
+	0x59, 0xe5, 0x18, 0xe8, 0x65, 0x01, 0xa7, 0x95, 0xa7, 0xa5, 0x72, 0x2c, 0x21, 0x62, 0x9f, 0x28,
+	0xdf, 0x8d, 0x51, 0x24, 0x96, 0xd0, 0x67, 0x56, 0xb5, 0xa7, 0x6b, 0x15, 0x8d, 0xb1, 0x56, 0xc1,
+	0x4b, 0x66, 0xbc, 0xad, 0xce, 0xe6, 0x76, 0xd5, 0xd7, 0xe1, 0x7c, 0x22, 0x38, 0x19, 0xac, 0x39,
+	0xe1, 0xb4, 0x1e, 0x8b, 0x8c, 0xc5, 0xcb, 0xe8, 0xc1, 0x42, 0xea, 0x96, 0x0e, 0x70, 0xd8, 0x23,
+	0x2c, 0xf7, 0x9e, 0x2e, 0x02, 0x24, 0xd6, 0x55, 0x52, 0xcd, 0xb7, 0x23, 0x6b, 0x68, 0x05, 0x2a,
+	0x01, 0x16, 0x15, 0x2e, 0xf8, 0xf2, 0x8e, 0x40, 0x92, 0xb8, 0x80, 0xf1, 0x25, 0xb8, 0x30, 0x96,
+	0x0e, 0x5b, 0x9e, 0x7d, 0x3b, 0xce, 0xb2, 0xe7, 0xcb, 0xfd, 0x15, 0xa8, 0xa4, 0x12, 0x59, 0x78,
+	0x37, 0x67, 0x42, 0x92, 0xc3, 0xc6, 0xd7, 0xcb, 0x30, 0x1f, 0x4f, 0xda, 0x68, 0x09, 0x66, 0x82,
+	0x61, 0xfb, 0x90, 0x1c, 0x89, 0xdd, 0xaa, 0xa6, 0x5a, 0xf1, 0x19, 0xec, 0x7d, 0x87, 0xf5, 0xed,
+	0x10, 0xbf, 0x8f, 0x5d, 0xab, 0x13, 0x12, 0x9b, 0x78, 0xcc, 0xc1, 0x2e, 0x8d, 0xa2, 0x29, 0x8f,
+	0x7d, 0x3e, 0x11, 0xba, 0x9d, 0xc8, 0xa8, 0x34, 0xb8, 0x0e, 0x4d, 0xdc, 0x61, 0xce, 0x48, 0x56,
+	0xa1, 0xbc, 0xb9, 0x69, 0x09, 0x8b, 0x09, 0x5d, 0x5e, 0xdd, 0x45, 0x00, 0xf2, 0xd8, 0x61, 0x4a,
+	0x68, 0x46, 0x08, 0xcd, 0x73, 0x8a, 0x64, 0x5f, 0x87, 0x66, 0xca, 0x9b, 0x74, 0x0e, 0x34, 0x12,
+	0xba, 0x14, 0xbd, 0x02, 0xb5, 0xa8, 0xad, 0x4b, 0xb9, 0x39, 0xd9, 0x24, 0x14, 0x51, 0x0a, 0xdd,
+	0x83, 0x2a, 0x8f, 0xdc, 0x90, 0x5a, 0x5d, 0x17, 0xf7, 0xa8, 0x3e, 0xbf, 0xaa, 0xad, 0xd5, 0x37,
+	0x5f, 0x3e, 0xf1, 0xc3, 0x64, 0x7d, 0x5f, 0x68, 0xbd, 0xc1, 0x95, 0xcc, 0x0a, 0x4d, 0x16, 0xc6,
+	0x27, 0xa1, 0x92, 0xe2, 0xa1, 0x0a, 0xcc, 0xee, 0xbd, 0xb5, 0x77, 0xb0, 0xb7, 0x75, 0xb7, 0xf9,
+	0x7f, 0x08, 0x41, 0x5d, 0x2e, 0x0e, 0x76, 0x77, 0xac, 0xdd, 0x77, 0xf6, 0x0e, 0x9a, 0x1a, 0x6a,
+	0x42, 0xf5, 0xe1, 0xde, 0xc1, 0x9d, 0x1d, 0x73, 0xeb, 0xe1, 0xd6, 0xf6, 0xdd, 0xdd, 0x66, 0xc9,
+	0x70, 0x61, 0x59, 0x0c, 0xee, 0x26, 0xc1, 0x94, 0xa3, 0xca, 0x80, 0xa7, 0x0b, 0xe9, 0xf8, 0xa1,
+	0xcd, 0x2b, 0x20, 0xf9, 0x68, 0x91, 0xbd, 0x4f, 0xe6, 0x63, 0x3d, 0x26, 0xcb, 0xe6, 0x97, 0x8f,
+	0x20, 0x51, 0x0e, 0x97, 0x53, 0xad, 0xeb, 0x5d, 0x98, 0x4f, 0x2a, 0x2c, 0xee, 0x35, 0x5a, 0xaa,
+	0xd7, 0x9c, 0x00, 0x01, 0xa5, 0x63, 0x21, 0xc0, 0xf8, 0x41, 0x29, 0xfa, 0x20, 0x94, 0x83, 0x45,
+	0x5e, 0x1d, 0xbd, 0x04, 0x28, 0x55, 0x28, 0x59, 0xc3, 0xcd, 0xa4, 0x5e, 0x54, 0x36, 0xdd, 0x80,
+	0x05, 0x1e, 0x70, 0x92, 0x03, 0x80, 0x0d, 0xc1, 0x48, 0xc9, 0x5e, 0x81, 0x9a, 0xfa, 0x5e, 0x0b,
+	0xc9, 0x88, 0x60, 0x57, 0xa1, 0x5d, 0x55, 0x12, 0x4d, 0x41, 0x43, 0xaf, 0xc3, 0x7c, 0x32, 0xe4,
+	0x4c, 0x3f, 0xe5, 0x8c, 0x33, 0x17, 0xcd, 0x24, 0xe8, 0x02, 0xcc, 0x27, 0xe0, 0x3f, 0x23, 0x41,
+	0x20, 0x26, 0xf0, 0x1a, 0x6e, 0xfb, 0xf6, 0x91, 0x3e, 0x7b, 0x7c, 0x0d, 0xa7, 0x42, 0xb4, 0xed,
+	0xdb, 0x47, 0xa6, 0x50, 0x32, 0xbe, 0x51, 0x86, 0xc6, 0x18, 0x87, 0x8f, 0x78, 0x99, 0xa9, 0x53,
+	0x7e, 0x4b, 0x5f, 0x79, 0x0a, 0x70, 0x30, 0x33, 0x8a, 0xe8, 0x21, 0xa0, 0x20, 0xf4, 0x03, 0x9f,
+	0x92, 0x50, 0x0e, 0xc0, 0x8e, 0xd7, 0xa3, 0x7a, 0x49, 0x98, 0x5b, 0x2b, 0x9c, 0x61, 0x95, 0xc6,
+	0xbe, 0x52, 0x30, 0x17, 0x82, 0x31, 0x8a, 0x30, 0x2c, 0x37, 0xca, 0x18, 0x2e, 0x1f, 0x6f, 0x78,
+	0x4b, 0x69, 0x24, 0x86, 0xf1, 0x18, 0x85, 0xa2, 0x5b, 0x30, 0xa7, 0x46, 0x3c, 0xaa, 0x4f, 0x09,
+	0x73, 0x2b, 0x45, 0xe6, 0x76, 0xa4, 0x9c, 0x19, 0x2b, 0xa0, 0xb7, 0xa0, 0x31, 0xf2, 0xdd, 0xa1,
+	0xc7, 0x78, 0x03, 0xe4, 0x88, 0x42, 0xf5, 0x69, 0x61, 0xe3, 0x85, 0xc2, 0x6a, 0x8f, 0xc4, 0x77,
+	0x1f, 0x3b, 0xcc, 0xac, 0x8f, 0xd2, 0x4b, 0x6a, 0x7c, 0x53, 0x83, 0xea, 0x4e, 0x34, 0x70, 0x06,
+	0x43, 0x56, 0x88, 0xa0, 0xeb, 0x70, 0x26, 0x08, 0x7d, 0xbf, 0x6b, 0xf9, 0x5d, 0x2b, 0xf0, 0x29,
+	0x25, 0x34, 0x9e, 0xf6, 0xaa, 0x22, 0x7c, 0x7e, 0xf7, 0xed, 0xee, 0xbd, 0x98, 0x71, 0x32, 0xe2,
+	0x96, 0x4f, 0x44, 0x5c, 0xe3, 0x11, 0x20, 0x79, 0x53, 0xd8, 0xe5, 0xe3, 0x07, 0xb1, 0x4f, 0x39,
+	0x6b, 0xdc, 0x80, 0x85, 0xa2, 0x21, 0xa3, 0xd1, 0x1e, 0x6b, 0x97, 0x7f, 0xd4, 0xe0, 0xac, 0xb8,
+	0x23, 0xdc, 0x76, 0x49, 0x7a, 0x74, 0xfb, 0x08, 0x2c, 0x64, 0xd0, 0xca, 0xe9, 0x10, 0x99, 0xae,
+	0x53, 0x66, 0x33, 0x8d, 0x57, 0x9c, 0x9e, 0x3b, 0x77, 0x95, 0xf2, 0xe7, 0xae, 0xa8, 0x2d, 0x96,
+	0x9f, 0xa5, 0x2d, 0x9e, 0x7a, 0x68, 0xfb, 0x9a, 0x06, 0x15, 0x75, 0xcf, 0x22, 0x88, 0x7b, 0xe9,
+	0xaf, 0x8f, 0x60, 0xc8, 0x54, 0x77, 0xbe, 0x7a, 0x42, 0x26, 0x8a, 0x1c, 0x49, 0x7d, 0xa2, 0xa8,
+	0x8c, 0xc1, 0x03, 0x7f, 0xe8, 0x31, 0x15, 0x7c, 0xb5, 0xe2, 0x88, 0xc2, 0x3f, 0x59, 0x28, 0xc3,
+	0x83, 0x40, 0x81, 0x75, 0x42, 0x30, 0x7e, 0x5e, 0x82, 0xe6, 0x78, 0x19, 0xf2, 0xe9, 0x3a, 0x2e,
+	0xe6, 0x74, 0x63, 0xa8, 0x45, 0x54, 0xd9, 0x17, 0x4c, 0x68, 0x04, 0x2a, 0x2f, 0x24, 0x92, 0xb7,
+	0xc4, 0xd6, 0xc7, 0x7d, 0xb4, 0x4e, 0xa4, 0x51, 0x64, 0x13, 0xbb, 0x7c, 0xd5, 0x42, 0x1f, 0x85,
+	0xb3, 0xb1, 0xcd, 0x38, 0xa0, 0x56, 0x4b, 0xa5, 0x0b, 0x0a, 0x52, 0x06, 0x04, 0xab, 0x35, 0xe9,
+	0x85, 0x9c, 0x42, 0x9f, 0xc3, 0x8b, 0xcd, 0x02, 0x2f, 0xa2, 0x09, 0x75, 0xd2, 0x8b, 0x4d, 0xe3,
+	0x4f, 0x1a, 0x34, 0xc7, 0x51, 0x07, 0xd9, 0xb0, 0x4c, 0xa3, 0x5c, 0x4e, 0x7f, 0xdd, 0x5b, 0x2d,
+	0x75, 0xcf, 0x2f, 0x15, 0xb9, 0x98, 0x57, 0x02, 0xe6, 0x22, 0xcd, 0xa1, 0xb6, 0x8a, 0x77, 0xd9,
+	0xd4, 0x4b, 0xff, 0xad, 0x5d, 0x36, 0x8d, 0x0f, 0x34, 0x98, 0x55, 0xd9, 0xc7, 0xc3, 0x33, 0x20,
+	0xe1, 0xa1, 0x4b, 0x2c, 0x89, 0x45, 0xd1, 0x7b, 0x82, 0x26, 0x9e, 0x13, 0x90, 0xe4, 0xdd, 0xe3,
+	0xac, 0xe8, 0x29, 0xe1, 0x06, 0x2c, 0x28, 0x0d, 0x16, 0x12, 0xa2, 0x92, 0x4a, 0xe6, 0x69, 0x43,
+	0x32, 0x0e, 0x42, 0x42, 0x64, 0x5a, 0x5d, 0x86, 0x28, 0xb1, 0xad, 0xb8, 0x32, 0xab, 0x66, 0xc5,
+	0x4e, 0xca, 0xc6, 0x70, 0xa1, 0x96, 0xc1, 0xd3, 0x82, 0x59, 0x23, 0x67, 0xc2, 0x29, 0xe5, 0x4e,
+	0x38, 0x99, 0xae, 0x5b, 0x1e, 0xeb, 0xba, 0xc6, 0x17, 0x60, 0x2e, 0x7e, 0x7d, 0x58, 0x87, 0x33,
+	0x91, 0x73, 0x69, 0x34, 0x93, 0x20, 0xbd, 0xa0, 0x58, 0xa9, 0x99, 0xe1, 0x32, 0x54, 0x25, 0xf6,
+	0x65, 0xe6, 0x90, 0x8a, 0xa0, 0x29, 0xc8, 0x73, 0xa1, 0x9a, 0x7e, 0xa0, 0xc8, 0x4e, 0x10, 0xda,
+	0xa9, 0x27, 0x88, 0x8b, 0x00, 0x23, 0x9f, 0x11, 0xab, 0x93, 0xc2, 0x82, 0x79, 0x4e, 0xb9, 0xcd,
+	0x09, 0xc6, 0xf7, 0xa6, 0xa3, 0x29, 0x40, 0xbc, 0xa9, 0xef, 0x38, 0xdd, 0x2e, 0x8f, 0x13, 0xf5,
+	0x70, 0x40, 0xfb, 0xe3, 0x07, 0xaa, 0x47, 0x64, 0x75, 0x9a, 0x3b, 0x50, 0x0b, 0x70, 0xc8, 0x7b,
+	0x83, 0x25, 0x86, 0x23, 0x95, 0x60, 0x27, 0xbc, 0x08, 0x89, 0x8d, 0xcc, 0xaa, 0xd2, 0x14, 0x2b,
+	0xf4, 0x4e, 0xee, 0x53, 0xbe, 0x04, 0xe1, 0xeb, 0x27, 0x4e, 0xcc, 0x77, 0x1d, 0xca, 0xb8, 0xe7,
+	0x79, 0x4f, 0xfa, 0xf7, 0x73, 0xdf, 0xe2, 0x25, 0x24, 0xbc, 0x58, 0x64, 0xf9, 0xbe, 0xe3, 0xb1,
+	0x57, 0x3f, 0x96, 0x63, 0x36, 0x7e, 0xa6, 0xbb, 0x9f, 0xff, 0x66, 0x2f, 0x27, 0xbc, 0xc2, 0xae,
+	0xbf, 0x7d, 0xc4, 0x08, 0x4d, 0xcc, 0x4e, 0xbe, 0xec, 0xbf, 0x77, 0xdc, 0x83, 0xe5, 0xcc, 0x69,
+	0x8c, 0x17, 0x3d, 0x6b, 0xbe, 0x77, 0xdc, 0x0b, 0xe0, 0xec, 0x33, 0xec, 0x30, 0xf1, 0x4e, 0xf8,
+	0x6e, 0xf1, 0xd3, 0xe7, 0xdc, 0xa9, 0xc2, 0x9e, 0xff, 0x42, 0x6a, 0x7c, 0x59, 0x83, 0x85, 0x89,
+	0xab, 0xe7, 0xfd, 0xce, 0x25, 0x5e, 0x8f, 0x45, 0x35, 0xaf, 0x56, 0x48, 0x87, 0xd9, 0x68, 0x3c,
+	0x28, 0x89, 0xf1, 0x20, 0x5a, 0xa2, 0xd7, 0x60, 0x66, 0x84, 0xdd, 0x21, 0x89, 0xc6, 0xc7, 0xa7,
+	0xf8, 0xcb, 0x48, 0x29, 0x18, 0x9f, 0x83, 0x7a, 0xd6, 0xd7, 0x67, 0xd8, 0x7e, 0x29, 0xb3, 0xfd,
+	0x54, 0x6c, 0xfb, 0xb3, 0x50, 0xcb, 0xc4, 0xf9, 0xb9, 0x4d, 0x57, 0x23, 0xd3, 0xdb, 0xd5, 0x5f,
+	0x3c, 0xb9, 0xa4, 0xfd, 0xf2, 0xc9, 0x25, 0xed, 0xcf, 0x4f, 0x2e, 0x69, 0xed, 0x19, 0xf1, 0xaf,
+	0xda, 0x2b, 0xff, 0x19, 0x00, 0x32, 0xb1, 0xfe, 0xe6, 0xad, 0x1b, 0x00, 0x00,
 }
 
 func (m *BeaconState) Marshal() (dAtA []byte, err error) {
@@ -3249,54 +3558,317 @@ func (m *Eth1DataVote) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BeaconStateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *BeaconState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BeaconStateDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorRegistry) > 0 {
-		for _, e := range m.ValidatorRegistry {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.SnapshotHash32) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SnapshotHash32)))
+		i += copy(dAtA[i:], m.SnapshotHash32)
+	}
+	if m.PartialState != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.PartialState.Size()))
+		n23, err := m.PartialState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n23
 	}
-	if m.ValidatorRegistryUpdateEpoch != 0 {
-		n += 1 + sovTypes(uint64(m.ValidatorRegistryUpdateEpoch))
+	if m.ValidatorRegistry != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorRegistry.Size()))
+		n24, err := m.ValidatorRegistry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
 	}
-	if len(m.ValidatorBalances) > 0 {
-		l = 0
-		for _, e := range m.ValidatorBalances {
-			l += sovTypes(uint64(e))
+	if m.ValidatorBalances != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorBalances.Size()))
+		n25, err := m.ValidatorBalances.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovTypes(uint64(l)) + l
+		i += n25
 	}
-	if len(m.LatestRandaoMixes) > 0 {
-		for _, b := range m.LatestRandaoMixes {
-			l = len(b)
-			n += 2 + l + sovTypes(uint64(l))
+	if m.LatestRandaoMixes != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.LatestRandaoMixes.Size()))
+		n26, err := m.LatestRandaoMixes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n26
 	}
-	if m.PreviousShufflingStartShard != 0 {
-		n += 2 + sovTypes(uint64(m.PreviousShufflingStartShard))
+	if m.LatestBlockRootHash32S != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.LatestBlockRootHash32S.Size()))
+		n27, err := m.LatestBlockRootHash32S.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
 	}
-	if m.CurrentShufflingStartShard != 0 {
-		n += 2 + sovTypes(uint64(m.CurrentShufflingStartShard))
+	if m.LatestIndexRootHash32S != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.LatestIndexRootHash32S.Size()))
+		n28, err := m.LatestIndexRootHash32S.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
-	if m.PreviousShufflingEpoch != 0 {
-		n += 2 + sovTypes(uint64(m.PreviousShufflingEpoch))
+	if m.LatestSlashedBalances != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.LatestSlashedBalances.Size()))
+		n29, err := m.LatestSlashedBalances.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
-	if m.CurrentShufflingEpoch != 0 {
-		n += 2 + sovTypes(uint64(m.CurrentShufflingEpoch))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorListDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorListDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Length))
+	}
+	if len(m.Indices) > 0 {
+		dAtA31 := make([]byte, len(m.Indices)*10)
+		var j30 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j30++
+			}
+			dAtA31[j30] = uint8(num)
+			j30++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j30))
+		i += copy(dAtA[i:], dAtA31[:j30])
+	}
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Uint64ListDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Uint64ListDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Length))
+	}
+	if len(m.Indices) > 0 {
+		dAtA33 := make([]byte, len(m.Indices)*10)
+		var j32 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j32++
+			}
+			dAtA33[j32] = uint8(num)
+			j32++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j32))
+		i += copy(dAtA[i:], dAtA33[:j32])
+	}
+	if len(m.Values) > 0 {
+		dAtA35 := make([]byte, len(m.Values)*10)
+		var j34 int
+		for _, num := range m.Values {
+			for num >= 1<<7 {
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j34++
+			}
+			dAtA35[j34] = uint8(num)
+			j34++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j34))
+		i += copy(dAtA[i:], dAtA35[:j34])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BytesListDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BytesListDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Length))
+	}
+	if len(m.Indices) > 0 {
+		dAtA37 := make([]byte, len(m.Indices)*10)
+		var j36 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j36++
+			}
+			dAtA37[j36] = uint8(num)
+			j36++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j36))
+		i += copy(dAtA[i:], dAtA37[:j36])
+	}
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BeaconState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorRegistry) > 0 {
+		for _, e := range m.ValidatorRegistry {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ValidatorRegistryUpdateEpoch != 0 {
+		n += 1 + sovTypes(uint64(m.ValidatorRegistryUpdateEpoch))
+	}
+	if len(m.ValidatorBalances) > 0 {
+		l = 0
+		for _, e := range m.ValidatorBalances {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.LatestRandaoMixes) > 0 {
+		for _, b := range m.LatestRandaoMixes {
+			l = len(b)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.PreviousShufflingStartShard != 0 {
+		n += 2 + sovTypes(uint64(m.PreviousShufflingStartShard))
+	}
+	if m.CurrentShufflingStartShard != 0 {
+		n += 2 + sovTypes(uint64(m.CurrentShufflingStartShard))
+	}
+	if m.PreviousShufflingEpoch != 0 {
+		n += 2 + sovTypes(uint64(m.PreviousShufflingEpoch))
+	}
+	if m.CurrentShufflingEpoch != 0 {
+		n += 2 + sovTypes(uint64(m.CurrentShufflingEpoch))
 	}
 	l = len(m.PreviousShufflingSeedHash32)
 	if l > 0 {
@@ -3951,6 +4523,135 @@ func (m *Eth1DataVote) Size() (n int) {
 	return n
 }
 
+func (m *BeaconStateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SnapshotHash32)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PartialState != nil {
+		l = m.PartialState.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidatorRegistry != nil {
+		l = m.ValidatorRegistry.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidatorBalances != nil {
+		l = m.ValidatorBalances.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LatestRandaoMixes != nil {
+		l = m.LatestRandaoMixes.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LatestBlockRootHash32S != nil {
+		l = m.LatestBlockRootHash32S.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LatestIndexRootHash32S != nil {
+		l = m.LatestIndexRootHash32S.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LatestSlashedBalances != nil {
+		l = m.LatestSlashedBalances.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorListDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovTypes(uint64(m.Length))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Uint64ListDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovTypes(uint64(m.Length))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Values) > 0 {
+		l = 0
+		for _, e := range m.Values {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BytesListDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovTypes(uint64(m.Length))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	for {
 		n++
@@ -8373,6 +9074,935 @@ func (m *Eth1DataVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BeaconStateDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotHash32 = append(m.SnapshotHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.SnapshotHash32 == nil {
+				m.SnapshotHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartialState == nil {
+				m.PartialState = &BeaconState{}
+			}
+			if err := m.PartialState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRegistry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorRegistry == nil {
+				m.ValidatorRegistry = &ValidatorListDiff{}
+			}
+			if err := m.ValidatorRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorBalances == nil {
+				m.ValidatorBalances = &Uint64ListDiff{}
+			}
+			if err := m.ValidatorBalances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestRandaoMixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestRandaoMixes == nil {
+				m.LatestRandaoMixes = &BytesListDiff{}
+			}
+			if err := m.LatestRandaoMixes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockRootHash32S", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestBlockRootHash32S == nil {
+				m.LatestBlockRootHash32S = &BytesListDiff{}
+			}
+			if err := m.LatestBlockRootHash32S.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexRootHash32S", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestIndexRootHash32S == nil {
+				m.LatestIndexRootHash32S = &BytesListDiff{}
+			}
+			if err := m.LatestIndexRootHash32S.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSlashedBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestSlashedBalances == nil {
+				m.LatestSlashedBalances = &Uint64ListDiff{}
+			}
+			if err := m.LatestSlashedBalances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorListDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorListDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorListDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &Validator{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint64ListDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint64ListDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint64ListDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Values = append(m.Values, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Values) == 0 {
+					m.Values = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Values = append(m.Values, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BytesListDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BytesListDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BytesListDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, make([]byte, postIndex-iNdEx))
+			copy(m.Values[len(m.Values)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -87,3 +87,25 @@ func fillBit(target byte, index uint64) byte {
 	bitShift := 7 - index
 	return target ^ (1 << bitShift)
 }
+
+// OrBitfields returns the union of two bitfields of the same length.
+func OrBitfields(a []byte, b []byte) ([]byte, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("bitfield lengths do not match: %d != %d", len(a), len(b))
+	}
+	union := make([]byte, len(a))
+	for i := range a {
+		union[i] = a[i] | b[i]
+	}
+	return union, nil
+}
+
+// BitfieldsOverlap checks if two bitfields have a bit set at the same index.
+func BitfieldsOverlap(a []byte, b []byte) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i]&b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -117,3 +117,33 @@ func TestSetBitfield_LargerCommitteesThanIndex(t *testing.T) {
 
 	}
 }
+
+func TestOrBitfields(t *testing.T) {
+	union, err := OrBitfields([]byte{0x81, 0x00}, []byte{0x01, 0x10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(union, []byte{0x81, 0x10}) {
+		t.Errorf("Expected union %08b, received %08b", []byte{0x81, 0x10}, union)
+	}
+	if _, err := OrBitfields([]byte{1}, []byte{1, 0}); err == nil {
+		t.Error("Expected bitfields of different lengths to fail")
+	}
+}
+
+func TestBitfieldsOverlap(t *testing.T) {
+	tests := []struct {
+		a       []byte
+		b       []byte
+		overlap bool
+	}{
+		{a: []byte{0x80, 0x00}, b: []byte{0x40, 0x01}, overlap: false},
+		{a: []byte{0x80, 0x01}, b: []byte{0x40, 0x01}, overlap: true},
+		{a: []byte{}, b: []byte{0xff}, overlap: false},
+	}
+	for _, tt := range tests {
+		if overlap := BitfieldsOverlap(tt.a, tt.b); overlap != tt.overlap {
+			t.Errorf("Expected overlap of %08b and %08b to be %v", tt.a, tt.b, tt.overlap)
+		}
+	}
+}
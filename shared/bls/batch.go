@@ -0,0 +1,149 @@
+package bls
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/phoreproject/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// batchEntry is a signature over a message by the aggregate of its public keys.
+type batchEntry struct {
+	sig         *Signature
+	pubKeys     []*PublicKey
+	msg         [32]byte
+	domain      uint64
+	description string
+}
+
+// SignatureBatch collects signatures to verify them all at once. Instead of
+// checking e(pk_i, H(m_i)) == e(g1, sig_i) for every signature, which costs two
+// pairings each, it picks a random scalar r_i per signature and checks
+//
+//   e(g1, sum(r_i * sig_i)) == product(e(sum(r_i * pk_i), H(m)) for every distinct message m)
+//
+// which costs a single pairing per distinct message plus one. The random scalars
+// prevent invalid signatures from cancelling each other out in the sums.
+type SignatureBatch struct {
+	entries []*batchEntry
+}
+
+// NewSignatureBatch creates an empty signature batch.
+func NewSignatureBatch() *SignatureBatch {
+	return &SignatureBatch{}
+}
+
+// Add a signature over the message by the aggregate of the public keys to the
+// batch. The description identifies the signature in verification errors.
+func (b *SignatureBatch) Add(sig *Signature, pubKeys []*PublicKey, msg []byte, domain uint64, description string) {
+	b.entries = append(b.entries, &batchEntry{
+		sig:         sig,
+		pubKeys:     pubKeys,
+		msg:         bytesutil.ToBytes32(msg),
+		domain:      domain,
+		description: description,
+	})
+}
+
+// Len returns the number of signatures in the batch.
+func (b *SignatureBatch) Len() int {
+	return len(b.entries)
+}
+
+// Verify every signature of the batch. When the batch does not verify, the
+// signatures are checked one at a time and the error describes the first one
+// which is invalid.
+func (b *SignatureBatch) Verify() error {
+	if len(b.entries) == 0 {
+		return nil
+	}
+	ok, err := b.verifyBatch()
+	if err == nil && ok {
+		return nil
+	}
+	for _, entry := range b.entries {
+		if !entry.verify() {
+			return fmt.Errorf("%s signature did not verify", entry.description)
+		}
+	}
+	// Every signature verified on its own, the batch failed to decode a point.
+	return nil
+}
+
+// verify checks the signature of the entry on its own.
+func (e *batchEntry) verify() bool {
+	if len(e.pubKeys) == 0 {
+		return false
+	}
+	if len(e.pubKeys) == 1 {
+		return e.sig.Verify(e.msg[:], e.pubKeys[0], e.domain)
+	}
+	return e.sig.VerifyAggregate(e.pubKeys, e.msg[:], e.domain)
+}
+
+type messageKey struct {
+	msg    [32]byte
+	domain uint64
+}
+
+func (b *SignatureBatch) verifyBatch() (bool, error) {
+	sigSum := bls.G2ProjectiveZero
+	// Random combination of the public keys of each distinct message.
+	pubKeySums := make(map[messageKey]*bls.G1Projective)
+	var order []messageKey
+
+	for _, entry := range b.entries {
+		if len(entry.pubKeys) == 0 {
+			return false, errors.New("signature without public keys")
+		}
+		r, err := randomScalar()
+		if err != nil {
+			return false, err
+		}
+		sigPoint, err := bls.DecompressG2(entry.sig.val.Serialize())
+		if err != nil {
+			return false, fmt.Errorf("could not decode signature: %v", err)
+		}
+		sigSum = sigSum.Add(sigPoint.ToProjective().MulFR(r))
+
+		pubKeySum := bls.G1ProjectiveZero
+		for _, pub := range entry.pubKeys {
+			pubPoint, err := bls.DecompressG1(pub.val.Serialize())
+			if err != nil {
+				return false, fmt.Errorf("could not decode public key: %v", err)
+			}
+			pubKeySum = pubKeySum.Add(pubPoint.ToProjective())
+		}
+		key := messageKey{msg: entry.msg, domain: entry.domain}
+		if _, ok := pubKeySums[key]; !ok {
+			pubKeySums[key] = bls.G1ProjectiveZero
+			order = append(order, key)
+		}
+		pubKeySums[key] = pubKeySums[key].Add(pubKeySum.MulFR(r))
+	}
+
+	rhs := bls.FQ12One.Copy()
+	for _, key := range order {
+		h := bls.HashG2WithDomain(key.msg, key.domain)
+		rhs.MulAssign(bls.Pairing(pubKeySums[key], h))
+	}
+	lhs := bls.Pairing(bls.G1ProjectiveOne, sigSum)
+	return lhs.Equals(rhs), nil
+}
+
+// randomScalar returns a random non-zero 64 bit scalar, which is enough for
+// an invalid signature to go unnoticed with a probability of at most 2^-64.
+func randomScalar() (*bls.FRRepr, error) {
+	buf := make([]byte, 8)
+	for {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("could not generate random scalar: %v", err)
+		}
+		if r := binary.LittleEndian.Uint64(buf); r != 0 {
+			return bls.NewFRRepr(r), nil
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package bls_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+type signedMessage struct {
+	sig     *bls.Signature
+	pubKeys []*bls.PublicKey
+	msg     []byte
+	domain  uint64
+}
+
+// signedMessages returns n single signatures spread over the given number of
+// distinct messages.
+func signedMessages(tb testing.TB, n int, messages int) []*signedMessage {
+	signed := make([]*signedMessage, n)
+	for i := 0; i < n; i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		msg := []byte(fmt.Sprintf("message %d", i%messages))
+		signed[i] = &signedMessage{
+			sig:     priv.Sign(msg, 1),
+			pubKeys: []*bls.PublicKey{priv.PublicKey()},
+			msg:     msg,
+			domain:  1,
+		}
+	}
+	return signed
+}
+
+func TestSignatureBatch_Verify(t *testing.T) {
+	batch := bls.NewSignatureBatch()
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Expected empty batch to verify, received %v", err)
+	}
+	for i, s := range signedMessages(t, 8, 3) {
+		batch.Add(s.sig, s.pubKeys, s.msg, s.domain, fmt.Sprintf("signature %d", i))
+	}
+
+	// An aggregate signature of several keys over a single message.
+	var pubKeys []*bls.PublicKey
+	var sigs []*bls.Signature
+	msg := []byte("aggregate")
+	for i := 0; i < 4; i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg, 2))
+	}
+	batch.Add(bls.AggregateSignatures(sigs), pubKeys, msg, 2, "aggregate")
+
+	if batch.Len() != 9 {
+		t.Errorf("Expected 9 signatures in the batch, received %d", batch.Len())
+	}
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Expected batch to verify, received %v", err)
+	}
+}
+
+func TestSignatureBatch_PinpointsInvalidSignature(t *testing.T) {
+	signed := signedMessages(t, 8, 2)
+	batch := bls.NewSignatureBatch()
+	for i, s := range signed {
+		// Signature 5 is checked against the wrong domain.
+		domain := s.domain
+		if i == 5 {
+			domain++
+		}
+		batch.Add(s.sig, s.pubKeys, s.msg, domain, fmt.Sprintf("signature %d", i))
+	}
+	want := "signature 5 signature did not verify"
+	if err := batch.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestSignatureBatch_SwappedSignaturesDoNotVerify(t *testing.T) {
+	signed := signedMessages(t, 2, 2)
+	batch := bls.NewSignatureBatch()
+	// Both signatures are valid, but not for the messages they are added with,
+	// so only their sum matches the sum of the messages.
+	batch.Add(signed[1].sig, signed[0].pubKeys, signed[0].msg, signed[0].domain, "first")
+	batch.Add(signed[0].sig, signed[1].pubKeys, signed[1].msg, signed[1].domain, "second")
+	want := "first signature did not verify"
+	if err := batch.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func BenchmarkVerify_Individually(b *testing.B) {
+	signed := signedMessages(b, 64, 4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, s := range signed {
+			if !s.sig.Verify(s.msg, s.pubKeys[0], s.domain) {
+				b.Fatal("Signature did not verify")
+			}
+		}
+	}
+}
+
+func BenchmarkVerify_Batch(b *testing.B) {
+	signed := signedMessages(b, 64, 4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch := bls.NewSignatureBatch()
+		for _, s := range signed {
+			batch.Add(s.sig, s.pubKeys, s.msg, s.domain, "benchmark")
+		}
+		if err := batch.Verify(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -2,6 +2,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 )
 
@@ -107,6 +109,24 @@ var (
 			"would whitelist connections to peers on your local network only. The default " +
 			"is to accept all connections.",
 	}
+	// P2PBanThreshold defines the score below which a peer is banned.
+	P2PBanThreshold = cli.Float64Flag{
+		Name:  "p2p-ban-threshold",
+		Usage: "The score below which a peer is disconnected and banned.",
+		Value: -2000,
+	}
+	// P2PBanDuration defines how long a peer stays banned.
+	P2PBanDuration = cli.DurationFlag{
+		Name:  "p2p-ban-duration",
+		Usage: "How long a peer stays banned once its score drops below the ban threshold.",
+		Value: time.Hour,
+	}
+	// P2PScoreHalfLife defines the half life of the peer scores.
+	P2PScoreHalfLife = cli.DurationFlag{
+		Name:  "p2p-score-half-life",
+		Usage: "The time after which half of a peer score is forgiven.",
+		Value: 10 * time.Minute,
+	}
 	// ClearDB tells the beacon node to remove any previously stored data at the data directory.
 	ClearDB = cli.BoolFlag{
 		Name:  "clear-db",
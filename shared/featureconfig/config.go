@@ -34,6 +34,7 @@ type FeatureFlagConfig struct {
 	EnableCommitteesCache         bool // EnableCommitteesCache for state transition.
 	CacheTreeHash                 bool // CacheTreeHash determent whether tree hashes will be cached.
 	EnableExcessDeposits          bool // EnableExcessDeposits in validator balances.
+	DisableSignatureVerification  bool // DisableSignatureVerification of blocks and attestations in block processing.
 }
 
 var featureConfig *FeatureFlagConfig
@@ -87,6 +88,10 @@ func ConfigureBeaconFeatures(ctx *cli.Context) {
 		log.Info("Enabled excess deposits")
 		cfg.EnableExcessDeposits = true
 	}
+	if ctx.GlobalBool(DisableSignatureVerificationFlag.Name) {
+		log.Warn("Disabled signature verification in block processing")
+		cfg.DisableSignatureVerification = true
+	}
 	InitFeatureConfig(cfg)
 }
 
@@ -51,6 +51,13 @@ var (
 		Name:  "enables-excess-deposit",
 		Usage: "Enables balances more than max deposit amount for a validator",
 	}
+	// DisableSignatureVerificationFlag skips the verification of the proposer signature,
+	// randao reveal and attestation signatures of blocks. It is meant for test networks
+	// running validator clients which do not sign their blocks and attestations.
+	DisableSignatureVerificationFlag = cli.BoolFlag{
+		Name:  "disable-signature-verification",
+		Usage: "Disable the BLS signature verification of blocks and attestations in block processing",
+	}
 )
 
 // ValidatorFlags contains a list of all the feature flags that apply to the validator client.
@@ -67,4 +74,5 @@ var BeaconChainFlags = []cli.Flag{
 	DisableGossipSubFlag,
 	CacheTreeHashFlag,
 	EnableExcessDepositsFlag,
+	DisableSignatureVerificationFlag,
 }
@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // HashBeaconBlock hashes the full block without the proposer signature.
@@ -20,3 +21,41 @@ func HashBeaconBlock(bb *pb.BeaconBlock) ([32]byte, error) {
 
 	return HashProto(bb)
 }
+
+// HashProposal hashes the proposal signed data of the block, which is the
+// message signed by the block proposer:
+//   hash_tree_root(Proposal(slot=block.slot, shard=BEACON_CHAIN_SHARD_NUMBER,
+//     block_root=hash_tree_root(block without signature)))
+func HashProposal(bb *pb.BeaconBlock) ([32]byte, error) {
+	blockRoot, err := HashBeaconBlock(bb)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return HashProto(&pb.ProposalSignedData{
+		Slot:            bb.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: blockRoot[:],
+	})
+}
+
+// HashAttestationData hashes the attestation data together with a custody bit,
+// which is the message signed by attesters:
+//   hash_tree_root(AttestationDataAndCustodyBit(data=data, custody_bit=custodyBit))
+func HashAttestationData(data *pb.AttestationData, custodyBit bool) ([32]byte, error) {
+	return HashProto(&pb.AttestationDataAndCustodyBit{
+		Data:       data,
+		CustodyBit: custodyBit,
+	})
+}
+
+// HashVoluntaryExit hashes the exit without its signature, which is the message
+// signed by the exiting validator.
+func HashVoluntaryExit(exit *pb.VoluntaryExit) ([32]byte, error) {
+	if exit == nil {
+		return [32]byte{}, ErrNilProto
+	}
+	return HashProto(&pb.VoluntaryExit{
+		Epoch:          exit.Epoch,
+		ValidatorIndex: exit.ValidatorIndex,
+	})
+}
@@ -41,3 +41,27 @@ func TestHashBeaconBlock_nil(t *testing.T) {
 		t.Fatalf("Error from hashing nil block is not the correct type, instead it is: %v", err)
 	}
 }
+
+func TestHashProposal_IgnoresSignature(t *testing.T) {
+	unsigned := &pb.BeaconBlock{Slot: 5, StateRootHash32: []byte{'A'}}
+	signed := &pb.BeaconBlock{Slot: 5, StateRootHash32: []byte{'A'}, Signature: []byte{'S', 'I', 'G'}}
+	unsignedRoot, err := hashutil.HashProposal(unsigned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedRoot, err := hashutil.HashProposal(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unsignedRoot != signedRoot {
+		t.Errorf("Expected proposal hash to ignore the signature, %#x != %#x", unsignedRoot, signedRoot)
+	}
+
+	other, err := hashutil.HashProposal(&pb.BeaconBlock{Slot: 6, StateRootHash32: []byte{'A'}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == unsignedRoot {
+		t.Error("Expected proposals of different blocks to hash differently")
+	}
+}
@@ -7,17 +7,7 @@ import (
 	"github.com/libp2p/go-libp2p"
 	connmgr "github.com/libp2p/go-libp2p-connmgr"
 	peer "github.com/libp2p/go-libp2p-peer"
-)
-
-// Reputation reward values.
-const (
-	RepRewardValidBlock       = 4
-	RepRewardValidAttestation = 1
-
-	RepPenalityInvalidProtobuf    = -1000
-	RepPenalityInitialSyncFailure = -500
-	RepPenalityInvalidBlock       = -10
-	RepPenalityInvalidAttestation = -5
+	"github.com/sirupsen/logrus"
 )
 
 func optionConnectionManager(maxPeers int) libp2p.Option {
@@ -31,14 +21,25 @@ func optionConnectionManager(maxPeers int) libp2p.Option {
 	return libp2p.ConnectionManager(cm)
 }
 
-// Reputation adds (or subtracts) a given reward/penalty against a peer.
-// Eventually, the lowest scoring peers will be pruned from the connections.
-func (s *Server) Reputation(peer peer.ID, val int) {
-	ti := s.host.ConnManager().GetTagInfo(peer)
-	if ti != nil {
-		val += ti.Value
+// Reputation records the behaviour of a peer in its score. The score is also
+// tagged on the peer so that the lowest scoring peers are pruned first when the
+// connection limit is reached, and peers whose score drops below the ban
+// threshold are disconnected and refused until their ban expires.
+func (s *Server) Reputation(peer peer.ID, behaviour Behaviour) {
+	score, banned := s.scorer.record(peer, behaviour)
+	s.host.ConnManager().TagPeer(peer, TagReputation, int(score))
+	if banned {
+		log.WithFields(logrus.Fields{
+			"peer":  peer.Pretty(),
+			"score": score,
+		}).Warn("Banning peer with a low score")
+		s.Disconnect(peer)
 	}
-	s.host.ConnManager().TagPeer(peer, TagReputation, val)
+}
+
+// PeerScores returns the score of every peer the server keeps track of, lowest first.
+func (s *Server) PeerScores() []*PeerScore {
+	return s.scorer.scores()
 }
 
 // Disconnect will close all connections to the given peer.
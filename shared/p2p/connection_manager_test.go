@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"testing"
+	"time"
 
 	libp2p "github.com/libp2p/go-libp2p"
 	host "github.com/libp2p/go-libp2p-host"
@@ -41,33 +42,40 @@ func (*tconn) RemoteMultiaddr() ma.Multiaddr {
 
 func TestReputation(t *testing.T) {
 	h := hostWithConnMgr(t)
+	scorer, err := newPeerScorer(nil, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	scorer.now = func() time.Time { return now }
 
 	s := &Server{
-		host: h,
+		host:   h,
+		scorer: scorer,
 	}
 
 	pid := tu.RandPeerIDFatal(t)
 
 	h.ConnManager().Notifee().Connected(h.Network(), &tconn{pid: pid})
 
-	s.Reputation(pid, 5)
-	if h.ConnManager().GetTagInfo(pid).Value != 5 {
-		t.Fatal("Expected value 5")
+	s.Reputation(pid, RepRewardValidBlock)
+	if h.ConnManager().GetTagInfo(pid).Value != 4 {
+		t.Fatal("Expected value 4")
 	}
 
-	s.Reputation(pid, -10)
-	if h.ConnManager().GetTagInfo(pid).Value != -5 {
-		t.Fatal("Expected value -5")
+	s.Reputation(pid, RepPenalityInvalidBlock)
+	if h.ConnManager().GetTagInfo(pid).Value != -6 {
+		t.Fatal("Expected value -6")
 	}
 
-	s.Reputation(pid, -10)
-	if h.ConnManager().GetTagInfo(pid).Value != -15 {
-		t.Fatal("Expected value -15")
+	s.Reputation(pid, RepPenalityInvalidBlock)
+	if h.ConnManager().GetTagInfo(pid).Value != -16 {
+		t.Fatal("Expected value -16")
 	}
 
-	s.Reputation(pid, 100)
-	if h.ConnManager().GetTagInfo(pid).Value != 85 {
-		t.Fatal("Expected value 85")
+	now = now.Add(DefaultScoreHalfLife)
+	s.Reputation(pid, RepRewardValidAttestation)
+	if h.ConnManager().GetTagInfo(pid).Value != -7 {
+		t.Fatal("Expected value -7 after the score halved")
 	}
-
 }
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	peer "github.com/libp2p/go-libp2p-peer"
@@ -25,5 +26,13 @@ type Subscriber interface {
 // ReputationManager represents a subset of the p2p.Server which enables
 // reputaiton reporting of peers.
 type ReputationManager interface {
-	Reputation(peer peer.ID, val int)
+	Reputation(peer peer.ID, behaviour Behaviour)
+}
+
+// BanStore persists the bans of misbehaving peers across restarts. Peers are
+// identified by their base58 encoded ID.
+type BanStore interface {
+	SavePeerBan(peerID string, until time.Time) error
+	DeletePeerBan(peerID string) error
+	PeerBans() (map[string]time.Time, error)
 }
@@ -0,0 +1,307 @@
+package p2p
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	host "github.com/libp2p/go-libp2p-host"
+	inet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Behaviour is an action of a peer which is rewarded or penalized in its score.
+type Behaviour int
+
+// Peer behaviours reported to the p2p server. The score each one adds to or
+// subtracts from a peer is defined in behaviourWeights.
+const (
+	RepRewardValidBlock Behaviour = iota
+	RepRewardValidAttestation
+	RepRewardValidSlashing
+	RepRewardUsefulResponse
+
+	RepPenalityInvalidProtobuf
+	RepPenalityInitialSyncFailure
+	RepPenalityInvalidBlock
+	RepPenalityInvalidAttestation
+	RepPenalityInvalidSlashing
+	RepPenalityRateLimitExceeded
+	RepPenalityRequestTimeout
+)
+
+var behaviourWeights = map[Behaviour]float64{
+	RepRewardValidBlock:       4,
+	RepRewardValidAttestation: 1,
+	RepRewardValidSlashing:    1,
+	RepRewardUsefulResponse:   2,
+
+	RepPenalityInvalidProtobuf:    -1000,
+	RepPenalityInitialSyncFailure: -500,
+	RepPenalityInvalidBlock:       -10,
+	RepPenalityInvalidAttestation: -5,
+	RepPenalityInvalidSlashing:    -5,
+	RepPenalityRateLimitExceeded:  -20,
+	RepPenalityRequestTimeout:     -50,
+}
+
+var behaviourNames = map[Behaviour]string{
+	RepRewardValidBlock:       "valid_block",
+	RepRewardValidAttestation: "valid_attestation",
+	RepRewardValidSlashing:    "valid_slashing",
+	RepRewardUsefulResponse:   "useful_response",
+
+	RepPenalityInvalidProtobuf:    "invalid_protobuf",
+	RepPenalityInitialSyncFailure: "initial_sync_failure",
+	RepPenalityInvalidBlock:       "invalid_block",
+	RepPenalityInvalidAttestation: "invalid_attestation",
+	RepPenalityInvalidSlashing:    "invalid_slashing",
+	RepPenalityRateLimitExceeded:  "rate_limit_exceeded",
+	RepPenalityRequestTimeout:     "request_timeout",
+}
+
+func (b Behaviour) String() string {
+	if name, ok := behaviourNames[b]; ok {
+		return name
+	}
+	return fmt.Sprintf("behaviour(%d)", int(b))
+}
+
+const (
+	// DefaultBanThreshold is the score below which a peer is disconnected and banned.
+	DefaultBanThreshold = -2000
+	// DefaultBanDuration is how long a banned peer is refused.
+	DefaultBanDuration = time.Hour
+	// DefaultScoreHalfLife is the time after which half of a peer's score has decayed.
+	DefaultScoreHalfLife = 10 * time.Minute
+
+	// maxScoredPeers is the number of tracked peers above which the records of
+	// peers whose score has decayed to about zero are dropped.
+	maxScoredPeers = 1000
+)
+
+var (
+	peerBehaviourMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_peer_behaviours",
+		Help: "The number of reported peer behaviours, by behaviour",
+	}, []string{"behaviour"})
+	bannedPeersMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_banned_peers",
+		Help: "The number of peers banned for falling below the score threshold",
+	})
+)
+
+// PeerScore is a snapshot of the score of a peer and the behaviours reported for it.
+type PeerScore struct {
+	Peer        peer.ID
+	Score       float64
+	Behaviours  map[Behaviour]uint64
+	BannedUntil time.Time // Zero if the peer is not banned.
+}
+
+type peerRecord struct {
+	score      float64
+	updated    time.Time
+	behaviours map[Behaviour]uint64
+}
+
+// peerScorer keeps a score per peer which decays exponentially towards zero. Peers
+// whose score drops below the threshold are banned for a while, and the bans are
+// written to the ban store so that they survive restarts.
+type peerScorer struct {
+	lock        sync.Mutex
+	records     map[peer.ID]*peerRecord
+	bans        map[peer.ID]time.Time
+	threshold   float64
+	banDuration time.Duration
+	halfLife    time.Duration
+	store       BanStore
+	now         func() time.Time
+}
+
+// newPeerScorer creates a scorer with the bans which have not expired yet loaded
+// from the store. A nil store keeps bans in memory only.
+func newPeerScorer(store BanStore, threshold float64, banDuration time.Duration, halfLife time.Duration) (*peerScorer, error) {
+	if threshold == 0 {
+		threshold = DefaultBanThreshold
+	}
+	if banDuration == 0 {
+		banDuration = DefaultBanDuration
+	}
+	if halfLife == 0 {
+		halfLife = DefaultScoreHalfLife
+	}
+	s := &peerScorer{
+		records:     make(map[peer.ID]*peerRecord),
+		bans:        make(map[peer.ID]time.Time),
+		threshold:   threshold,
+		banDuration: banDuration,
+		halfLife:    halfLife,
+		store:       store,
+		now:         time.Now,
+	}
+	if store == nil {
+		return s, nil
+	}
+
+	bans, err := store.PeerBans()
+	if err != nil {
+		return nil, fmt.Errorf("could not load peer bans: %v", err)
+	}
+	now := s.now()
+	for id, until := range bans {
+		p, err := peer.IDB58Decode(id)
+		if err != nil || !until.After(now) {
+			s.deleteBan(id)
+			continue
+		}
+		s.bans[p] = until
+	}
+	if len(s.bans) > 0 {
+		log.WithField("peers", len(s.bans)).Info("Loaded peer bans")
+	}
+	return s, nil
+}
+
+// record applies the weight of the behaviour to the decayed score of the peer. It
+// returns the new score and whether the peer got banned because of it.
+func (s *peerScorer) record(p peer.ID, b Behaviour) (float64, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	peerBehaviourMetric.WithLabelValues(b.String()).Inc()
+
+	now := s.now()
+	r, ok := s.records[p]
+	if !ok {
+		if len(s.records) >= maxScoredPeers {
+			s.prune(now)
+		}
+		r = &peerRecord{updated: now, behaviours: make(map[Behaviour]uint64)}
+		s.records[p] = r
+	}
+	r.score = s.decayed(r, now) + behaviourWeights[b]
+	r.updated = now
+	r.behaviours[b]++
+
+	if r.score >= s.threshold || s.isBannedAt(p, now) {
+		return r.score, false
+	}
+	score := r.score
+	// The peer starts over with a neutral score once its ban expires.
+	r.score = 0
+	until := now.Add(s.banDuration)
+	s.bans[p] = until
+	bannedPeersMetric.Inc()
+	if s.store != nil {
+		if err := s.store.SavePeerBan(peer.IDB58Encode(p), until); err != nil {
+			log.WithError(err).WithField("peer", p.Pretty()).Error("Could not save peer ban")
+		}
+	}
+	return score, true
+}
+
+// isBanned reports whether the peer is currently banned.
+func (s *peerScorer) isBanned(p peer.ID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.isBannedAt(p, s.now())
+}
+
+// isBannedAt reports whether the peer is banned at the given time, lifting the ban
+// if it expired. The caller must hold the lock.
+func (s *peerScorer) isBannedAt(p peer.ID, now time.Time) bool {
+	until, ok := s.bans[p]
+	if !ok {
+		return false
+	}
+	if now.Before(until) {
+		return true
+	}
+	delete(s.bans, p)
+	s.deleteBan(peer.IDB58Encode(p))
+	return false
+}
+
+// scores returns the current score of every tracked or banned peer, lowest first.
+func (s *peerScorer) scores() []*PeerScore {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	scores := make(map[peer.ID]*PeerScore)
+	for p, r := range s.records {
+		behaviours := make(map[Behaviour]uint64, len(r.behaviours))
+		for b, count := range r.behaviours {
+			behaviours[b] = count
+		}
+		scores[p] = &PeerScore{Peer: p, Score: s.decayed(r, now), Behaviours: behaviours}
+	}
+	for p := range s.bans {
+		if !s.isBannedAt(p, now) {
+			continue
+		}
+		if _, ok := scores[p]; !ok {
+			scores[p] = &PeerScore{Peer: p, Behaviours: make(map[Behaviour]uint64)}
+		}
+		scores[p].BannedUntil = s.bans[p]
+	}
+
+	list := make([]*PeerScore, 0, len(scores))
+	for _, score := range scores {
+		list = append(list, score)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Score != list[j].Score {
+			return list[i].Score < list[j].Score
+		}
+		return list[i].Peer < list[j].Peer
+	})
+	return list
+}
+
+func (s *peerScorer) decayed(r *peerRecord, now time.Time) float64 {
+	elapsed := now.Sub(r.updated).Seconds()
+	return r.score * math.Exp2(-elapsed/s.halfLife.Seconds())
+}
+
+// prune drops the records of peers whose score decayed to about zero. The caller
+// must hold the lock.
+func (s *peerScorer) prune(now time.Time) {
+	for p, r := range s.records {
+		if math.Abs(s.decayed(r, now)) < 1 {
+			delete(s.records, p)
+		}
+	}
+}
+
+func (s *peerScorer) deleteBan(id string) {
+	if s.store == nil {
+		return
+	}
+	if err := s.store.DeletePeerBan(id); err != nil {
+		log.WithError(err).WithField("peer", id).Error("Could not delete peer ban")
+	}
+}
+
+// rejectBannedPeers closes every new connection with a banned peer.
+func rejectBannedPeers(h host.Host, s *peerScorer) {
+	h.Network().Notify(&inet.NotifyBundle{
+		ConnectedF: func(net inet.Network, conn inet.Conn) {
+			p := conn.RemotePeer()
+			if !s.isBanned(p) {
+				return
+			}
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go func() {
+				log.WithField("peer", p.Pretty()).Debug("Rejecting connection from banned peer")
+				if err := net.ClosePeer(p); err != nil {
+					log.WithError(err).Error("Failed to disconnect banned peer")
+				}
+			}()
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+type mockBanStore struct {
+	bans map[string]time.Time
+}
+
+func (ms *mockBanStore) SavePeerBan(peerID string, until time.Time) error {
+	ms.bans[peerID] = until
+	return nil
+}
+
+func (ms *mockBanStore) DeletePeerBan(peerID string) error {
+	delete(ms.bans, peerID)
+	return nil
+}
+
+func (ms *mockBanStore) PeerBans() (map[string]time.Time, error) {
+	bans := make(map[string]time.Time)
+	for id, until := range ms.bans {
+		bans[id] = until
+	}
+	return bans, nil
+}
+
+func TestPeerScorer_BansBelowThreshold(t *testing.T) {
+	store := &mockBanStore{bans: make(map[string]time.Time)}
+	scorer, err := newPeerScorer(store, -1500, time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	scorer.now = func() time.Time { return now }
+	p := peer.ID("a")
+
+	if _, banned := scorer.record(p, RepPenalityInvalidProtobuf); banned {
+		t.Fatal("Expected peer above the threshold not to be banned")
+	}
+	score, banned := scorer.record(p, RepPenalityInitialSyncFailure)
+	if !banned {
+		t.Fatal("Expected peer below the threshold to be banned")
+	}
+	if score != -1500 {
+		t.Errorf("Expected score -1500, received %f", score)
+	}
+	if !scorer.isBanned(p) {
+		t.Error("Expected peer to be banned")
+	}
+	if _, ok := store.bans[peer.IDB58Encode(p)]; !ok {
+		t.Error("Expected ban to be saved")
+	}
+
+	// A restarted scorer keeps refusing the peer.
+	restarted, err := newPeerScorer(store, -1500, time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted.now = scorer.now
+	if !restarted.isBanned(p) {
+		t.Error("Expected ban to be loaded from the store")
+	}
+
+	now = now.Add(time.Hour)
+	if restarted.isBanned(p) {
+		t.Error("Expected ban to expire")
+	}
+	if len(store.bans) != 0 {
+		t.Errorf("Expected expired ban to be deleted, %d bans left", len(store.bans))
+	}
+}
+
+func TestPeerScorer_Scores(t *testing.T) {
+	scorer, err := newPeerScorer(nil, 0, 0, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	scorer.now = func() time.Time { return now }
+
+	scorer.record(peer.ID("a"), RepRewardValidBlock)
+	scorer.record(peer.ID("a"), RepRewardValidBlock)
+	scorer.record(peer.ID("b"), RepPenalityInvalidBlock)
+	now = now.Add(time.Minute)
+
+	scores := scorer.scores()
+	if len(scores) != 2 {
+		t.Fatalf("Expected 2 scores, received %d", len(scores))
+	}
+	if scores[0].Peer != peer.ID("b") || scores[0].Score != -5 {
+		t.Errorf("Expected lowest score -5 for peer b, received %f for %s", scores[0].Score, scores[0].Peer)
+	}
+	if scores[1].Score != 4 {
+		t.Errorf("Expected decayed score 4, received %f", scores[1].Score)
+	}
+	if scores[1].Behaviours[RepRewardValidBlock] != 2 {
+		t.Errorf("Expected 2 valid blocks, received %d", scores[1].Behaviours[RepRewardValidBlock])
+	}
+	if !scores[0].BannedUntil.IsZero() {
+		t.Error("Expected peer b not to be banned")
+	}
+}
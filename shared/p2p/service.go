@@ -61,6 +61,7 @@ type Server struct {
 	relayNodeAddr string
 	noDiscovery   bool
 	staticPeers   []string
+	scorer        *peerScorer
 }
 
 // ServerConfig for peer to peer networking.
@@ -76,6 +77,10 @@ type ServerConfig struct {
 	DepositContractAddress string
 	WhitelistCIDR          string
 	EnableUPnP             bool
+	BanStore               BanStore
+	BanThreshold           float64
+	BanDuration            time.Duration
+	ScoreHalfLife          time.Duration
 }
 
 // NewServer creates a new p2p server instance.
@@ -99,6 +104,11 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		cancel()
 		return nil, fmt.Errorf("error listening on p2p, port %d already taken", cfg.Port)
 	}
+	scorer, err := newPeerScorer(cfg.BanStore, cfg.BanThreshold, cfg.BanDuration, cfg.ScoreHalfLife)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
 	h, err := libp2p.New(ctx, opts...)
 	if err != nil {
 		cancel()
@@ -151,6 +161,7 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		exclusions = append(exclusions, info.ID)
 		h.ConnManager().Protect(info.ID, TagReputation)
 	}
+	rejectBannedPeers(h, scorer)
 	setupPeerNegotiation(h, cfg.DepositContractAddress, exclusions)
 	setHandshakeHandler(h, cfg.DepositContractAddress)
 
@@ -167,6 +178,7 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		relayNodeAddr: cfg.RelayNodeAddr,
 		noDiscovery:   cfg.NoDiscovery,
 		staticPeers:   cfg.StaticPeers,
+		scorer:        scorer,
 	}, nil
 }
 
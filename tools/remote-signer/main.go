@@ -0,0 +1,124 @@
+// Package main runs a reference remote signer which holds validator keys
+// from a local keystore and signs on behalf of validator clients over gRPC.
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/signer"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	host         = flag.String("host", "127.0.0.1", "The host address to serve gRPC on, serving on other than a loopback address requires mutual TLS")
+	port         = flag.Int("port", 7500, "The port to serve gRPC")
+	keystorePath = flag.String("keystore-path", "", "Path to the validator keystore directory")
+	password     = flag.String("password", "", "Password to unlock the validator keystore")
+	dbPath       = flag.String("db-path", "", "Path to the slashing protection database directory, defaults to the keystore path")
+	tlsCert      = flag.String("tls-cert", "", "Certificate for secure gRPC, requires --tls-key")
+	tlsKey       = flag.String("tls-key", "", "Key for secure gRPC, requires --tls-cert")
+	tlsClientCA  = flag.String("tls-client-ca", "", "CA certificate validator clients must present a certificate of, enables mutual TLS")
+	verbose      = flag.Bool("verbose", false, "Enable debug logging")
+)
+
+var log = logrus.WithField("prefix", "remote-signer")
+
+func main() {
+	flag.Parse()
+	if *verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	ks := keystore.NewKeystore(*keystorePath)
+	keys, err := ks.GetKeys(*keystorePath, params.BeaconConfig().ValidatorPrivkeyFileName, *password)
+	if err != nil {
+		log.Fatalf("Could not read keystore: %v", err)
+	}
+	if len(keys) == 0 {
+		log.Fatalf("No validator keys found in %s", *keystorePath)
+	}
+	for id := range keys {
+		log.WithField("publicKey", fmt.Sprintf("%#x", keys[id].PublicKey.Marshal())).Info("Loaded validator key")
+	}
+
+	if *dbPath == "" {
+		*dbPath = *keystorePath
+	}
+	protection, err := db.NewDB(*dbPath)
+	if err != nil {
+		log.Fatalf("Could not open slashing protection database: %v", err)
+	}
+	defer protection.Close()
+
+	if !isLoopback(*host) && *tlsClientCA == "" {
+		log.Fatalf("Refusing to serve on %s without mutual TLS, provide --tls-client-ca or serve on a loopback address", *host)
+	}
+	var opts []grpc.ServerOption
+	if *tlsCert != "" && *tlsKey != "" {
+		creds, err := serverCredentials(*tlsCert, *tlsKey, *tlsClientCA)
+		if err != nil {
+			log.Fatalf("Could not load TLS keys: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else if *tlsClientCA != "" {
+		log.Fatal("Mutual TLS requires --tls-cert and --tls-key")
+	} else {
+		log.Warn("You are using an insecure gRPC connection! Provide a certificate and key to connect securely")
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterRemoteSignerServer(s, signer.NewServer(signer.NewLocalSigner(keys), protection))
+
+	address := net.JoinHostPort(*host, fmt.Sprintf("%d", *port))
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Fatalf("Could not listen to %s: %v", address, err)
+	}
+	log.Infof("Listening for gRPC requests on %s", address)
+	if err := s.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// serverCredentials loads the TLS certificate of the signer, and when a client CA
+// is given, requires clients to present a certificate signed by it.
+func serverCredentials(certFile string, keyFile string, clientCAFile string) (credentials.TransportCredentials, error) {
+	if clientCAFile == "" {
+		return credentials.NewServerTLSFromFile(certFile, keyFile)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := ioutil.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read client CA: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificate found in client CA %s", clientCAFile)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}), nil
+}
@@ -14,6 +14,7 @@ type fakeValidator struct {
 	DoneCalled                       bool
 	WaitForActivationCalled          bool
 	WaitForChainStartCalled          bool
+	CheckDoppelgangerCalled          bool
 	NextSlotRet                      <-chan uint64
 	NextSlotCalled                   bool
 	CanonicalHeadSlotCalled          bool
@@ -46,6 +47,11 @@ func (fv *fakeValidator) WaitForActivation(_ context.Context) error {
 	return nil
 }
 
+func (fv *fakeValidator) CheckDoppelganger(_ context.Context) error {
+	fv.CheckDoppelgangerCalled = true
+	return nil
+}
+
 func (fv *fakeValidator) CanonicalHeadSlot(_ context.Context) (uint64, error) {
 	fv.CanonicalHeadSlotCalled = true
 	return params.BeaconConfig().GenesisSlot, nil
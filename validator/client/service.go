@@ -2,12 +2,17 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -19,15 +24,21 @@ var log = logrus.WithField("prefix", "validator")
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
-	ctx                  context.Context
-	cancel               context.CancelFunc
-	validator            Validator
-	conn                 *grpc.ClientConn
-	endpoint             string
-	withCert             string
-	key                  *keystore.Key
-	keys                 map[string]*keystore.Key
-	logValidatorBalances bool
+	ctx                    context.Context
+	cancel                 context.CancelFunc
+	validator              Validator
+	conn                   *grpc.ClientConn
+	endpoint               string
+	withCert               string
+	signer                 signer.Signer
+	signerConn             *grpc.ClientConn
+	remoteSigner           string
+	remoteSignerCert       string
+	remoteSignerClientCert string
+	remoteSignerClientKey  string
+	validatorDB            *db.ValidatorDB
+	logValidatorBalances   bool
+	doppelgangerEpochs     uint64
 }
 
 // Config for the validator service.
@@ -36,59 +47,76 @@ type Config struct {
 	CertFlag             string
 	KeystorePath         string
 	Password             string
+	ValidatorDB          *db.ValidatorDB
 	LogValidatorBalances bool
+	// DoppelgangerEpochs is the number of epochs to watch for activity of the
+	// validators before performing their duties, 0 disables the check.
+	DoppelgangerEpochs uint64
+	// RemoteSigner is the endpoint of a remote signer holding the keys of the
+	// validators, the keystore is not used when it is set.
+	RemoteSigner     string
+	RemoteSignerCert string
+	// RemoteSignerClientCert and RemoteSignerClientKey are the certificate the
+	// validator client presents to a remote signer requiring mutual TLS.
+	RemoteSignerClientCert string
+	RemoteSignerClientKey  string
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	validatorFolder := cfg.KeystorePath
-	validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
-	ks := keystore.NewKeystore(cfg.KeystorePath)
-	keys, err := ks.GetKeys(validatorFolder, validatorPrefix, cfg.Password)
-	if err != nil {
-		cancel()
-		return nil, fmt.Errorf("could not get private key: %v", err)
-	}
-	var key *keystore.Key
-	for _, v := range keys {
-		key = v
-		break
+	var localSigner signer.Signer
+	if cfg.RemoteSigner == "" {
+		validatorFolder := cfg.KeystorePath
+		validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
+		ks := keystore.NewKeystore(cfg.KeystorePath)
+		keys, err := ks.GetKeys(validatorFolder, validatorPrefix, cfg.Password)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not get private key: %v", err)
+		}
+		localSigner = signer.NewLocalSigner(keys)
 	}
 	return &ValidatorService{
-		ctx:                  ctx,
-		cancel:               cancel,
-		endpoint:             cfg.Endpoint,
-		withCert:             cfg.CertFlag,
-		keys:                 keys,
-		key:                  key,
-		logValidatorBalances: cfg.LogValidatorBalances,
+		ctx:                    ctx,
+		cancel:                 cancel,
+		endpoint:               cfg.Endpoint,
+		withCert:               cfg.CertFlag,
+		signer:                 localSigner,
+		remoteSigner:           cfg.RemoteSigner,
+		remoteSignerCert:       cfg.RemoteSignerCert,
+		remoteSignerClientCert: cfg.RemoteSignerClientCert,
+		remoteSignerClientKey:  cfg.RemoteSignerClientKey,
+		validatorDB:            cfg.ValidatorDB,
+		logValidatorBalances:   cfg.LogValidatorBalances,
+		doppelgangerEpochs:     cfg.DoppelgangerEpochs,
 	}, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	pubkeys := make([][]byte, 0)
-	for i := range v.keys {
-		log.WithField("publicKey", fmt.Sprintf("%#x", v.keys[i].PublicKey.Marshal())).Info("Initializing new validator service")
-		pubkeys = append(pubkeys, v.keys[i].PublicKey.Marshal())
-	}
-
-	var dialOpt grpc.DialOption
-	if v.withCert != "" {
-		creds, err := credentials.NewClientTLSFromFile(v.withCert, "")
+	if v.remoteSigner != "" {
+		conn, err := dialSigner(v.ctx, v.remoteSigner, v.remoteSignerCert, v.remoteSignerClientCert, v.remoteSignerClientKey)
 		if err != nil {
-			log.Errorf("Could not get valid credentials: %v", err)
+			log.Errorf("Could not dial remote signer: %s, %v", v.remoteSigner, err)
 			return
 		}
-		dialOpt = grpc.WithTransportCredentials(creds)
-	} else {
-		dialOpt = grpc.WithInsecure()
-		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+		log.WithField("endpoint", v.remoteSigner).Info("Signing with remote signer")
+		v.signerConn = conn
+		v.signer = signer.NewRemoteSigner(conn)
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+	pubkeys, err := v.signer.PublicKeys(v.ctx)
+	if err != nil {
+		log.Errorf("Could not get validator public keys: %v", err)
+		return
+	}
+	for _, pk := range pubkeys {
+		log.WithField("publicKey", fmt.Sprintf("%#x", pk)).Info("Initializing new validator service")
+	}
+
+	conn, err := dial(v.ctx, v.endpoint, v.withCert)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
 		return
@@ -100,17 +128,69 @@ func (v *ValidatorService) Start() {
 		validatorClient:      pb.NewValidatorServiceClient(v.conn),
 		attesterClient:       pb.NewAttesterServiceClient(v.conn),
 		proposerClient:       pb.NewProposerServiceClient(v.conn),
-		keys:                 v.keys,
+		signer:               v.signer,
 		pubkeys:              pubkeys,
+		validatorDB:          v.validatorDB,
 		logValidatorBalances: v.logValidatorBalances,
+		doppelgangerEpochs:   v.doppelgangerEpochs,
 	}
 	go run(v.ctx, v.validator)
 }
 
+// dial opens a gRPC connection to the endpoint, secured with TLS when a
+// certificate is given.
+func dial(ctx context.Context, endpoint string, cert string) (*grpc.ClientConn, error) {
+	var dialOpt grpc.DialOption
+	if cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.WithField("endpoint", endpoint).Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	}
+	return grpc.DialContext(ctx, endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+}
+
+// dialSigner dials the remote signer, presenting the client certificate when
+// one is given for signers which require mutual TLS.
+func dialSigner(ctx context.Context, endpoint string, cert string, clientCert string, clientKey string) (*grpc.ClientConn, error) {
+	if clientCert == "" {
+		return dial(ctx, endpoint, cert)
+	}
+	if cert == "" {
+		return nil, errors.New("mutual TLS with the remote signer requires its certificate")
+	}
+	keyPair, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not load client certificate: %v", err)
+	}
+	ca, err := ioutil.ReadFile(cert)
+	if err != nil {
+		return nil, fmt.Errorf("could not read remote signer certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificate found in %s", cert)
+	}
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		RootCAs:      pool,
+	})
+	return grpc.DialContext(ctx, endpoint, grpc.WithTransportCredentials(creds), grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.signerConn != nil {
+		if err := v.signerConn.Close(); err != nil {
+			return err
+		}
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
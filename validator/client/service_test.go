@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -77,7 +78,7 @@ func TestLifecycle(t *testing.T) {
 		cancel:   cancel,
 		endpoint: "merkle tries",
 		withCert: "alice.crt",
-		keys:     keyMap,
+		signer:   signer.NewLocalSigner(keyMap),
 	}
 	validatorService.Start()
 	if err := validatorService.Stop(); err != nil {
@@ -95,7 +96,7 @@ func TestLifecycle_Insecure(t *testing.T) {
 		ctx:      ctx,
 		cancel:   cancel,
 		endpoint: "merkle tries",
-		keys:     keyMap,
+		signer:   signer.NewLocalSigner(keyMap),
 	}
 	validatorService.Start()
 	testutil.AssertLogsContain(t, hook, "You are using an insecure gRPC connection")
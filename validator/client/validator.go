@@ -11,9 +11,10 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -26,10 +27,12 @@ type validator struct {
 	validatorClient      pb.ValidatorServiceClient
 	beaconClient         pb.BeaconServiceClient
 	attesterClient       pb.AttesterServiceClient
-	keys                 map[string]*keystore.Key
+	signer               signer.Signer
 	pubkeys              [][]byte
+	validatorDB          *db.ValidatorDB
 	prevBalance          uint64
 	logValidatorBalances bool
+	doppelgangerEpochs   uint64
 }
 
 // Done cleans up the validator.
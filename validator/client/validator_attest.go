@@ -3,13 +3,17 @@ package client
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -25,8 +29,13 @@ var delay = params.BeaconConfig().SecondsPerSlot / 2
 func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, idx string) {
 	ctx, span := trace.StartSpan(ctx, "validator.AttestToBlockHead")
 	defer span.End()
+	pubKey, err := hex.DecodeString(idx)
+	if err != nil {
+		log.Errorf("Could not decode validator public key: %v", err)
+		return
+	}
 	span.AddAttributes(
-		trace.StringAttribute("validator", fmt.Sprintf("%#x", v.keys[idx].PublicKey.Marshal())),
+		trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)),
 	)
 	truncatedPk := idx
 	if len(idx) > 12 {
@@ -42,7 +51,6 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, idx stri
 	}
 	// We fetch the validator index as it is necessary to generate the aggregation
 	// bitfield of the attestation itself.
-	pubKey := v.keys[idx].PublicKey.Marshal()
 	var assignment *pb.CommitteeAssignmentResponse_CommitteeAssignment
 	if v.assignments == nil {
 		log.Errorf("No assignments for validators")
@@ -128,9 +136,6 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, idx stri
 	}
 	attestation.AggregationBitfield = aggregationBitfield
 
-	// TODO(#1366): Use BLS to generate an aggregate signature.
-	attestation.AggregateSignature = []byte("signed")
-
 	log.WithFields(logrus.Fields{
 		"shard":     attData.Shard,
 		"slot":      slot - params.BeaconConfig().GenesisSlot,
@@ -145,6 +150,38 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, idx stri
 		trace.StringAttribute("bitfield", fmt.Sprintf("%#x", aggregationBitfield)),
 	)
 
+	// The slashing protection database refuses attestations which would make a
+	// double or surround vote with the ones the validator already signed.
+	// Custody bits are all 0 in phase 0, so the validator signs the attestation
+	// data with a 0 custody bit.
+	attDataRoot, err := hashutil.HashAttestationData(attData, false /* custodyBit */)
+	if err != nil {
+		log.Errorf("Could not hash attestation data: %v", err)
+		return
+	}
+	targetEpoch := attData.Slot / params.BeaconConfig().SlotsPerEpoch
+	if err := v.validatorDB.CheckAndSaveAttestation(pubKey, attData.JustifiedEpoch, targetEpoch, attDataRoot); err != nil {
+		log.WithFields(logrus.Fields{
+			"slot":      slot - params.BeaconConfig().GenesisSlot,
+			"validator": truncatedPk,
+		}).WithError(err).Error("Not attesting! Attestation is slashable")
+		return
+	}
+
+	// Retrieve the current fork data from the beacon node.
+	fork, err := v.beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Could not fetch fork data from beacon node: %v", err)
+		return
+	}
+	domain := forkutil.DomainVersion(fork, targetEpoch, params.BeaconConfig().DomainAttestation)
+	sig, err := v.signer.SignAttestation(ctx, pubKey, attData.JustifiedEpoch, targetEpoch, attDataRoot[:], domain)
+	if err != nil {
+		log.Errorf("Could not sign attestation: %v", err)
+		return
+	}
+	attestation.AggregateSignature = sig.Marshal()
+
 	attResp, err := v.attesterClient.AttestHead(ctx, attestation)
 	if err != nil {
 		log.Errorf("Could not submit attestation to beacon node: %v", err)
@@ -9,10 +9,13 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -83,6 +86,14 @@ func TestAttestToBlockHead_AttestHeadRequestFailure(t *testing.T) {
 		LatestCrosslink:          &pbp2p.Crosslink{},
 		JustifiedEpoch:           0,
 	}, nil)
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
@@ -123,6 +134,15 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 		JustifiedEpoch:           3,
 	}, nil)
 
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
 	var generatedAttestation *pbp2p.Attestation
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
@@ -145,20 +165,68 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 			CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
 			JustifiedEpoch:           3,
 		},
-		CustodyBitfield:    make([]byte, (len(committee)+7)/8),
-		AggregateSignature: []byte("signed"),
+		CustodyBitfield: make([]byte, (len(committee)+7)/8),
 	}
 	aggregationBitfield, err := bitutil.SetBitfield(4, len(committee))
 	if err != nil {
 		t.Fatal(err)
 	}
 	expectedAttestation.AggregationBitfield = aggregationBitfield
+	attDataRoot, err := hashutil.HashAttestationData(expectedAttestation.Data, false /* custodyBit */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(&pbp2p.Fork{Epoch: params.BeaconConfig().GenesisEpoch}, 30/params.BeaconConfig().SlotsPerEpoch, params.BeaconConfig().DomainAttestation)
+	expectedAttestation.AggregateSignature = validatorKey.SecretKey.Sign(attDataRoot[:], domain).Marshal()
 	if !proto.Equal(generatedAttestation, expectedAttestation) {
 		t.Errorf("Incorrectly attested head, wanted %v, received %v", expectedAttestation, generatedAttestation)
 	}
 	testutil.AssertLogsContain(t, hook, "Attested latest head")
 }
 
+func TestAttestToBlockHead_RefusesSurroundVote(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	// The validator already signed an attestation from epoch 2 to epoch 5,
+	// which surrounds the attestation from epoch 3 to epoch 4 it is asked for.
+	slot := 4 * params.BeaconConfig().SlotsPerEpoch
+	if err := validator.validatorDB.CheckAndSaveAttestation(validatorKey.PublicKey.Marshal(), 2, 5, [32]byte{'A'}); err != nil {
+		t.Fatal(err)
+	}
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+			Committee: make([]uint64, 111),
+		}}}
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(&pb.ValidatorIndexResponse{
+		Index: 0,
+	}, nil)
+	m.attesterClient.EXPECT().AttestationDataAtSlot(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
+	).Return(&pb.AttestationDataResponse{
+		HeadSlot:                 slot,
+		BeaconBlockRootHash32:    []byte{},
+		EpochBoundaryRootHash32:  []byte{},
+		JustifiedBlockRootHash32: []byte{},
+		LatestCrosslink:          &pbp2p.Crosslink{},
+		JustifiedEpoch:           3,
+	}, nil)
+	m.attesterClient.EXPECT().AttestHead(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
+	).Times(0)
+
+	validator.AttestToBlockHead(context.Background(), slot, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "Not attesting! Attestation is slashable")
+}
+
 func TestAttestToBlockHead_DoesNotAttestBeforeDelay(t *testing.T) {
 	validator, m, finish := setup(t)
 	defer finish()
@@ -231,6 +299,14 @@ func TestAttestToBlockHead_DoesAttestAfterDelay(t *testing.T) {
 		wg.Done()
 	})
 
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
 		gomock.Any(),
@@ -269,6 +345,15 @@ func TestAttestToBlockHead_CorrectBitfieldLength(t *testing.T) {
 		JustifiedEpoch:           3,
 	}, nil)
 
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
 	var generatedAttestation *pbp2p.Attestation
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
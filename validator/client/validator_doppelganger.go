@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// CheckDoppelganger watches the attestations and blocks of the beacon chain
+// for activity of the validators before they start performing duties. The
+// current epoch may hold messages this client signed before it restarted, so
+// observation starts at the next epoch boundary, and every epoch is checked once
+// it ended, until the configured number of epochs elapsed. Activity of a validator
+// which this client did not sign means its key is in use by another validator
+// client, and signing with it from two clients gets it slashed, so an error is
+// returned instead.
+func (v *validator) CheckDoppelganger(ctx context.Context) error {
+	if v.doppelgangerEpochs == 0 {
+		log.Warn("Doppelganger detection is disabled, validators running elsewhere with the same keys will get slashed")
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelganger")
+	defer span.End()
+
+	pubKeys := make(map[uint64][]byte)
+	var indices []uint64
+	for _, pk := range v.pubkeys {
+		resp, err := v.validatorClient.ValidatorIndex(ctx, &pb.ValidatorIndexRequest{PublicKey: pk})
+		if err != nil {
+			// A validator missing from the registry cannot be active elsewhere.
+			log.WithError(err).WithField("publicKey", fmt.Sprintf("%#x", pk)).Debug("Could not get validator index")
+			continue
+		}
+		pubKeys[resp.Index] = pk
+		indices = append(indices, resp.Index)
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
+	headSlot, err := v.CanonicalHeadSlot(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get current canonical head slot: %v", err)
+	}
+	epoch := headSlot / params.BeaconConfig().SlotsPerEpoch
+	log.WithFields(logrus.Fields{
+		"epochs":     v.doppelgangerEpochs,
+		"validators": len(indices),
+	}).Info("Checking that the validators are not active elsewhere before performing duties")
+	for i := uint64(1); i <= v.doppelgangerEpochs; i++ {
+		target := epoch + i
+		// Wait for the epoch to end so every attestation of the epoch has been broadcast.
+		if err := v.waitForSlot(ctx, (target+1)*params.BeaconConfig().SlotsPerEpoch); err != nil {
+			return err
+		}
+		if err := v.checkLiveness(ctx, target, indices, pubKeys); err != nil {
+			return err
+		}
+		log.WithField("epoch", target-params.BeaconConfig().GenesisEpoch).Debug("No activity of the validators found in epoch")
+	}
+	log.Info("No activity of the validators found elsewhere")
+	return nil
+}
+
+// checkLiveness returns an error when one of the validators attested or
+// proposed a block in the epoch, unless the slashing protection database shows
+// the activity was signed by this client.
+func (v *validator) checkLiveness(ctx context.Context, epoch uint64, indices []uint64, pubKeys map[uint64][]byte) error {
+	resp, err := v.validatorClient.ValidatorLiveness(ctx, &pb.ValidatorLivenessRequest{
+		Epoch:   epoch,
+		Indices: indices,
+	})
+	if err != nil {
+		return fmt.Errorf("could not check validator liveness in epoch %d: %v", epoch-params.BeaconConfig().GenesisEpoch, err)
+	}
+	for _, status := range resp.Statuses {
+		if !status.IsLive {
+			continue
+		}
+		signed, err := v.validatorDB.SignedInEpoch(pubKeys[status.Index], epoch)
+		if err != nil {
+			return fmt.Errorf("could not check slashing protection history: %v", err)
+		}
+		if signed {
+			log.WithFields(logrus.Fields{
+				"epoch":     epoch - params.BeaconConfig().GenesisEpoch,
+				"validator": status.Index,
+			}).Debug("Validator activity was signed by this client")
+			continue
+		}
+		return fmt.Errorf(
+			"validator %d with public key %#x was active in epoch %d, its key is in use by another validator client: "+
+				"stop the other client and wait for the next epoch, or disable doppelganger detection to start anyway",
+			status.Index,
+			pubKeys[status.Index],
+			epoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	return nil
+}
+
+// waitForSlot blocks until the slot ticker reaches the slot.
+func (v *validator) waitForSlot(ctx context.Context, slot uint64) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context has been canceled: %v", ctx.Err())
+		case s := <-v.NextSlot():
+			if s >= slot {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestCheckDoppelganger_Disabled(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+	validator.pubkeys = [][]byte{validatorKey.PublicKey.Marshal()}
+
+	// No call to the beacon node is expected.
+	if err := validator.CheckDoppelganger(context.Background()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestCheckDoppelganger_WaitsForNextEpoch(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.pubkeys = [][]byte{validatorKey.PublicKey.Marshal()}
+	validator.doppelgangerEpochs = 2
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.ValidatorIndexRequest{PublicKey: validatorKey.PublicKey.Marshal()}),
+	).Return(&pb.ValidatorIndexResponse{Index: 7}, nil /*err*/)
+
+	epoch := params.BeaconConfig().GenesisEpoch + 4
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{Slot: epoch*params.BeaconConfig().SlotsPerEpoch + 3}, nil /*err*/)
+
+	// No epoch is checked before the next epoch has ended.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	want := "context has been canceled"
+	if err := validator.CheckDoppelganger(ctx); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestCheckDoppelganger_UnknownValidators(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.pubkeys = [][]byte{validatorKey.PublicKey.Marshal()}
+	validator.doppelgangerEpochs = 2
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(nil, errors.New("not found"))
+
+	if err := validator.CheckDoppelganger(context.Background()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestCheckLiveness_NoActivity(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	epoch := params.BeaconConfig().GenesisEpoch + 4
+	m.validatorClient.EXPECT().ValidatorLiveness(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.ValidatorLivenessRequest{Epoch: epoch, Indices: []uint64{1, 2}}),
+	).Return(&pb.ValidatorLivenessResponse{
+		Statuses: []*pb.ValidatorLivenessResponse_Liveness{{Index: 1}, {Index: 2}},
+	}, nil /*err*/)
+
+	if err := validator.checkLiveness(context.Background(), epoch, []uint64{1, 2}, nil); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestCheckLiveness_ActiveElsewhere(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	epoch := params.BeaconConfig().GenesisEpoch + 4
+	m.validatorClient.EXPECT().ValidatorLiveness(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.ValidatorLivenessRequest{Epoch: epoch, Indices: []uint64{7}}),
+	).Return(&pb.ValidatorLivenessResponse{
+		Statuses: []*pb.ValidatorLivenessResponse_Liveness{{Index: 7, IsLive: true}},
+	}, nil /*err*/)
+
+	pubKeys := map[uint64][]byte{7: validatorKey.PublicKey.Marshal()}
+	want := "validator 7"
+	if err := validator.checkLiveness(context.Background(), epoch, []uint64{7}, pubKeys); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestCheckLiveness_SkipsOwnActivity(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	epoch := params.BeaconConfig().GenesisEpoch + 4
+	pubKey := validatorKey.PublicKey.Marshal()
+	if err := validator.validatorDB.CheckAndSaveAttestation(pubKey, epoch-1, epoch, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	m.validatorClient.EXPECT().ValidatorLiveness(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.ValidatorLivenessRequest{Epoch: epoch, Indices: []uint64{7}}),
+	).Return(&pb.ValidatorLivenessResponse{
+		Statuses: []*pb.ValidatorLivenessResponse_Liveness{{Index: 7, IsLive: true}},
+	}, nil /*err*/)
+
+	pubKeys := map[uint64][]byte{7: pubKey}
+	if err := validator.checkLiveness(context.Background(), epoch, []uint64{7}, pubKeys); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package client
+
+// Validator client voluntary exit functions.
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ProposeExit builds a voluntary exit of the validator owning the key at the
+// epoch of the beacon node's canonical head, signs it and sends it to the beacon
+// node which verifies and broadcasts it. Once included in a block the exit cannot
+// be undone.
+func ProposeExit(
+	ctx context.Context,
+	beaconClient pb.BeaconServiceClient,
+	validatorClient pb.ValidatorServiceClient,
+	key *keystore.Key,
+) (*pbp2p.VoluntaryExit, error) {
+	indexResp, err := validatorClient.ValidatorIndex(ctx, &pb.ValidatorIndexRequest{
+		PublicKey: key.PublicKey.Marshal(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator index: %v", err)
+	}
+	headBlock, err := beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch canonical head: %v", err)
+	}
+	fork, err := beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get fork data from beacon node's state: %v", err)
+	}
+
+	exit := &pbp2p.VoluntaryExit{
+		Epoch:          headBlock.Slot / params.BeaconConfig().SlotsPerEpoch,
+		ValidatorIndex: indexResp.Index,
+	}
+	// exit.signature = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=signed_root(exit),
+	//   domain=get_domain(fork, exit.epoch, DOMAIN_EXIT),
+	// )
+	exitRoot, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	domain := forkutil.DomainVersion(fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = key.SecretKey.Sign(exitRoot[:], domain).Marshal()
+
+	if _, err := validatorClient.ProposeExit(ctx, exit); err != nil {
+		return nil, fmt.Errorf("could not propose exit: %v", err)
+	}
+	return exit, nil
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+)
+
+func TestProposeExit_SignsExitAtHeadEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := internal.NewMockBeaconServiceClient(ctrl)
+	validatorClient := internal.NewMockValidatorServiceClient(ctrl)
+
+	validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.ValidatorIndexRequest{PublicKey: validatorKey.PublicKey.Marshal()}),
+	).Return(&pb.ValidatorIndexResponse{Index: 5}, nil /*err*/)
+
+	headSlot := params.BeaconConfig().GenesisSlot + 3*params.BeaconConfig().SlotsPerEpoch + 1
+	beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{Slot: headSlot}, nil /*err*/)
+
+	fork := &pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}
+	beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(fork, nil /*err*/)
+
+	var proposed *pbp2p.VoluntaryExit
+	validatorClient.EXPECT().ProposeExit(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.VoluntaryExit{}),
+	).Do(func(_ context.Context, exit *pbp2p.VoluntaryExit) {
+		proposed = exit
+	}).Return(&pb.ProposeExitResponse{}, nil /*err*/)
+
+	exit, err := ProposeExit(context.Background(), beaconClient, validatorClient, validatorKey)
+	if err != nil {
+		t.Fatalf("Could not propose exit: %v", err)
+	}
+	if proposed != exit {
+		t.Error("Expected the returned exit to be proposed")
+	}
+	wantEpoch := params.BeaconConfig().GenesisEpoch + 3
+	if exit.Epoch != wantEpoch || exit.ValidatorIndex != 5 {
+		t.Errorf("Expected exit of validator 5 at epoch %d, received %v", wantEpoch, exit)
+	}
+	exitRoot, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	if want := validatorKey.SecretKey.Sign(exitRoot[:], domain).Marshal(); string(exit.Signature) != string(want) {
+		t.Error("Expected the exit to be signed by the validator key")
+	}
+}
+
+func TestProposeExit_UnknownValidator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := internal.NewMockBeaconServiceClient(ctrl)
+	validatorClient := internal.NewMockValidatorServiceClient(ctrl)
+
+	validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(nil, errors.New("not found"))
+
+	want := "could not get validator index"
+	if _, err := ProposeExit(context.Background(), beaconClient, validatorClient, validatorKey); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
@@ -29,7 +30,12 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 	}
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
-	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", v.keys[idx].PublicKey.Marshal())))
+	pubKey, err := hex.DecodeString(idx)
+	if err != nil {
+		log.WithError(err).Error("Failed to decode validator public key")
+		return
+	}
+	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	truncatedPk := idx
 	if len(idx) > 12 {
 		truncatedPk = idx[:12]
@@ -83,7 +89,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 	buf := make([]byte, 32)
 	binary.LittleEndian.PutUint64(buf, epoch)
 	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainRandao)
-	epochSignature := v.keys[idx].SecretKey.Sign(buf, domain)
+	epochSignature, err := v.signer.Sign(ctx, pubKey, buf, domain)
+	if err != nil {
+		log.WithError(err).Error("Failed to sign randao reveal")
+		return
+	}
 
 	// Fetch pending attestations seen by the beacon node.
 	attResp, err := v.proposerClient.PendingAttestations(ctx, &pb.PendingAttestationsRequest{
@@ -95,6 +105,25 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 		return
 	}
 
+	// Fetch pending slashings seen by the beacon node.
+	proposerSlashingsResp, err := v.proposerClient.PendingProposerSlashings(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch pending proposer slashings from the beacon node")
+		return
+	}
+	attesterSlashingsResp, err := v.proposerClient.PendingAttesterSlashings(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch pending attester slashings from the beacon node")
+		return
+	}
+
+	// Fetch pending exits seen by the beacon node.
+	exitsResp, err := v.proposerClient.PendingExits(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch pending exits from the beacon node")
+		return
+	}
+
 	// 2. Construct block.
 	block := &pbp2p.BeaconBlock{
 		Slot:             slot,
@@ -103,10 +132,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 		Eth1Data:         eth1DataResp.Eth1Data,
 		Body: &pbp2p.BeaconBlockBody{
 			Attestations:      attResp.PendingAttestations,
-			ProposerSlashings: nil, // TODO(1438): Add after operations pool
-			AttesterSlashings: nil, // TODO(1438): Add after operations pool
+			ProposerSlashings: proposerSlashingsResp.PendingProposerSlashings,
+			AttesterSlashings: attesterSlashingsResp.PendingAttesterSlashings,
 			Deposits:          pDepResp.PendingDeposits,
-			VoluntaryExits:    nil, // TODO(1323): Add validator exits
+			VoluntaryExits:    exitsResp.PendingExits,
 		},
 	}
 
@@ -121,11 +150,40 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 	}
 	block.StateRootHash32 = resp.GetStateRoot()
 
-	// 4. Sign the complete block.
-	// TODO(1366): BLS sign block
-	block.Signature = nil
+	// 4. Record the block in the slashing protection database, which refuses
+	// a block conflicting with one the validator already signed for the slot.
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		log.WithError(err).Error("Failed to hash proposal")
+		return
+	}
+	if err := v.validatorDB.CheckAndSaveProposal(pubKey, slot, proposalRoot); err != nil {
+		log.WithFields(logrus.Fields{
+			"slot":      slot - params.BeaconConfig().GenesisSlot,
+			"validator": truncatedPk,
+		}).WithError(err).Error("Not proposing! Block is slashable")
+		return
+	}
+
+	// 5. Sign the complete block.
+	// block_signature = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=hash_tree_root(ProposalSignedData(block.slot, BEACON_CHAIN_SHARD_NUMBER, block_without_signature_root)),
+	//   domain=get_domain(
+	//     fork=fork,
+	//     epoch=slot_to_epoch(block.slot),
+	//     domain_type=DOMAIN_PROPOSAL,
+	//   )
+	// )
+	proposalDomain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainProposal)
+	blockSignature, err := v.signer.SignProposal(ctx, pubKey, slot, proposalRoot[:], proposalDomain)
+	if err != nil {
+		log.WithError(err).Error("Failed to sign block")
+		return
+	}
+	block.Signature = blockSignature.Marshal()
 
-	// 5. Broadcast to the network via beacon chain node.
+	// 6. Broadcast to the network via beacon chain node.
 	blkResp, err := v.proposerClient.ProposeBlock(ctx, block)
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -137,12 +195,15 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRootHash32)),
 		trace.Int64Attribute("numDeposits", int64(len(block.Body.Deposits))),
 		trace.Int64Attribute("numAttestations", int64(len(block.Body.Attestations))),
+		trace.Int64Attribute("numProposerSlashings", int64(len(block.Body.ProposerSlashings))),
+		trace.Int64Attribute("numAttesterSlashings", int64(len(block.Body.AttesterSlashings))),
 	)
 	log.WithFields(logrus.Fields{
 		"slot":            block.Slot - params.BeaconConfig().GenesisSlot,
 		"blockRoot":       fmt.Sprintf("%#x", blkResp.BlockRootHash32),
 		"numAttestations": len(block.Body.Attestations),
 		"numDeposits":     len(block.Body.Deposits),
+		"expectedReward":  attResp.ExpectedReward,
 		"validator":       truncatedPk,
 	}).Info("Proposed new beacon block")
 }
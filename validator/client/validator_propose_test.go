@@ -5,15 +5,25 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path"
+	"reflect"
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/internal"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -32,15 +42,30 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		validatorClient: internal.NewMockValidatorServiceClient(ctrl),
 		attesterClient:  internal.NewMockAttesterServiceClient(ctrl),
 	}
+	dbPath := path.Join(testutil.TempDir(), fmt.Sprintf("validatordb-%d", rand.Int()))
+	validatorDB, err := db.NewDB(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
 	validator := &validator{
 		proposerClient:  m.proposerClient,
 		beaconClient:    m.beaconClient,
 		attesterClient:  m.attesterClient,
 		validatorClient: m.validatorClient,
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
+		validatorDB:     validatorDB,
 	}
 
-	return validator, m, ctrl.Finish
+	finish := func() {
+		ctrl.Finish()
+		if err := validatorDB.Close(); err != nil {
+			t.Fatalf("Failed to close database: %v", err)
+		}
+		if err := os.RemoveAll(dbPath); err != nil {
+			t.Fatalf("Failed to remove directory: %v", err)
+		}
+	}
+	return validator, m, finish
 }
 
 func TestProposeBlock_DoesNotProposeGenesisBlock(t *testing.T) {
@@ -124,6 +149,21 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -206,6 +246,21 @@ func TestProposeBlock_UsesEth1Data(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -267,6 +322,21 @@ func TestProposeBlock_PendingAttestations_UsesCurrentSlot(t *testing.T) {
 		return &pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil
 	})
 
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -363,6 +433,21 @@ func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -405,6 +490,21 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
 	var broadcastedBlock *pbp2p.BeaconBlock
 	m.proposerClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
@@ -431,6 +531,90 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 	}
 }
 
+func TestProposeBlock_SignsBlock(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
+	var broadcastedBlock *pbp2p.BeaconBlock
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
+		broadcastedBlock = blk
+	}).Return(&pb.ProposeResponse{}, nil /*error*/)
+
+	computedStateRoot := []byte{'T', 'E', 'S', 'T'}
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(
+		&pb.StateRootResponse{
+			StateRoot: computedStateRoot,
+		},
+		nil, // err
+	)
+
+	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+
+	proposalRoot, err := hashutil.HashProposal(broadcastedBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(broadcastedBlock.Signature)
+	if err != nil {
+		t.Fatalf("Could not deserialize block signature: %v", err)
+	}
+	epoch := uint64(55) / params.BeaconConfig().SlotsPerEpoch
+	domain := forkutil.DomainVersion(&pbp2p.Fork{Epoch: params.BeaconConfig().GenesisEpoch}, epoch, params.BeaconConfig().DomainProposal)
+	if !sig.Verify(proposalRoot[:], validatorKey.PublicKey, domain) {
+		t.Error("Expected block signature to verify with the validator public key")
+	}
+}
+
 func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 	validator, m, finish := setup(t)
 	defer finish()
@@ -464,6 +648,21 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -478,3 +677,152 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 
 	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
 }
+
+func TestProposeBlock_UsesPendingSlashings(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	proposerSlashings := []*pbp2p.ProposerSlashing{{ProposerIndex: 1}}
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{PendingProposerSlashings: proposerSlashings}, nil /*err*/)
+
+	attesterSlashings := []*pbp2p.AttesterSlashing{{
+		SlashableAttestation_1: &pbp2p.SlashableAttestation{ValidatorIndices: []uint64{2}},
+	}}
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{PendingAttesterSlashings: attesterSlashings}, nil /*err*/)
+
+	exits := []*pbp2p.VoluntaryExit{{ValidatorIndex: 3}}
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{PendingExits: exits}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{
+		StateRoot: []byte{'F'},
+	}, nil /*err*/)
+
+	var broadcastedBlock *pbp2p.BeaconBlock
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
+		broadcastedBlock = blk
+	}).Return(&pb.ProposeResponse{}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+
+	if !reflect.DeepEqual(broadcastedBlock.Body.ProposerSlashings, proposerSlashings) {
+		t.Errorf("Expected proposer slashings %v, received %v", proposerSlashings, broadcastedBlock.Body.ProposerSlashings)
+	}
+	if !reflect.DeepEqual(broadcastedBlock.Body.AttesterSlashings, attesterSlashings) {
+		t.Errorf("Expected attester slashings %v, received %v", attesterSlashings, broadcastedBlock.Body.AttesterSlashings)
+	}
+	if !reflect.DeepEqual(broadcastedBlock.Body.VoluntaryExits, exits) {
+		t.Errorf("Expected exits %v, received %v", exits, broadcastedBlock.Body.VoluntaryExits)
+	}
+}
+
+func TestProposeBlock_RefusesDoubleProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	// The validator already signed another block at the slot.
+	if err := validator.validatorDB.CheckAndSaveProposal(validatorKey.PublicKey.Marshal(), 55, [32]byte{'A'}); err != nil {
+		t.Fatal(err)
+	}
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingProposerSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingProposerSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttesterSlashings(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingAttesterSlashingsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{StateRoot: []byte{'T', 'E', 'S', 'T'}}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Times(0)
+
+	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "Not proposing! Block is slashable")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/internal"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -57,7 +58,7 @@ func TestWaitForChainStart_SetsChainStartGenesisTime(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       signer.NewLocalSigner(keyMap),
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -90,7 +91,7 @@ func TestWaitForChainStart_ContextCanceled(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       signer.NewLocalSigner(keyMap),
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -121,7 +122,7 @@ func TestWaitForChainStart_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       signer.NewLocalSigner(keyMap),
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -142,7 +143,7 @@ func TestWaitForChainStart_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       signer.NewLocalSigner(keyMap),
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -167,22 +168,22 @@ func TestWaitActivation_ContextCanceled(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
 		&pb.ValidatorActivationResponse{
-			ActivatedPublicKeys: publicKeys(v.keys),
+			ActivatedPublicKeys: publicKeys(keyMap),
 		},
 		nil,
 	)
@@ -201,16 +202,16 @@ func TestWaitActivation_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
 	).Return(clientStream, errors.New("failed stream"))
 	err := v.WaitForActivation(context.Background())
@@ -226,16 +227,16 @@ func TestWaitActivation_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
@@ -256,18 +257,18 @@ func TestWaitActivation_LogsActivationEpochOK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	resp := generateMockStatusResponse(v.pubkeys)
 	resp.Statuses[0].Status.Status = pb.ValidatorStatus_ACTIVE
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
@@ -285,7 +286,7 @@ func TestCanonicalHeadSlot_FailedRPC(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockBeaconServiceClient(ctrl)
 	v := validator{
-		keys:         keyMap,
+		signer:       signer.NewLocalSigner(keyMap),
 		beaconClient: client,
 	}
 	client.EXPECT().CanonicalHead(
@@ -302,7 +303,7 @@ func TestCanonicalHeadSlot_OK(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockBeaconServiceClient(ctrl)
 	v := validator{
-		keys:         keyMap,
+		signer:       signer.NewLocalSigner(keyMap),
 		beaconClient: client,
 	}
 	client.EXPECT().CanonicalHead(
@@ -324,11 +325,11 @@ func TestWaitMultipleActivation_LogsActivationEpochOK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMapThreeValidators,
+		signer:          signer.NewLocalSigner(keyMapThreeValidators),
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMapThreeValidators)
 	resp := generateMockStatusResponse(v.pubkeys)
 	resp.Statuses[0].Status.Status = pb.ValidatorStatus_ACTIVE
 	resp.Statuses[1].Status.Status = pb.ValidatorStatus_ACTIVE
@@ -354,7 +355,7 @@ func TestWaitActivation_NotAllValidatorsActivatedOK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMapThreeValidators,
+		signer:          signer.NewLocalSigner(keyMapThreeValidators),
 		validatorClient: client,
 		pubkeys:         publicKeys(keyMapThreeValidators),
 	}
@@ -389,7 +390,7 @@ func TestUpdateAssignments_DoesNothingWhenNotEpochStartAndAlreadyExistingAssignm
 
 	slot := uint64(1)
 	v := validator{
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
 		validatorClient: client,
 		assignments: &pb.CommitteeAssignmentResponse{
 			Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
@@ -417,7 +418,7 @@ func TestUpdateAssignments_ReturnsError(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
 		validatorClient: client,
 		assignments: &pb.CommitteeAssignmentResponse{
 			Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
@@ -461,7 +462,7 @@ func TestUpdateAssignments_OK(t *testing.T) {
 		},
 	}
 	v := validator{
-		keys:            keyMap,
+		signer:          signer.NewLocalSigner(keyMap),
 		validatorClient: client,
 	}
 	client.EXPECT().CommitteeAssignment(
@@ -0,0 +1,63 @@
+// Package db defines the slashing protection database of the validator client,
+// which keeps the proposals and attestations signed by each of its validators.
+package db
+
+import (
+	"errors"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "validatordb")
+
+const dbFileName = "validator.db"
+
+var (
+	// Both buckets hold a nested bucket per validator public key.
+	proposalHistoryBucket    = []byte("proposal-history-bucket")
+	attestationHistoryBucket = []byte("attestation-history-bucket")
+)
+
+// ValidatorDB records every block proposal and attestation signed by the
+// validator client so it can refuse to sign slashable messages.
+type ValidatorDB struct {
+	db           *bolt.DB
+	DatabasePath string
+}
+
+// NewDB opens the slashing protection database in the given directory,
+// creating it if it does not exist yet.
+func NewDB(dirPath string) (*ValidatorDB, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	datafile := path.Join(dirPath, dbFileName)
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{proposalHistoryBucket, attestationHistoryBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &ValidatorDB{db: boltDB, DatabasePath: dirPath}, nil
+}
+
+// Close closes the underlying boltdb database.
+func (db *ValidatorDB) Close() error {
+	return db.db.Close()
+}
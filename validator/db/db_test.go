@@ -0,0 +1,48 @@
+package db
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// setupDB instantiates and returns a ValidatorDB instance.
+func setupDB(t testing.TB) *ValidatorDB {
+	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		t.Fatalf("Could not generate random file path: %v", err)
+	}
+	p := path.Join(testutil.TempDir(), fmt.Sprintf("/%d", randPath))
+	if err := os.RemoveAll(p); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+	db, err := NewDB(p)
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	return db
+}
+
+// teardownDB cleans up a test ValidatorDB instance.
+func teardownDB(t testing.TB, db *ValidatorDB) {
+	if err := db.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
+	if err := os.RemoveAll(db.DatabasePath); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+}
+
+func TestNewDB_RefusesDatabaseInUse(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if _, err := NewDB(db.DatabasePath); err == nil {
+		t.Error("Expected opening a database in use to fail")
+	}
+}
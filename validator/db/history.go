@@ -0,0 +1,191 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// History is the interchange format of the slashing protection database, used to
+// move the signing history of validators between machines.
+type History struct {
+	Validators []*ValidatorHistory `json:"validators"`
+}
+
+// ValidatorHistory holds the proposals and attestations signed by a validator.
+type ValidatorHistory struct {
+	PublicKey    string               `json:"pubkey"`
+	Proposals    []*ProposalRecord    `json:"proposals"`
+	Attestations []*AttestationRecord `json:"attestations"`
+}
+
+// ProposalRecord is a block signed by a validator.
+type ProposalRecord struct {
+	Slot        uint64 `json:"slot"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// AttestationRecord is an attestation signed by a validator.
+type AttestationRecord struct {
+	SourceEpoch uint64 `json:"source_epoch"`
+	TargetEpoch uint64 `json:"target_epoch"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// ExportHistory writes the signing history of every validator in the database
+// as JSON.
+func (db *ValidatorDB) ExportHistory(w io.Writer) error {
+	validators := make(map[string]*ValidatorHistory)
+	var order []string
+	validator := func(pubKey []byte) *ValidatorHistory {
+		key := encodeHex(pubKey)
+		if _, ok := validators[key]; !ok {
+			validators[key] = &ValidatorHistory{
+				PublicKey:    key,
+				Proposals:    []*ProposalRecord{},
+				Attestations: []*AttestationRecord{},
+			}
+			order = append(order, key)
+		}
+		return validators[key]
+	}
+
+	if err := db.db.View(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(proposalHistoryBucket).ForEach(func(pubKey, _ []byte) error {
+			history := validator(pubKey)
+			return tx.Bucket(proposalHistoryBucket).Bucket(pubKey).ForEach(func(k, v []byte) error {
+				history.Proposals = append(history.Proposals, &ProposalRecord{
+					Slot:        binary.BigEndian.Uint64(k),
+					SigningRoot: encodeHex(v),
+				})
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+		return tx.Bucket(attestationHistoryBucket).ForEach(func(pubKey, _ []byte) error {
+			history := validator(pubKey)
+			return tx.Bucket(attestationHistoryBucket).Bucket(pubKey).ForEach(func(k, v []byte) error {
+				history.Attestations = append(history.Attestations, &AttestationRecord{
+					SourceEpoch: binary.BigEndian.Uint64(v[:8]),
+					TargetEpoch: binary.BigEndian.Uint64(k),
+					SigningRoot: encodeHex(v[8:]),
+				})
+				return nil
+			})
+		})
+	}); err != nil {
+		return err
+	}
+
+	history := &History{Validators: []*ValidatorHistory{}}
+	for _, key := range order {
+		history.Validators = append(history.Validators, validators[key])
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(history)
+}
+
+// ImportHistory merges the signing history read as JSON into the database. A
+// record conflicting with one already in the database does not replace it, as
+// both messages have been signed and the existing one keeps protecting the validator.
+// The import fails if an attestation surrounds or is surrounded by another one, as
+// the validator could no longer be protected against both.
+func (db *ValidatorDB) ImportHistory(r io.Reader) error {
+	history := &History{}
+	if err := json.NewDecoder(r).Decode(history); err != nil {
+		return fmt.Errorf("could not decode slashing protection history: %v", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		for _, validator := range history.Validators {
+			pubKey, err := decodeHex(validator.PublicKey)
+			if err != nil {
+				return fmt.Errorf("invalid public key %s: %v", validator.PublicKey, err)
+			}
+			conflicts := 0
+			proposals, err := tx.Bucket(proposalHistoryBucket).CreateBucketIfNotExists(pubKey)
+			if err != nil {
+				return err
+			}
+			for _, proposal := range validator.Proposals {
+				root, err := decodeRoot(proposal.SigningRoot)
+				if err != nil {
+					return err
+				}
+				conflict, err := importRecord(proposals, uint64ToBytes(proposal.Slot), root[:])
+				if err != nil {
+					return err
+				}
+				if conflict {
+					conflicts++
+				}
+			}
+			attestations, err := tx.Bucket(attestationHistoryBucket).CreateBucketIfNotExists(pubKey)
+			if err != nil {
+				return err
+			}
+			for _, attestation := range validator.Attestations {
+				root, err := decodeRoot(attestation.SigningRoot)
+				if err != nil {
+					return err
+				}
+				if err := checkSurroundVote(attestations, attestation.SourceEpoch, attestation.TargetEpoch); err != nil {
+					return fmt.Errorf("could not import history of %s: %v", validator.PublicKey, err)
+				}
+				value := attestationRecord(attestation.SourceEpoch, root)
+				conflict, err := importRecord(attestations, uint64ToBytes(attestation.TargetEpoch), value)
+				if err != nil {
+					return err
+				}
+				if conflict {
+					conflicts++
+				}
+			}
+			log.WithFields(logrus.Fields{
+				"pubkey":       validator.PublicKey,
+				"proposals":    len(validator.Proposals),
+				"attestations": len(validator.Attestations),
+			}).Info("Imported slashing protection history")
+			if conflicts > 0 {
+				log.WithField("pubkey", validator.PublicKey).Warnf(
+					"Kept %d existing records conflicting with imported ones", conflicts)
+			}
+		}
+		return nil
+	})
+}
+
+// importRecord puts the record into the bucket unless it already holds one under
+// the key, and reports whether that existing record differs from the imported one.
+func importRecord(bucket *bolt.Bucket, key []byte, value []byte) (bool, error) {
+	if previous := bucket.Get(key); previous != nil {
+		return !bytes.Equal(previous, value), nil
+	}
+	return false, bucket.Put(key, value)
+}
+
+func encodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+func decodeRoot(s string) ([32]byte, error) {
+	root, err := decodeHex(s)
+	if err != nil || len(root) != 32 {
+		return [32]byte{}, fmt.Errorf("invalid signing root %s", s)
+	}
+	return bytesutil.ToBytes32(root), nil
+}
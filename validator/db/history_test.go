@@ -0,0 +1,100 @@
+package db
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExportImportHistory_RoundTrip(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.CheckAndSaveProposal([]byte{'A'}, 10, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveAttestation([]byte{'A'}, 1, 2, [32]byte{2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveAttestation([]byte{'B'}, 2, 3, [32]byte{3}); err != nil {
+		t.Fatal(err)
+	}
+	exported := new(bytes.Buffer)
+	if err := db.ExportHistory(exported); err != nil {
+		t.Fatal(err)
+	}
+
+	otherDB := setupDB(t)
+	defer teardownDB(t, otherDB)
+	if err := otherDB.ImportHistory(bytes.NewReader(exported.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	reexported := new(bytes.Buffer)
+	if err := otherDB.ExportHistory(reexported); err != nil {
+		t.Fatal(err)
+	}
+	if exported.String() != reexported.String() {
+		t.Errorf("Expected imported history to match, wanted %s, received %s", exported, reexported)
+	}
+
+	// The imported history protects the validators on the new machine.
+	if err := otherDB.CheckAndSaveAttestation([]byte{'B'}, 1, 4, [32]byte{4}); err == nil {
+		t.Error("Expected surround vote of imported history to be refused")
+	}
+}
+
+func TestImportHistory_KeepsConflictingRecords(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.CheckAndSaveProposal([]byte{'A'}, 10, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	history := `{"validators": [{
+		"pubkey": "0x41",
+		"proposals": [
+			{"slot": 10, "signing_root": "0x0200000000000000000000000000000000000000000000000000000000000000"},
+			{"slot": 11, "signing_root": "0x0300000000000000000000000000000000000000000000000000000000000000"}
+		]
+	}]}`
+	if err := db.ImportHistory(strings.NewReader(history)); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveProposal([]byte{'A'}, 10, [32]byte{1}); err != nil {
+		t.Errorf("Expected existing proposal to be kept: %v", err)
+	}
+	if err := db.CheckAndSaveProposal([]byte{'A'}, 11, [32]byte{1}); err == nil {
+		t.Error("Expected imported proposal to protect the validator")
+	}
+
+	invalid := `{"validators": [{"pubkey": "0x41", "proposals": [{"slot": 12, "signing_root": "0x02"}]}]}`
+	if err := db.ImportHistory(strings.NewReader(invalid)); err == nil {
+		t.Error("Expected history with an invalid signing root to be rejected")
+	}
+}
+
+func TestImportHistory_RefusesSurroundVotes(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.CheckAndSaveAttestation([]byte{'A'}, 2, 3, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	history := `{"validators": [{
+		"pubkey": "0x41",
+		"proposals": [
+			{"slot": 20, "signing_root": "0x0200000000000000000000000000000000000000000000000000000000000000"}
+		],
+		"attestations": [
+			{"source_epoch": 1, "target_epoch": 4, "signing_root": "0x0300000000000000000000000000000000000000000000000000000000000000"}
+		]
+	}]}`
+	want := "surround vote"
+	if err := db.ImportHistory(strings.NewReader(history)); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	// Nothing of the refused history is imported.
+	if err := db.CheckAndSaveProposal([]byte{'A'}, 20, [32]byte{4}); err != nil {
+		t.Errorf("Expected proposal of the refused history not to be imported: %v", err)
+	}
+}
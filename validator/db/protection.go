@@ -0,0 +1,111 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// CheckAndSaveProposal records that the validator signs the block with the given
+// signing root at the slot. It refuses to do so if the validator already signed a
+// different block at that slot, in which case signing it would be a double proposal.
+func (db *ValidatorDB) CheckAndSaveProposal(pubKey []byte, slot uint64, signingRoot [32]byte) error {
+	return db.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(proposalHistoryBucket).CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return err
+		}
+		key := uint64ToBytes(slot)
+		if previous := bucket.Get(key); previous != nil {
+			if bytes.Equal(previous, signingRoot[:]) {
+				return nil
+			}
+			return fmt.Errorf("double proposal: already signed block %#x at slot %d",
+				previous, slot-params.BeaconConfig().GenesisSlot)
+		}
+		return bucket.Put(key, signingRoot[:])
+	})
+}
+
+// CheckAndSaveAttestation records that the validator signs the attestation with the
+// given signing root and source and target epochs. It refuses to do so if the
+// attestation would be a double vote or surround vote with any attestation the
+// validator signed before.
+func (db *ValidatorDB) CheckAndSaveAttestation(pubKey []byte, sourceEpoch uint64, targetEpoch uint64, signingRoot [32]byte) error {
+	return db.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(attestationHistoryBucket).CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return err
+		}
+		key := uint64ToBytes(targetEpoch)
+		if previous := bucket.Get(key); previous != nil {
+			if bytes.Equal(previous, attestationRecord(sourceEpoch, signingRoot)) {
+				return nil
+			}
+			return fmt.Errorf("double vote: already signed attestation %#x with target epoch %d",
+				previous[8:], targetEpoch-params.BeaconConfig().GenesisEpoch)
+		}
+		if err := checkSurroundVote(bucket, sourceEpoch, targetEpoch); err != nil {
+			return err
+		}
+		return bucket.Put(key, attestationRecord(sourceEpoch, signingRoot))
+	})
+}
+
+// checkSurroundVote returns an error if an attestation with the given source and
+// target epochs would surround, or be surrounded by, an attestation in the bucket.
+// Either attestation has a target epoch after the source epoch of the other, so
+// only the records from the epoch after the source epoch onwards are scanned.
+func checkSurroundVote(bucket *bolt.Bucket, sourceEpoch uint64, targetEpoch uint64) error {
+	c := bucket.Cursor()
+	for k, v := c.Seek(uint64ToBytes(sourceEpoch + 1)); k != nil; k, v = c.Next() {
+		prevTarget := binary.BigEndian.Uint64(k)
+		prevSource := binary.BigEndian.Uint64(v[:8])
+		surrounds := sourceEpoch < prevSource && prevTarget < targetEpoch
+		surrounded := prevSource < sourceEpoch && targetEpoch < prevTarget
+		if surrounds || surrounded {
+			return fmt.Errorf("surround vote: already signed attestation %#x with source epoch %d and target epoch %d",
+				v[8:], prevSource-params.BeaconConfig().GenesisEpoch, prevTarget-params.BeaconConfig().GenesisEpoch)
+		}
+	}
+	return nil
+}
+
+// SignedInEpoch returns whether the validator signed an attestation targeting the
+// epoch or a block at one of the slots of the epoch.
+func (db *ValidatorDB) SignedInEpoch(pubKey []byte, epoch uint64) (bool, error) {
+	var signed bool
+	err := db.db.View(func(tx *bolt.Tx) error {
+		if bucket := tx.Bucket(attestationHistoryBucket).Bucket(pubKey); bucket != nil {
+			if bucket.Get(uint64ToBytes(epoch)) != nil {
+				signed = true
+				return nil
+			}
+		}
+		bucket := tx.Bucket(proposalHistoryBucket).Bucket(pubKey)
+		if bucket == nil {
+			return nil
+		}
+		startSlot := epoch * params.BeaconConfig().SlotsPerEpoch
+		k, _ := bucket.Cursor().Seek(uint64ToBytes(startSlot))
+		signed = k != nil && binary.BigEndian.Uint64(k) < startSlot+params.BeaconConfig().SlotsPerEpoch
+		return nil
+	})
+	return signed, err
+}
+
+// attestationRecord encodes the source epoch followed by the signing root of an
+// attestation, it is stored under the attestation's target epoch.
+func attestationRecord(sourceEpoch uint64, signingRoot [32]byte) []byte {
+	return append(uint64ToBytes(sourceEpoch), signingRoot[:]...)
+}
+
+// uint64ToBytes encodes integers as big endian so keys sort numerically.
+func uint64ToBytes(i uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, i)
+	return buf
+}
@@ -0,0 +1,106 @@
+package db
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestCheckAndSaveProposal_RefusesDoubleProposal(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte{'A'}
+	slot := params.BeaconConfig().GenesisSlot + 10
+
+	if err := db.CheckAndSaveProposal(pubKey, slot, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveProposal(pubKey, slot, [32]byte{1}); err != nil {
+		t.Errorf("Expected signing the same block again to be allowed: %v", err)
+	}
+	if err := db.CheckAndSaveProposal(pubKey, slot+1, [32]byte{2}); err != nil {
+		t.Errorf("Expected proposal at another slot to be allowed: %v", err)
+	}
+	if err := db.CheckAndSaveProposal([]byte{'B'}, slot, [32]byte{2}); err != nil {
+		t.Errorf("Expected proposal of another validator to be allowed: %v", err)
+	}
+
+	want := "double proposal"
+	if err := db.CheckAndSaveProposal(pubKey, slot, [32]byte{2}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestCheckAndSaveAttestation_RefusesSlashableVotes(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte{'A'}
+	epoch := func(e uint64) uint64 {
+		return params.BeaconConfig().GenesisEpoch + e
+	}
+
+	if err := db.CheckAndSaveAttestation(pubKey, epoch(2), epoch(4), [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveAttestation(pubKey, epoch(2), epoch(4), [32]byte{1}); err != nil {
+		t.Errorf("Expected signing the same attestation again to be allowed: %v", err)
+	}
+	if err := db.CheckAndSaveAttestation(pubKey, epoch(4), epoch(5), [32]byte{2}); err != nil {
+		t.Errorf("Expected following attestation to be allowed: %v", err)
+	}
+
+	tests := []struct {
+		source uint64
+		target uint64
+		want   string
+	}{
+		{source: 2, target: 4, want: "double vote"},
+		{source: 3, target: 5, want: "double vote"},
+		{source: 1, target: 6, want: "surround vote"},
+		{source: 3, target: 4, want: "double vote"},
+		{source: 3, target: 3, want: "surround vote"},
+	}
+	for _, tt := range tests {
+		err := db.CheckAndSaveAttestation(pubKey, epoch(tt.source), epoch(tt.target), [32]byte{3})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Attestation (%d, %d): expected %s, received %v", tt.source, tt.target, tt.want, err)
+		}
+	}
+}
+
+func TestSignedInEpoch(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte{'A'}
+	epoch := params.BeaconConfig().GenesisEpoch + 3
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	if err := db.CheckAndSaveAttestation(pubKey, epoch-1, epoch, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveProposal(pubKey, (epoch+2)*slotsPerEpoch-1, [32]byte{2}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		pubKey []byte
+		epoch  uint64
+		signed bool
+	}{
+		{pubKey: pubKey, epoch: epoch - 1, signed: false},
+		{pubKey: pubKey, epoch: epoch, signed: true},
+		{pubKey: pubKey, epoch: epoch + 1, signed: true},
+		{pubKey: pubKey, epoch: epoch + 2, signed: false},
+		{pubKey: []byte{'B'}, epoch: epoch, signed: false},
+	}
+	for _, tt := range tests {
+		signed, err := db.SignedInEpoch(tt.pubKey, tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signed != tt.signed {
+			t.Errorf("Expected signed in epoch %d to be %t, received %t", tt.epoch-params.BeaconConfig().GenesisEpoch, tt.signed, signed)
+		}
+	}
+}
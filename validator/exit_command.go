@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"syscall"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/types"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var exitPublicKeyFlag = cli.StringFlag{
+	Name:  "public-key",
+	Usage: "Hex encoded public key of the validator to exit, required when the keystore holds several keys",
+}
+
+var exitCommand = cli.Command{
+	Name:     "exit",
+	Category: "exit",
+	Usage:    "signs a voluntary exit of a validator and submits it to the beacon node",
+	Description: `builds a voluntary exit at the current epoch of the beacon node for a key of the keystore, signs it
+and sends it to the beacon node which verifies and broadcasts it. The validator stops being active once
+the exit is included in a block, and an exit cannot be undone`,
+	Flags: []cli.Flag{
+		types.KeystorePathFlag,
+		types.PasswordFlag,
+		types.BeaconRPCProviderFlag,
+		types.CertFlag,
+		exitPublicKeyFlag,
+	},
+	Action: exitValidator,
+}
+
+func exitValidator(ctx *cli.Context) error {
+	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	if keystorePassword == "" {
+		fmt.Println("Enter your validator account password:")
+		bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return fmt.Errorf("could not read account password: %v", err)
+		}
+		keystorePassword = strings.Replace(string(bytePassword), "\n", "", -1)
+	}
+	ks := keystore.NewKeystore(keystoreDirectory)
+	keys, err := ks.GetKeys(keystoreDirectory, params.BeaconConfig().ValidatorPrivkeyFileName, keystorePassword)
+	if err != nil {
+		return fmt.Errorf("could not get private keys: %v", err)
+	}
+	key, err := exitKey(keys, ctx.String(exitPublicKeyFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	dialOpt := grpc.WithInsecure()
+	if cert := ctx.String(types.CertFlag.Name); cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	}
+	endpoint := ctx.String(types.BeaconRPCProviderFlag.Name)
+	conn, err := grpc.Dial(endpoint, dialOpt)
+	if err != nil {
+		return fmt.Errorf("could not dial endpoint %s: %v", endpoint, err)
+	}
+	defer conn.Close()
+
+	exit, err := client.ProposeExit(
+		context.Background(),
+		pb.NewBeaconServiceClient(conn),
+		pb.NewValidatorServiceClient(conn),
+		key,
+	)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Submitted exit of validator %d at epoch %d\n",
+		exit.ValidatorIndex, exit.Epoch-params.BeaconConfig().GenesisEpoch)
+	return nil
+}
+
+// exitKey selects the key of the validator to exit among the keys of the
+// keystore, by public key when one is given.
+func exitKey(keys map[string]*keystore.Key, publicKey string) (*keystore.Key, error) {
+	if publicKey == "" {
+		if len(keys) != 1 {
+			return nil, fmt.Errorf("the keystore holds %d keys, select one with --%s", len(keys), exitPublicKeyFlag.Name)
+		}
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	key, ok := keys[strings.TrimPrefix(publicKey, "0x")]
+	if !ok {
+		return nil, errors.New("no key of the keystore matches the public key")
+	}
+	return key, nil
+}
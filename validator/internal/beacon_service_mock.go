@@ -119,6 +119,26 @@ func (mr *MockBeaconServiceClientMockRecorder) Eth1Data(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1Data), varargs...)
 }
 
+// ForkChoiceStore mocks base method
+func (m *MockBeaconServiceClient) ForkChoiceStore(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.ForkChoiceStoreResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkChoiceStore", varargs...)
+	ret0, _ := ret[0].(*v10.ForkChoiceStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkChoiceStore indicates an expected call of ForkChoiceStore
+func (mr *MockBeaconServiceClientMockRecorder) ForkChoiceStore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkChoiceStore", reflect.TypeOf((*MockBeaconServiceClient)(nil).ForkChoiceStore), varargs...)
+}
+
 // ForkData mocks base method
 func (m *MockBeaconServiceClient) ForkData(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.Fork, error) {
 	m.ctrl.T.Helper()
@@ -179,6 +199,26 @@ func (mr *MockBeaconServiceClientMockRecorder) PendingDeposits(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceClient)(nil).PendingDeposits), varargs...)
 }
 
+// StateAtSlot mocks base method
+func (m *MockBeaconServiceClient) StateAtSlot(arg0 context.Context, arg1 *v10.StateAtSlotRequest, arg2 ...grpc.CallOption) (*v1.BeaconState, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StateAtSlot", varargs...)
+	ret0, _ := ret[0].(*v1.BeaconState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateAtSlot indicates an expected call of StateAtSlot
+func (mr *MockBeaconServiceClientMockRecorder) StateAtSlot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateAtSlot", reflect.TypeOf((*MockBeaconServiceClient)(nil).StateAtSlot), varargs...)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_WaitForChainStartClient, error) {
 	m.ctrl.T.Helper()
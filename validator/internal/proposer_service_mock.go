@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/gogo/protobuf/types"
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	v10 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -77,6 +78,66 @@ func (mr *MockProposerServiceClientMockRecorder) PendingAttestations(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingAttestations", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingAttestations), varargs...)
 }
 
+// PendingAttesterSlashings mocks base method
+func (m *MockProposerServiceClient) PendingAttesterSlashings(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.PendingAttesterSlashingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingAttesterSlashings", varargs...)
+	ret0, _ := ret[0].(*v10.PendingAttesterSlashingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingAttesterSlashings indicates an expected call of PendingAttesterSlashings
+func (mr *MockProposerServiceClientMockRecorder) PendingAttesterSlashings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingAttesterSlashings", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingAttesterSlashings), varargs...)
+}
+
+// PendingExits mocks base method
+func (m *MockProposerServiceClient) PendingExits(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.PendingExitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingExits", varargs...)
+	ret0, _ := ret[0].(*v10.PendingExitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingExits indicates an expected call of PendingExits
+func (mr *MockProposerServiceClientMockRecorder) PendingExits(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingExits", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingExits), varargs...)
+}
+
+// PendingProposerSlashings mocks base method
+func (m *MockProposerServiceClient) PendingProposerSlashings(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.PendingProposerSlashingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingProposerSlashings", varargs...)
+	ret0, _ := ret[0].(*v10.PendingProposerSlashingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingProposerSlashings indicates an expected call of PendingProposerSlashings
+func (mr *MockProposerServiceClientMockRecorder) PendingProposerSlashings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingProposerSlashings", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingProposerSlashings), varargs...)
+}
+
 // ProposeBlock mocks base method
 func (m *MockProposerServiceClient) ProposeBlock(arg0 context.Context, arg1 *v1.BeaconBlock, arg2 ...grpc.CallOption) (*v10.ProposeResponse, error) {
 	m.ctrl.T.Helper()
@@ -9,7 +9,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	v10 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	grpc "google.golang.org/grpc"
 	metadata "google.golang.org/grpc/metadata"
 )
@@ -38,14 +39,14 @@ func (m *MockValidatorServiceClient) EXPECT() *MockValidatorServiceClientMockRec
 }
 
 // CommitteeAssignment mocks base method
-func (m *MockValidatorServiceClient) CommitteeAssignment(arg0 context.Context, arg1 *v1.CommitteeAssignmentsRequest, arg2 ...grpc.CallOption) (*v1.CommitteeAssignmentResponse, error) {
+func (m *MockValidatorServiceClient) CommitteeAssignment(arg0 context.Context, arg1 *v10.CommitteeAssignmentsRequest, arg2 ...grpc.CallOption) (*v10.CommitteeAssignmentResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CommitteeAssignment", varargs...)
-	ret0, _ := ret[0].(*v1.CommitteeAssignmentResponse)
+	ret0, _ := ret[0].(*v10.CommitteeAssignmentResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -58,14 +59,14 @@ func (mr *MockValidatorServiceClientMockRecorder) CommitteeAssignment(arg0, arg1
 }
 
 // ExitedValidators mocks base method
-func (m *MockValidatorServiceClient) ExitedValidators(arg0 context.Context, arg1 *v1.ExitedValidatorsRequest, arg2 ...grpc.CallOption) (*v1.ExitedValidatorsResponse, error) {
+func (m *MockValidatorServiceClient) ExitedValidators(arg0 context.Context, arg1 *v10.ExitedValidatorsRequest, arg2 ...grpc.CallOption) (*v10.ExitedValidatorsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ExitedValidators", varargs...)
-	ret0, _ := ret[0].(*v1.ExitedValidatorsResponse)
+	ret0, _ := ret[0].(*v10.ExitedValidatorsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -77,15 +78,35 @@ func (mr *MockValidatorServiceClientMockRecorder) ExitedValidators(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitedValidators", reflect.TypeOf((*MockValidatorServiceClient)(nil).ExitedValidators), varargs...)
 }
 
+// ProposeExit mocks base method
+func (m *MockValidatorServiceClient) ProposeExit(arg0 context.Context, arg1 *v1.VoluntaryExit, arg2 ...grpc.CallOption) (*v10.ProposeExitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProposeExit", varargs...)
+	ret0, _ := ret[0].(*v10.ProposeExitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeExit indicates an expected call of ProposeExit
+func (mr *MockValidatorServiceClientMockRecorder) ProposeExit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeExit", reflect.TypeOf((*MockValidatorServiceClient)(nil).ProposeExit), varargs...)
+}
+
 // ValidatorIndex mocks base method
-func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorIndexResponse, error) {
+func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v10.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v10.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ValidatorIndex", varargs...)
-	ret0, _ := ret[0].(*v1.ValidatorIndexResponse)
+	ret0, _ := ret[0].(*v10.ValidatorIndexResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -97,15 +118,35 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorIndex(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorIndex", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorIndex), varargs...)
 }
 
+// ValidatorLiveness mocks base method
+func (m *MockValidatorServiceClient) ValidatorLiveness(arg0 context.Context, arg1 *v10.ValidatorLivenessRequest, arg2 ...grpc.CallOption) (*v10.ValidatorLivenessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorLiveness", varargs...)
+	ret0, _ := ret[0].(*v10.ValidatorLivenessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorLiveness indicates an expected call of ValidatorLiveness
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorLiveness(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorLiveness", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorLiveness), varargs...)
+}
+
 // ValidatorPerformance mocks base method
-func (m *MockValidatorServiceClient) ValidatorPerformance(arg0 context.Context, arg1 *v1.ValidatorPerformanceRequest, arg2 ...grpc.CallOption) (*v1.ValidatorPerformanceResponse, error) {
+func (m *MockValidatorServiceClient) ValidatorPerformance(arg0 context.Context, arg1 *v10.ValidatorPerformanceRequest, arg2 ...grpc.CallOption) (*v10.ValidatorPerformanceResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ValidatorPerformance", varargs...)
-	ret0, _ := ret[0].(*v1.ValidatorPerformanceResponse)
+	ret0, _ := ret[0].(*v10.ValidatorPerformanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -118,14 +159,14 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorPerformance(arg0, arg
 }
 
 // ValidatorStatus mocks base method
-func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorStatusResponse, error) {
+func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v10.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v10.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ValidatorStatus", varargs...)
-	ret0, _ := ret[0].(*v1.ValidatorStatusResponse)
+	ret0, _ := ret[0].(*v10.ValidatorStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -138,14 +179,14 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorStatus(arg0, arg1 int
 }
 
 // WaitForActivation mocks base method
-func (m *MockValidatorServiceClient) WaitForActivation(arg0 context.Context, arg1 *v1.ValidatorActivationRequest, arg2 ...grpc.CallOption) (v1.ValidatorService_WaitForActivationClient, error) {
+func (m *MockValidatorServiceClient) WaitForActivation(arg0 context.Context, arg1 *v10.ValidatorActivationRequest, arg2 ...grpc.CallOption) (v10.ValidatorService_WaitForActivationClient, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "WaitForActivation", varargs...)
-	ret0, _ := ret[0].(v1.ValidatorService_WaitForActivationClient)
+	ret0, _ := ret[0].(v10.ValidatorService_WaitForActivationClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -224,10 +265,10 @@ func (mr *MockValidatorService_WaitForActivationClientMockRecorder) Header() *go
 }
 
 // Recv mocks base method
-func (m *MockValidatorService_WaitForActivationClient) Recv() (*v1.ValidatorActivationResponse, error) {
+func (m *MockValidatorService_WaitForActivationClient) Recv() (*v10.ValidatorActivationResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*v1.ValidatorActivationResponse)
+	ret0, _ := ret[0].(*v10.ValidatorActivationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -28,29 +28,32 @@ func startNode(ctx *cli.Context) error {
 	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
 
-	exists, err := accounts.Exists(keystoreDirectory)
-	if err != nil {
-		logrus.Fatal(err)
-	}
-	if !exists {
-		// If an account does not exist, we create a new one and start the node.
-		keystoreDirectory, keystorePassword, err = createValidatorAccount(ctx)
+	// Validators signing with a remote signer have no keystore to unlock.
+	if ctx.String(types.RemoteSignerFlag.Name) == "" {
+		exists, err := accounts.Exists(keystoreDirectory)
 		if err != nil {
-			logrus.Fatalf("Could not create validator account: %v", err)
+			logrus.Fatal(err)
 		}
-	} else {
-		if keystorePassword == "" {
-			logrus.Info("Enter your validator account password:")
-			bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
+		if !exists {
+			// If an account does not exist, we create a new one and start the node.
+			keystoreDirectory, keystorePassword, err = createValidatorAccount(ctx)
 			if err != nil {
-				logrus.Fatalf("Could not read account password: %v", err)
+				logrus.Fatalf("Could not create validator account: %v", err)
+			}
+		} else {
+			if keystorePassword == "" {
+				logrus.Info("Enter your validator account password:")
+				bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
+				if err != nil {
+					logrus.Fatalf("Could not read account password: %v", err)
+				}
+				text := string(bytePassword)
+				keystorePassword = strings.Replace(text, "\n", "", -1)
 			}
-			text := string(bytePassword)
-			keystorePassword = strings.Replace(text, "\n", "", -1)
-		}
 
-		if err := accounts.VerifyAccountNotExists(keystoreDirectory, keystorePassword); err == nil {
-			logrus.Info("No account found, creating new validator account...")
+			if err := accounts.VerifyAccountNotExists(keystoreDirectory, keystorePassword); err == nil {
+				logrus.Info("No account found, creating new validator account...")
+			}
 		}
 	}
 
@@ -131,13 +134,21 @@ contract in order to activate the validator client`,
 				},
 			},
 		},
+		slashingProtectionCommand,
+		exitCommand,
 	}
 	app.Flags = []cli.Flag{
 		types.NoCustomConfigFlag,
 		types.BeaconRPCProviderFlag,
 		types.KeystorePathFlag,
 		types.PasswordFlag,
+		types.RemoteSignerFlag,
+		types.RemoteSignerCertFlag,
+		types.RemoteSignerClientCertFlag,
+		types.RemoteSignerClientKeyFlag,
 		types.DisablePenaltyRewardLogFlag,
+		types.DoppelgangerDetectionEpochsFlag,
+		types.DisableDoppelgangerDetectionFlag,
 		cmd.VerbosityFlag,
 		cmd.DataDirFlag,
 		cmd.EnableTracingFlag,
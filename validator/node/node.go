@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
 	"sync"
 	"syscall"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/types"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -26,6 +28,10 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
+// ValidatorDBName is the name of the slashing protection database directory
+// inside the data directory.
+const ValidatorDBName = "validatordata"
+
 // ValidatorClient defines an instance of a sharding validator that manages
 // the entire lifecycle of services attached to it participating in
 // Ethereum Serenity.
@@ -33,6 +39,7 @@ type ValidatorClient struct {
 	ctx      *cli.Context
 	services *shared.ServiceRegistry // Lifecycle and service store.
 	lock     sync.RWMutex
+	db       *db.ValidatorDB
 	stop     chan struct{} // Channel to wait for termination notifications.
 }
 
@@ -62,6 +69,10 @@ func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, er
 
 	featureconfig.ConfigureBeaconFeatures(ctx)
 
+	if err := ValidatorClient.startDB(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := ValidatorClient.registerPrometheusService(ctx); err != nil {
 		return nil, err
 	}
@@ -113,11 +124,26 @@ func (s *ValidatorClient) Close() {
 	defer s.lock.Unlock()
 
 	s.services.StopAll()
+	if err := s.db.Close(); err != nil {
+		log.Errorf("Failed to close database: %v", err)
+	}
 	log.Info("Stopping sharding validator")
 
 	close(s.stop)
 }
 
+func (s *ValidatorClient) startDB(ctx *cli.Context) error {
+	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
+	dbPath := path.Join(baseDir, ValidatorDBName)
+	db, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection database: %v", err)
+	}
+	log.WithField("path", dbPath).Info("Checking db")
+	s.db = db
+	return nil
+}
+
 func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
@@ -131,11 +157,21 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, password strin
 	endpoint := ctx.GlobalString(types.BeaconRPCProviderFlag.Name)
 	keystoreDirectory := ctx.GlobalString(types.KeystorePathFlag.Name)
 	logValidatorBalances := !ctx.GlobalBool(types.DisablePenaltyRewardLogFlag.Name)
+	doppelgangerEpochs := ctx.GlobalUint64(types.DoppelgangerDetectionEpochsFlag.Name)
+	if ctx.GlobalBool(types.DisableDoppelgangerDetectionFlag.Name) {
+		doppelgangerEpochs = 0
+	}
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:             endpoint,
-		KeystorePath:         keystoreDirectory,
-		Password:             password,
-		LogValidatorBalances: logValidatorBalances,
+		Endpoint:               endpoint,
+		KeystorePath:           keystoreDirectory,
+		Password:               password,
+		ValidatorDB:            s.db,
+		LogValidatorBalances:   logValidatorBalances,
+		DoppelgangerEpochs:     doppelgangerEpochs,
+		RemoteSigner:           ctx.GlobalString(types.RemoteSignerFlag.Name),
+		RemoteSignerCert:       ctx.GlobalString(types.RemoteSignerCertFlag.Name),
+		RemoteSignerClientCert: ctx.GlobalString(types.RemoteSignerClientCertFlag.Name),
+		RemoteSignerClientKey:  ctx.GlobalString(types.RemoteSignerClientKeyFlag.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialize client service: %v", err)
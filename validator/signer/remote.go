@@ -0,0 +1,75 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"google.golang.org/grpc"
+)
+
+// RemoteSigner signs through a remote signer over gRPC, so the keys of the
+// validators never enter the validator client process.
+type RemoteSigner struct {
+	client pb.RemoteSignerClient
+}
+
+// NewRemoteSigner creates a signer sending signing requests over the connection.
+func NewRemoteSigner(conn *grpc.ClientConn) *RemoteSigner {
+	return &RemoteSigner{client: pb.NewRemoteSignerClient(conn)}
+}
+
+// PublicKeys returns the public keys the remote signer holds keys for.
+func (s *RemoteSigner) PublicKeys(ctx context.Context) ([][]byte, error) {
+	resp, err := s.client.PublicKeys(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list public keys of remote signer: %v", err)
+	}
+	return resp.PublicKeys, nil
+}
+
+// Sign requests the signature of the signing root from the remote signer.
+func (s *RemoteSigner) Sign(ctx context.Context, pubKey []byte, signingRoot []byte, domain uint64) (*bls.Signature, error) {
+	return s.sign(ctx, &pb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot,
+		Domain:      domain,
+	})
+}
+
+// SignProposal requests the signature of the block from the remote signer,
+// which checks it against its own slashing protection database.
+func (s *RemoteSigner) SignProposal(ctx context.Context, pubKey []byte, slot uint64, signingRoot []byte, domain uint64) (*bls.Signature, error) {
+	return s.sign(ctx, &pb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot,
+		Domain:      domain,
+		Slot:        slot,
+	})
+}
+
+// SignAttestation requests the signature of the attestation from the remote
+// signer, which checks it against its own slashing protection database.
+func (s *RemoteSigner) SignAttestation(ctx context.Context, pubKey []byte, sourceEpoch uint64, targetEpoch uint64, signingRoot []byte, domain uint64) (*bls.Signature, error) {
+	return s.sign(ctx, &pb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot,
+		Domain:      domain,
+		SourceEpoch: sourceEpoch,
+		TargetEpoch: targetEpoch,
+	})
+}
+
+func (s *RemoteSigner) sign(ctx context.Context, req *pb.SignRequest) (*bls.Signature, error) {
+	resp, err := s.client.Sign(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign with remote signer: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(resp.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize signature of remote signer: %v", err)
+	}
+	return sig, nil
+}
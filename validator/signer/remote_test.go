@@ -0,0 +1,141 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"google.golang.org/grpc"
+)
+
+func startServer(t *testing.T, keys map[string]*keystore.Key, protection *db.ValidatorDB) (*grpc.ClientConn, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Could not listen: %v", err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterRemoteSignerServer(s, NewServer(NewLocalSigner(keys), protection))
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Logf("Server stopped: %v", err)
+		}
+	}()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Could not dial server: %v", err)
+	}
+	return conn, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestRemoteSigner_SignsWithServerKeys(t *testing.T) {
+	keys := testKeys(t, 2)
+	conn, stop := startServer(t, keys, nil)
+	defer stop()
+	signer := NewRemoteSigner(conn)
+
+	pubKeys, err := signer.PublicKeys(context.Background())
+	if err != nil {
+		t.Fatalf("Could not list public keys: %v", err)
+	}
+	if len(pubKeys) != len(keys) {
+		t.Fatalf("Expected %d public keys, received %d", len(keys), len(pubKeys))
+	}
+
+	root := make([]byte, 32)
+	root[0] = 'A'
+	for _, key := range keys {
+		sig, err := signer.Sign(context.Background(), key.PublicKey.Marshal(), root, 5)
+		if err != nil {
+			t.Fatalf("Could not sign: %v", err)
+		}
+		if !sig.Verify(root, key.PublicKey, 5) {
+			t.Error("Expected signature of the remote signer to verify")
+		}
+	}
+}
+
+func TestRemoteSigner_RejectsInvalidRequests(t *testing.T) {
+	keys := testKeys(t, 1)
+	conn, stop := startServer(t, keys, nil)
+	defer stop()
+	signer := NewRemoteSigner(conn)
+
+	var pubKey []byte
+	for _, key := range keys {
+		pubKey = key.PublicKey.Marshal()
+	}
+	want := "signing root must be 32 bytes"
+	if _, err := signer.Sign(context.Background(), pubKey, []byte{'A'}, 5); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	want = "no key for public key"
+	if _, err := signer.Sign(context.Background(), []byte{'B'}, make([]byte, 32), 5); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestRemoteSigner_RefusesSlashableMessages(t *testing.T) {
+	dbPath := path.Join(testutil.TempDir(), fmt.Sprintf("signerdb-%d", rand.Int()))
+	protection, err := db.NewDB(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	defer func() {
+		if err := protection.Close(); err != nil {
+			t.Fatalf("Failed to close database: %v", err)
+		}
+		if err := os.RemoveAll(dbPath); err != nil {
+			t.Fatalf("Failed to remove directory: %v", err)
+		}
+	}()
+	keys := testKeys(t, 1)
+	conn, stop := startServer(t, keys, protection)
+	defer stop()
+	signer := NewRemoteSigner(conn)
+
+	var pubKey []byte
+	for _, key := range keys {
+		pubKey = key.PublicKey.Marshal()
+	}
+	root := func(b byte) []byte {
+		r := make([]byte, 32)
+		r[0] = b
+		return r
+	}
+	slot := params.BeaconConfig().GenesisSlot + 10
+	proposalDomain := params.BeaconConfig().DomainProposal
+	if _, err := signer.SignProposal(context.Background(), pubKey, slot, root('A'), proposalDomain); err != nil {
+		t.Fatalf("Could not sign proposal: %v", err)
+	}
+	want := "double proposal"
+	if _, err := signer.SignProposal(context.Background(), pubKey, slot, root('B'), proposalDomain); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	want = "slot is required"
+	if _, err := signer.Sign(context.Background(), pubKey, root('C'), proposalDomain); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+
+	epoch := params.BeaconConfig().GenesisEpoch
+	attestationDomain := params.BeaconConfig().DomainAttestation
+	if _, err := signer.SignAttestation(context.Background(), pubKey, epoch+2, epoch+4, root('A'), attestationDomain); err != nil {
+		t.Fatalf("Could not sign attestation: %v", err)
+	}
+	want = "surround vote"
+	if _, err := signer.SignAttestation(context.Background(), pubKey, epoch+1, epoch+5, root('B'), attestationDomain); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
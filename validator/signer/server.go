@@ -0,0 +1,90 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "signer")
+
+// domainTypeOffset separates the fork version from the domain type in a domain.
+const domainTypeOffset = 1 << 32
+
+// Server serves the remote signer gRPC service with the keys of a signer, it
+// is what a RemoteSigner connects to.
+type Server struct {
+	signer     Signer
+	protection *db.ValidatorDB
+}
+
+// NewServer creates a remote signer server signing with the signer. Block
+// proposals and attestations are checked against the slashing protection
+// database before they are signed, unless it is nil.
+func NewServer(signer Signer, protection *db.ValidatorDB) *Server {
+	return &Server{signer: signer, protection: protection}
+}
+
+// PublicKeys lists the public keys the server signs for.
+func (s *Server) PublicKeys(ctx context.Context, _ *ptypes.Empty) (*pb.PublicKeysResponse, error) {
+	pubKeys, err := s.signer.PublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not list public keys: %v", err)
+	}
+	return &pb.PublicKeysResponse{PublicKeys: pubKeys}, nil
+}
+
+// Sign signs a 32 byte signing root in the domain with the key of the public key.
+func (s *Server) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	if len(req.SigningRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "signing root must be 32 bytes, received %d", len(req.SigningRoot))
+	}
+	if err := s.checkSlashable(req); err != nil {
+		return nil, err
+	}
+	sig, err := s.signer.Sign(ctx, req.PublicKey, req.SigningRoot, req.Domain)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not sign: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"publicKey":   fmt.Sprintf("%#x", req.PublicKey),
+		"signingRoot": fmt.Sprintf("%#x", req.SigningRoot),
+		"domain":      req.Domain,
+	}).Debug("Signed signing root")
+	return &pb.SignResponse{Signature: sig.Marshal()}, nil
+}
+
+// checkSlashable records the block proposals and attestations to sign in the
+// slashing protection database, which refuses the ones conflicting with what
+// the validator already signed.
+func (s *Server) checkSlashable(req *pb.SignRequest) error {
+	if s.protection == nil {
+		return nil
+	}
+	signingRoot := bytesutil.ToBytes32(req.SigningRoot)
+	switch req.Domain % domainTypeOffset {
+	case params.BeaconConfig().DomainProposal:
+		if req.Slot == 0 {
+			return status.Error(codes.InvalidArgument, "slot is required to sign a block proposal")
+		}
+		if err := s.protection.CheckAndSaveProposal(req.PublicKey, req.Slot, signingRoot); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "refusing to sign slashable block: %v", err)
+		}
+	case params.BeaconConfig().DomainAttestation:
+		if req.TargetEpoch == 0 {
+			return status.Error(codes.InvalidArgument, "source and target epochs are required to sign an attestation")
+		}
+		if err := s.protection.CheckAndSaveAttestation(req.PublicKey, req.SourceEpoch, req.TargetEpoch, signingRoot); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "refusing to sign slashable attestation: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Package signer defines how the validator client signs with the keys of its
+// validators, either in-process from the keystore or through a remote signer.
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+// Signer signs on behalf of the validators of a validator client.
+type Signer interface {
+	// PublicKeys returns the public keys of the validators the signer can sign for.
+	PublicKeys(ctx context.Context) ([][]byte, error)
+	// Sign signs the signing root in the domain with the key of the validator
+	// identified by its public key.
+	Sign(ctx context.Context, pubKey []byte, signingRoot []byte, domain uint64) (*bls.Signature, error)
+	// SignProposal signs the signing root of a block proposed at the slot.
+	SignProposal(ctx context.Context, pubKey []byte, slot uint64, signingRoot []byte, domain uint64) (*bls.Signature, error)
+	// SignAttestation signs the signing root of an attestation with the source
+	// and target epochs.
+	SignAttestation(ctx context.Context, pubKey []byte, sourceEpoch uint64, targetEpoch uint64, signingRoot []byte, domain uint64) (*bls.Signature, error)
+}
+
+// LocalSigner signs in-process with the keys loaded from the keystore.
+type LocalSigner struct {
+	keys map[string]*keystore.Key
+}
+
+// NewLocalSigner creates a signer for the keys of a keystore, indexed by the
+// hex encoding of their public key as returned by keystore.Store.GetKeys.
+func NewLocalSigner(keys map[string]*keystore.Key) *LocalSigner {
+	return &LocalSigner{keys: keys}
+}
+
+// PublicKeys returns the public keys of the keystore sorted by their hex encoding.
+func (s *LocalSigner) PublicKeys(_ context.Context) ([][]byte, error) {
+	ids := make([]string, 0, len(s.keys))
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	pubKeys := make([][]byte, len(ids))
+	for i, id := range ids {
+		pubKeys[i] = s.keys[id].PublicKey.Marshal()
+	}
+	return pubKeys, nil
+}
+
+// Sign signs the signing root with the secret key of the public key.
+func (s *LocalSigner) Sign(_ context.Context, pubKey []byte, signingRoot []byte, domain uint64) (*bls.Signature, error) {
+	key, ok := s.keys[hex.EncodeToString(pubKey)]
+	if !ok {
+		return nil, fmt.Errorf("no key for public key %#x", pubKey)
+	}
+	return key.SecretKey.Sign(signingRoot, domain), nil
+}
+
+// SignProposal signs the signing root of the block, the slashing protection of
+// the validator client already checked it.
+func (s *LocalSigner) SignProposal(ctx context.Context, pubKey []byte, _ uint64, signingRoot []byte, domain uint64) (*bls.Signature, error) {
+	return s.Sign(ctx, pubKey, signingRoot, domain)
+}
+
+// SignAttestation signs the signing root of the attestation, the slashing
+// protection of the validator client already checked it.
+func (s *LocalSigner) SignAttestation(ctx context.Context, pubKey []byte, _ uint64, _ uint64, signingRoot []byte, domain uint64) (*bls.Signature, error) {
+	return s.Sign(ctx, pubKey, signingRoot, domain)
+}
@@ -0,0 +1,64 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+func testKeys(t *testing.T, n int) map[string]*keystore.Key {
+	keys := make(map[string]*keystore.Key)
+	for i := 0; i < n; i++ {
+		key, err := keystore.NewKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[hex.EncodeToString(key.PublicKey.Marshal())] = key
+	}
+	return keys
+}
+
+func TestLocalSigner_PublicKeys(t *testing.T) {
+	keys := testKeys(t, 3)
+	pubKeys, err := NewLocalSigner(keys).PublicKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubKeys) != len(keys) {
+		t.Fatalf("Expected %d public keys, received %d", len(keys), len(pubKeys))
+	}
+	for i, pub := range pubKeys {
+		if _, ok := keys[hex.EncodeToString(pub)]; !ok {
+			t.Errorf("Unexpected public key %#x", pub)
+		}
+		if i > 0 && bytes.Compare(pubKeys[i-1], pub) >= 0 {
+			t.Error("Expected public keys to be sorted")
+		}
+	}
+}
+
+func TestLocalSigner_Sign(t *testing.T) {
+	keys := testKeys(t, 1)
+	signer := NewLocalSigner(keys)
+	var key *keystore.Key
+	for _, k := range keys {
+		key = k
+	}
+	root := make([]byte, 32)
+	root[0] = 'A'
+	sig, err := signer.Sign(context.Background(), key.PublicKey.Marshal(), root, 5)
+	if err != nil {
+		t.Fatalf("Could not sign: %v", err)
+	}
+	if !sig.Verify(root, key.PublicKey, 5) {
+		t.Error("Expected signature to verify")
+	}
+
+	if _, err := signer.Sign(context.Background(), []byte{'B'}, root, 5); err == nil {
+		t.Error("Expected an error for an unknown public key")
+	}
+}
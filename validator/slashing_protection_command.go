@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/node"
+	"github.com/urfave/cli"
+)
+
+var historyFileFlag = cli.StringFlag{
+	Name:  "file",
+	Usage: "Path of the slashing protection history file to export to or import from",
+}
+
+var slashingProtectionCommand = cli.Command{
+	Name:     "slashing-protection",
+	Category: "slashing-protection",
+	Usage:    "defines commands for moving the signing history of validators between machines",
+	Subcommands: cli.Commands{
+		cli.Command{
+			Name: "export",
+			Description: `writes the proposals and attestations signed by every validator in the slashing protection
+database of the data directory to a JSON history file`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				historyFileFlag,
+			},
+			Action: exportHistory,
+		},
+		cli.Command{
+			Name: "import",
+			Description: `merges the proposals and attestations of a JSON history file into the slashing protection
+database of the data directory. Import the history before starting the validators on a new machine, and
+only after they are stopped on the old one`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				historyFileFlag,
+			},
+			Action: importHistory,
+		},
+	},
+}
+
+func openDB(ctx *cli.Context) (*db.ValidatorDB, error) {
+	return db.NewDB(path.Join(ctx.String(cmd.DataDirFlag.Name), node.ValidatorDBName))
+}
+
+func exportHistory(ctx *cli.Context) error {
+	if !ctx.IsSet(historyFileFlag.Name) {
+		return errors.New("the path of the history file is required")
+	}
+	validatorDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer validatorDB.Close()
+
+	f, err := os.Create(ctx.String(historyFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := validatorDB.ExportHistory(f); err != nil {
+		return fmt.Errorf("could not export history: %v", err)
+	}
+	fmt.Printf("Exported slashing protection history to %s\n", ctx.String(historyFileFlag.Name))
+	return nil
+}
+
+func importHistory(ctx *cli.Context) error {
+	if !ctx.IsSet(historyFileFlag.Name) {
+		return errors.New("the path of the history file is required")
+	}
+	validatorDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer validatorDB.Close()
+
+	f, err := os.Open(ctx.String(historyFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := validatorDB.ImportHistory(f); err != nil {
+		return fmt.Errorf("could not import history: %v", err)
+	}
+	return nil
+}
@@ -43,6 +43,38 @@ var (
 		Name:  "disable-rewards-penalties-logging",
 		Usage: "Disable reward/penalty logging during cluster deployment",
 	}
+	// RemoteSignerFlag defines the endpoint of a remote signer holding the keys of the validators.
+	RemoteSignerFlag = cli.StringFlag{
+		Name:  "remote-signer",
+		Usage: "Remote signer gRPC endpoint to sign with instead of the keys of the keystore",
+	}
+	// RemoteSignerCertFlag defines a flag for the TLS certificate of the remote signer.
+	RemoteSignerCertFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-cert",
+		Usage: "Certificate for a secure gRPC connection to the remote signer",
+	}
+	// RemoteSignerClientCertFlag defines a flag for the TLS client certificate presented to the remote signer.
+	RemoteSignerClientCertFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-client-cert",
+		Usage: "Client certificate presented to a remote signer requiring mutual TLS, requires --remote-signer-tls-client-key",
+	}
+	// RemoteSignerClientKeyFlag defines a flag for the key of the TLS client certificate.
+	RemoteSignerClientKeyFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-client-key",
+		Usage: "Key of the client certificate presented to the remote signer",
+	}
+	// DoppelgangerDetectionEpochsFlag defines the number of epochs the validator client watches for
+	// attestations and blocks of its validators before starting its duties.
+	DoppelgangerDetectionEpochsFlag = cli.Uint64Flag{
+		Name:  "doppelganger-detection-epochs",
+		Usage: "Number of epochs to watch for activity of the validators before performing their duties, refusing to start if they are active elsewhere",
+		Value: 2,
+	}
+	// DisableDoppelgangerDetectionFlag skips checking whether the validators are already active elsewhere on startup.
+	DisableDoppelgangerDetectionFlag = cli.BoolFlag{
+		Name:  "disable-doppelganger-detection",
+		Usage: "Start performing validator duties without checking if the validator keys are already in use elsewhere",
+	}
 )
 
 func homeDir() string {
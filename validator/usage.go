@@ -72,7 +72,13 @@ var appHelpFlagGroups = []flagGroup{
 			types.BeaconRPCProviderFlag,
 			types.KeystorePathFlag,
 			types.PasswordFlag,
+			types.RemoteSignerFlag,
+			types.RemoteSignerCertFlag,
+			types.RemoteSignerClientCertFlag,
+			types.RemoteSignerClientKeyFlag,
 			types.DisablePenaltyRewardLogFlag,
+			types.DoppelgangerDetectionEpochsFlag,
+			types.DisableDoppelgangerDetectionFlag,
 		},
 	},
 	{